@@ -106,6 +106,11 @@ type LinoBlockchain struct {
 
 	// auth
 	auth sdk.AnteHandler
+
+	// invariants
+	invariants     *InvariantRegistry
+	invCheckPeriod int64
+	invHalt        bool
 }
 
 // NewLinoBlockchain - create a Lino Blockchain instance
@@ -154,7 +159,7 @@ func NewLinoBlockchain(
 		&voteManager, lb.accountManager, lb.priceManager)
 	//// post -> developer
 	lb.postManager = postmn.NewPostManager(
-		lb.CapKeyPostStore, lb.accountManager,
+		lb.CapKeyPostStore, lb.paramHolder, lb.accountManager,
 		lb.globalManager, lb.developerManager, lb.reputationManager, lb.priceManager,
 		lb.voteManager)
 	// bandwidth -> developer
@@ -187,6 +192,13 @@ func NewLinoBlockchain(
 		AddRoute(rep.QuerierRoute, rep.NewQuerier(lb.reputationManager)).
 		AddRoute(pricetypes.QuerierRoute, price.NewQuerier(lb.priceManager))
 
+	lb.invariants = &InvariantRegistry{}
+	lb.accountManager.RegisterInvariants(lb.invariants)
+	lb.developerManager.RegisterInvariants(lb.invariants)
+	lb.valManager.RegisterInvariants(lb.invariants)
+	lb.invCheckPeriod = viper.GetInt64(FlagInvCheckPeriod)
+	lb.invHalt = viper.GetBool(FlagInvHalt)
+
 	lb.SetInitChainer(lb.initChainer)
 	lb.SetBeginBlocker(lb.beginBlocker)
 	lb.SetEndBlocker(lb.endBlocker)
@@ -260,6 +272,8 @@ func registerEvent(cdc *wire.Codec) {
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "lino/eventCpe", nil)
 	// cdc.RegisterConcrete(proposal.DecideProposalEvent{}, "lino/eventDpe", nil)
 	cdc.RegisterConcrete(votetypes.UnassignDutyEvent{}, "lino/eventUde", nil)
+	cdc.RegisterConcrete(votetypes.DecideTreasurySpendEvent{}, "lino/eventDts", nil)
+	cdc.RegisterConcrete(posttypes.DecideCensorshipEvent{}, "lino/eventDcs", nil)
 }
 
 // custom logic for lino blockchain initialization
@@ -452,6 +466,14 @@ func (lb *LinoBlockchain) executeEvent(ctx sdk.Context, event types.Event) sdk.E
 		if err := lb.voteManager.ExecUnassignDutyEvent(ctx, e); err != nil {
 			return err
 		}
+	case votetypes.DecideTreasurySpendEvent:
+		if err := lb.voteManager.ExecDecideTreasurySpendEvent(ctx, e); err != nil {
+			return err
+		}
+	case posttypes.DecideCensorshipEvent:
+		if err := lb.postManager.ExecDecideCensorshipEvent(ctx, e); err != nil {
+			return err
+		}
 	default:
 		return types.ErrUnknownEvent()
 	}
@@ -472,6 +494,8 @@ func (lb *LinoBlockchain) endBlocker(ctx sdk.Context, req abci.RequestEndBlock)
 		panic(err)
 	}
 
+	lb.assertInvariants(ctx)
+
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: validatorUpdates,
 	}
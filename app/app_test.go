@@ -51,9 +51,13 @@ func newLinoBlockchain(t *testing.T, numOfValidators int) *LinoBlockchain {
 				{Name: types.InflationDeveloperPool},
 				{Name: types.InflationValidatorPool},
 				{Name: types.InflationConsumptionPool},
+				{Name: types.InflationTreasuryPool},
 				{Name: types.VoteStakeInPool},
 				{Name: types.VoteStakeReturnPool},
 				{Name: types.VoteFrictionPool},
+				{Name: types.VoteTreasuryDepositPool},
+				{Name: types.PostCensorshipDepositPool},
+				{Name: types.PostDonationEscrowPool},
 				{
 					Name: types.DevIDAReservePool,
 				},
@@ -134,9 +138,13 @@ func TestGenesisAcc(t *testing.T) {
 				{Name: types.InflationDeveloperPool},
 				{Name: types.InflationValidatorPool},
 				{Name: types.InflationConsumptionPool},
+				{Name: types.InflationTreasuryPool},
 				{Name: types.VoteStakeInPool},
 				{Name: types.VoteStakeReturnPool},
 				{Name: types.VoteFrictionPool},
+				{Name: types.VoteTreasuryDepositPool},
+				{Name: types.PostCensorshipDepositPool},
+				{Name: types.PostDonationEscrowPool},
 				{
 					Name:   types.DevIDAReservePool,
 					Amount: types.MustLinoToCoin("2000000000"),
@@ -208,9 +216,13 @@ func TestGenesisFromConfig(t *testing.T) {
 				{Name: types.InflationDeveloperPool},
 				{Name: types.InflationValidatorPool},
 				{Name: types.InflationConsumptionPool},
+				{Name: types.InflationTreasuryPool},
 				{Name: types.VoteStakeInPool},
 				{Name: types.VoteStakeReturnPool},
 				{Name: types.VoteFrictionPool},
+				{Name: types.VoteTreasuryDepositPool},
+				{Name: types.PostCensorshipDepositPool},
+				{Name: types.PostDonationEscrowPool},
 				{
 					Name:   types.DevIDAReservePool,
 					Amount: types.MustLinoToCoin("2000000000"),
@@ -232,11 +244,20 @@ func TestGenesisFromConfig(t *testing.T) {
 			ContentCreatorAllocation: types.NewDecFromRat(85, 100),
 			DeveloperAllocation:      types.NewDecFromRat(10, 100),
 			ValidatorAllocation:      types.NewDecFromRat(5, 100),
+			InflationPolicy:          param.FixedRateInflation,
+			TargetStakingRatio:       types.NewDecFromRat(50, 100),
+			GrowthRateAdjustment:     types.NewDecFromRat(3, 100),
+			GrowthRateDecay:          types.NewDecFromRat(10, 100),
+			TreasuryAllocation:       types.NewDecFromRat(0, 1),
 		},
 		param.VoteParam{
 			MinStakeIn:                 types.NewCoinFromInt64(1000 * types.Decimals),
 			VoterCoinReturnIntervalSec: int64(7 * 24 * 3600),
 			VoterCoinReturnTimes:       int64(7),
+			TreasurySpendDecideSec:     int64(7 * 24 * 3600),
+			TreasurySpendPassRatio:     types.NewDecFromRat(1, 2),
+			TreasurySpendQuorum:        types.NewDecFromRat(20, 100),
+			TreasurySpendMinDeposit:    types.NewCoinFromInt64(1000 * types.Decimals),
 		},
 		param.ProposalParam{
 			ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -273,6 +294,13 @@ func TestGenesisFromConfig(t *testing.T) {
 			StandbyInflationWeight:         int64(1),
 			MaxVotedValidators:             int64(3),
 			SlashLimitation:                int64(5),
+			MaxCommissionRate:              types.NewDecFromRat(50, 100),
+			MaxCommissionChangeRate:        types.NewDecFromRat(5, 100),
+			CommissionChangeIntervalSec:    int64(24 * 3600),
+			JailDurationSec:                int64(24 * 3600),
+			ByzantineJailDurationSec:       int64(7 * 24 * 3600),
+			ByzantineSlashFraction:         types.NewDecFromRat(5, 100),
+			SignedBlocksWindow:             int64(2400),
 		},
 		param.BandwidthParam{
 			SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
@@ -294,7 +322,11 @@ func TestGenesisFromConfig(t *testing.T) {
 			MinimumBalance: types.NewCoinFromInt64(1 * types.Decimals),
 			RegisterFee:    types.NewCoinFromInt64(0),
 		},
-		param.PostParam{},
+		param.PostParam{
+			ContentBonusPolicy:  param.ConsumptionContentBonus,
+			ReputationWeight:    types.NewDecFromRat(50, 100),
+			RecentDonationsSize: 20,
+		},
 		param.ReputationParam{
 			BestContentIndexN: 200,
 			UserMaxN:          50,
@@ -366,6 +398,9 @@ func TestDistributeInflationToValidators(t *testing.T) {
 			inflationForValidator.ToDec().Quo(sdk.NewDec(int64(21 - i))))
 		expectBalanceList[i] = expectBalanceList[i].Plus(inflation)
 		inflationForValidator = inflationForValidator.Minus(inflation)
+		// validators only vote themselves, claim the shared inflation back.
+		err := lb.valManager.ClaimValidatorReward(ctx, types.AccountKey("validator"+strconv.Itoa(i)))
+		assert.Nil(t, err)
 		saving, err :=
 			lb.accountManager.GetSavingFromUsername(
 				ctx, types.AccountKey("validator"+strconv.Itoa(i)))
@@ -396,9 +431,20 @@ func TestFireByzantineValidators(t *testing.T) {
 	assert.Equal(t, 20, len(lst.Oncall)+len(lst.Standby))
 }
 
+// unclaimedValidatorReward - sum of validator reward shared to the genesis validators,
+// which only vote themselves.
+func unclaimedValidatorReward(ctx sdk.Context, lb *LinoBlockchain, numOfValidators int) types.Coin {
+	total := types.NewCoinFromInt64(0)
+	for i := 0; i < numOfValidators; i++ {
+		reward := lb.valManager.GetVoterReward(ctx, types.AccountKey("validator"+strconv.Itoa(i)))
+		total = total.Plus(reward.Unclaimed)
+	}
+	return total
+}
+
 // TODO(yumin):
-// This testcase only covers that the pool is distributed to empty, but the amount
-// is not checked.
+// This testcase only covers that the pool is distributed to voters' unclaimed reward,
+// but the amount is not checked.
 func TestDistributeInflationToValidator(t *testing.T) {
 	lb := newLinoBlockchain(t, 21)
 	cases := map[string]struct {
@@ -433,11 +479,11 @@ func TestDistributeInflationToValidator(t *testing.T) {
 		if err != nil {
 			t.Errorf("%s: failed to get inflation pool, got err %v", testName, err)
 		}
-		if !inflationPool.IsZero() {
+		unclaimed := unclaimedValidatorReward(ctx, lb, 21)
+		if !inflationPool.IsEqual(unclaimed) {
 			t.Errorf(
 				"%s: diff validator inflation pool, got %v, want %v",
-				testName, inflationPool,
-				types.NewCoinFromInt64(0))
+				testName, inflationPool, unclaimed)
 			return
 		}
 	}
@@ -472,7 +518,7 @@ func TestHourlyInflation(t *testing.T) {
 
 			vPool, err := lb.accountManager.GetPool(ctx, types.InflationValidatorPool)
 			assert.Nil(t, err)
-			assert.Equal(t, types.NewCoinFromInt64(0), vPool)
+			assert.Equal(t, unclaimedValidatorReward(ctx, lb, 21), vPool)
 		}
 	}
 }
@@ -31,10 +31,14 @@ func (g GenesisPools) IsValid() error {
 	poolMap[types.InflationDeveloperPool] = true
 	poolMap[types.InflationValidatorPool] = true
 	poolMap[types.InflationConsumptionPool] = true
+	poolMap[types.InflationTreasuryPool] = true
 	poolMap[types.AccountVestingPool] = true
 	poolMap[types.VoteStakeInPool] = true
 	poolMap[types.VoteStakeReturnPool] = true
 	poolMap[types.VoteFrictionPool] = true
+	poolMap[types.VoteTreasuryDepositPool] = true
+	poolMap[types.PostCensorshipDepositPool] = true
+	poolMap[types.PostDonationEscrowPool] = true
 	poolMap[types.DevIDAReservePool] = true
 
 	// checks
@@ -156,9 +160,13 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				{Name: types.InflationDeveloperPool},
 				{Name: types.InflationValidatorPool},
 				{Name: types.InflationConsumptionPool},
+				{Name: types.InflationTreasuryPool},
 				{Name: types.VoteStakeInPool},
 				{Name: types.VoteStakeReturnPool},
 				{Name: types.VoteFrictionPool},
+				{Name: types.VoteTreasuryDepositPool},
+				{Name: types.PostCensorshipDepositPool},
+				{Name: types.PostDonationEscrowPool},
 				{
 					Name:   types.DevIDAReservePool,
 					Amount: types.MustLinoToCoin("2000000000"),
@@ -180,11 +188,20 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ContentCreatorAllocation: types.NewDecFromRat(10, 100),
 				DeveloperAllocation:      types.NewDecFromRat(70, 100),
 				ValidatorAllocation:      types.NewDecFromRat(20, 100),
+				InflationPolicy:          param.FixedRateInflation,
+				TargetStakingRatio:       types.NewDecFromRat(50, 100),
+				GrowthRateAdjustment:     types.NewDecFromRat(3, 100),
+				GrowthRateDecay:          types.NewDecFromRat(10, 100),
+				TreasuryAllocation:       types.NewDecFromRat(0, 1),
 			},
 			param.VoteParam{
 				MinStakeIn:                 types.NewCoinFromInt64(1000 * types.Decimals),
 				VoterCoinReturnIntervalSec: int64(7 * 24 * 3600),
 				VoterCoinReturnTimes:       int64(7),
+				TreasurySpendDecideSec:     int64(7 * 24 * 3600),
+				TreasurySpendPassRatio:     types.NewDecFromRat(1, 2),
+				TreasurySpendQuorum:        types.NewDecFromRat(20, 100),
+				TreasurySpendMinDeposit:    types.NewCoinFromInt64(1000 * types.Decimals),
 			},
 			param.ProposalParam{
 				ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -221,6 +238,13 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				StandbyInflationWeight:         int64(1),
 				MaxVotedValidators:             int64(3),
 				SlashLimitation:                int64(5),
+				MaxCommissionRate:              types.NewDecFromRat(50, 100),
+				MaxCommissionChangeRate:        types.NewDecFromRat(5, 100),
+				CommissionChangeIntervalSec:    int64(24 * 3600),
+				JailDurationSec:                int64(24 * 3600),
+				ByzantineJailDurationSec:       int64(7 * 24 * 3600),
+				ByzantineSlashFraction:         types.NewDecFromRat(5, 100),
+				SignedBlocksWindow:             int64(2400), // 2 hours
 			},
 			param.BandwidthParam{
 				SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
@@ -242,7 +266,12 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				MinimumBalance: types.NewCoinFromInt64(0),
 				RegisterFee:    types.NewCoinFromInt64(1 * types.Decimals),
 			},
-			param.PostParam{},
+			param.PostParam{
+				ContentBonusPolicy:  param.ConsumptionContentBonus,
+				ReputationWeight:    types.NewDecFromRat(50, 100),
+				KeepRevisionContent: false,
+				RecentDonationsSize: 20,
+			},
 			param.ReputationParam{
 				BestContentIndexN: 200,
 				UserMaxN:          50,
@@ -49,6 +49,10 @@ func TestGetGenesisJson(t *testing.T) {
 					Name:   types.InflationConsumptionPool,
 					Amount: types.NewCoinFromInt64(0),
 				},
+				{
+					Name:   types.InflationTreasuryPool,
+					Amount: types.NewCoinFromInt64(0),
+				},
 				{
 					Name:   types.VoteStakeInPool,
 					Amount: types.NewCoinFromInt64(0),
@@ -61,6 +65,18 @@ func TestGetGenesisJson(t *testing.T) {
 					Name:   types.VoteFrictionPool,
 					Amount: types.NewCoinFromInt64(0),
 				},
+				{
+					Name:   types.VoteTreasuryDepositPool,
+					Amount: types.NewCoinFromInt64(0),
+				},
+				{
+					Name:   types.PostCensorshipDepositPool,
+					Amount: types.NewCoinFromInt64(0),
+				},
+				{
+					Name:   types.PostDonationEscrowPool,
+					Amount: types.NewCoinFromInt64(0),
+				},
 				{
 					Name:   types.DevIDAReservePool,
 					Amount: types.MustLinoToCoin("2000000000"),
@@ -82,11 +98,20 @@ func TestGetGenesisJson(t *testing.T) {
 				ContentCreatorAllocation: types.NewDecFromRat(10, 100),
 				DeveloperAllocation:      types.NewDecFromRat(70, 100),
 				ValidatorAllocation:      types.NewDecFromRat(20, 100),
+				InflationPolicy:          param.FixedRateInflation,
+				TargetStakingRatio:       types.NewDecFromRat(50, 100),
+				GrowthRateAdjustment:     types.NewDecFromRat(3, 100),
+				GrowthRateDecay:          types.NewDecFromRat(10, 100),
+				TreasuryAllocation:       types.NewDecFromRat(0, 1),
 			},
 			param.VoteParam{
 				MinStakeIn:                 types.NewCoinFromInt64(1000 * types.Decimals),
 				VoterCoinReturnIntervalSec: int64(7 * 24 * 3600),
 				VoterCoinReturnTimes:       int64(7),
+				TreasurySpendDecideSec:     int64(7 * 24 * 3600),
+				TreasurySpendPassRatio:     types.NewDecFromRat(1, 2),
+				TreasurySpendQuorum:        types.NewDecFromRat(20, 100),
+				TreasurySpendMinDeposit:    types.NewCoinFromInt64(1000 * types.Decimals),
 			},
 			param.ProposalParam{
 				ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -123,6 +148,13 @@ func TestGetGenesisJson(t *testing.T) {
 				StandbyInflationWeight:         int64(1),
 				MaxVotedValidators:             int64(3),
 				SlashLimitation:                int64(5),
+				MaxCommissionRate:              types.NewDecFromRat(50, 100),
+				MaxCommissionChangeRate:        types.NewDecFromRat(5, 100),
+				CommissionChangeIntervalSec:    int64(24 * 3600),
+				JailDurationSec:                int64(24 * 3600),
+				ByzantineJailDurationSec:       int64(7 * 24 * 3600),
+				ByzantineSlashFraction:         types.NewDecFromRat(5, 100),
+				SignedBlocksWindow:             int64(2400),
 			},
 			param.BandwidthParam{
 				SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
@@ -144,7 +176,11 @@ func TestGetGenesisJson(t *testing.T) {
 				MinimumBalance: types.NewCoinFromInt64(0),
 				RegisterFee:    types.NewCoinFromInt64(1 * types.Decimals),
 			},
-			param.PostParam{},
+			param.PostParam{
+				ContentBonusPolicy:  param.ConsumptionContentBonus,
+				ReputationWeight:    types.NewDecFromRat(50, 100),
+				RecentDonationsSize: 20,
+			},
 			param.ReputationParam{
 				BestContentIndexN: 200,
 				UserMaxN:          50,
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+)
+
+const (
+	// FlagInvCheckPeriod - check invariants every N blocks in end blocker, 0 to disable.
+	FlagInvCheckPeriod = "inv-check-period"
+	// FlagInvHalt - halt the chain when an invariant is broken, otherwise only log it.
+	FlagInvHalt = "inv-halt"
+)
+
+// invariantRoute - an invariant registered by a module.
+type invariantRoute struct {
+	moduleName string
+	route      string
+	invar      sdk.Invariant
+}
+
+// InvariantRegistry - invariants registered by keepers.
+type InvariantRegistry struct {
+	routes []invariantRoute
+}
+
+var _ sdk.InvariantRegistry = &InvariantRegistry{}
+
+// RegisterRoute - register an invariant of module.
+func (ir *InvariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, invariantRoute{
+		moduleName: moduleName,
+		route:      route,
+		invar:      invar,
+	})
+}
+
+// Size - number of registered invariants.
+func (ir *InvariantRegistry) Size() int {
+	return len(ir.routes)
+}
+
+// Check - run all invariants on a cached context, return messages of broken ones.
+func (ir *InvariantRegistry) Check(ctx sdk.Context) []string {
+	cachedCtx, _ := ctx.CacheContext()
+	broken := make([]string, 0)
+	for _, r := range ir.routes {
+		if msg, isBroken := r.invar(cachedCtx); isBroken {
+			broken = append(broken, msg)
+		}
+	}
+	return broken
+}
+
+// assertInvariants - check invariants every invCheckPeriod blocks,
+// panic on violation if invHalt is set.
+func (lb *LinoBlockchain) assertInvariants(ctx sdk.Context) {
+	if lb.invCheckPeriod <= 0 || ctx.BlockHeight()%lb.invCheckPeriod != 0 {
+		return
+	}
+	broken := lb.invariants.Check(ctx)
+	for _, msg := range broken {
+		ctx.Logger().Error(fmt.Sprintf("invariant broken at height %d: %s", ctx.BlockHeight(), msg))
+	}
+	if len(broken) > 0 && lb.invHalt {
+		panic(fmt.Errorf("%d invariants broken at height %d", len(broken), ctx.BlockHeight()))
+	}
+}
+
+// CheckInvariantsCmd - check all invariants on the latest state of data dir.
+func CheckInvariantsCmd(ctx *server.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Check invariants of all modules on the latest state in data dir",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			home := viper.GetString(tmcli.HomeFlag)
+			db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			lb := NewLinoBlockchain(ctx.Logger, db, nil)
+			height := lb.LastBlockHeight()
+			sdkCtx := lb.NewContext(true, abci.Header{Height: height})
+			broken := lb.invariants.Check(sdkCtx)
+			for _, msg := range broken {
+				fmt.Print(msg)
+			}
+			if len(broken) > 0 {
+				return fmt.Errorf("%d of %d invariants broken at height %d",
+					len(broken), lb.invariants.Size(), height)
+			}
+			fmt.Printf("all %d invariants hold at height %d\n", lb.invariants.Size(), height)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/lino-network/lino/types"
+)
+
+func TestInvariants(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{Height: 2, Time: time.Unix(3600, 0)})
+	assert.Equal(t, 4, lb.invariants.Size())
+	assert.Empty(t, lb.invariants.Check(ctx))
+
+	// minting keeps supply equal to the sum of savings and pools.
+	lb.globalManager.OnBeginBlock(ctx)
+	assert.Empty(t, lb.invariants.Check(ctx))
+
+	// reserve pool no longer matches its account pool.
+	err := lb.developerManager.InitGenesis(ctx, types.NewCoinFromInt64(1))
+	assert.Nil(t, err)
+	broken := lb.invariants.Check(ctx)
+	assert.Equal(t, 1, len(broken))
+	assert.Contains(t, broken[0], "developer: reserve-pool invariant")
+
+	// logs only.
+	lb.invCheckPeriod = 1
+	assert.NotPanics(t, func() { lb.assertInvariants(ctx) })
+
+	// only checks every period.
+	lb.invHalt = true
+	lb.invCheckPeriod = 3
+	assert.NotPanics(t, func() { lb.assertInvariants(ctx) })
+	lb.invCheckPeriod = 2
+	assert.Panics(t, func() { lb.assertInvariants(ctx) })
+}
@@ -50,10 +50,13 @@ func main() {
 		Short:             "Lino Blockchain (server)",
 		PersistentPreRunE: server.PersistentPreRunEFn(ctx),
 	}
+	rootCmd.PersistentFlags().Int64(app.FlagInvCheckPeriod, 0, "check invariants every N blocks, 0 to disable")
+	rootCmd.PersistentFlags().Bool(app.FlagInvHalt, false, "halt the chain when an invariant is broken")
 
 	rootCmd.AddCommand(app.VersionCmd())
 
 	rootCmd.AddCommand(app.InitCmd(ctx, cdc))
+	rootCmd.AddCommand(app.CheckInvariantsCmd(ctx))
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
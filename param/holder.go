@@ -53,12 +53,22 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ContentCreatorAllocation: types.NewDecFromRat(85, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		InflationPolicy:          FixedRateInflation,
+		TargetStakingRatio:       types.NewDecFromRat(50, 100),
+		GrowthRateAdjustment:     types.NewDecFromRat(3, 100),
+		GrowthRateDecay:          types.NewDecFromRat(10, 100),
+		TreasuryAllocation:       types.NewDecFromRat(0, 1),
 	}
 	if err := ph.setGlobalAllocationParam(ctx, globalAllocationParam); err != nil {
 		return err
 	}
 
-	postParam := &PostParam{}
+	postParam := &PostParam{
+		ContentBonusPolicy:  ConsumptionContentBonus,
+		ReputationWeight:    types.NewDecFromRat(50, 100),
+		KeepRevisionContent: false,
+		RecentDonationsSize: 20,
+	}
 	if err := ph.setPostParam(ctx, postParam); err != nil {
 		return err
 	}
@@ -86,6 +96,13 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		StandbyInflationWeight:         int64(1),
 		MaxVotedValidators:             int64(3),
 		SlashLimitation:                int64(5),
+		MaxCommissionRate:              types.NewDecFromRat(50, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(5, 100),
+		CommissionChangeIntervalSec:    int64(24 * 3600),
+		JailDurationSec:                int64(24 * 3600),
+		ByzantineJailDurationSec:       int64(7 * 24 * 3600),
+		ByzantineSlashFraction:         types.NewDecFromRat(5, 100),
+		SignedBlocksWindow:             int64(2400), // 2 hours
 	}
 	if err := ph.setValidatorParam(ctx, validatorParam); err != nil {
 		return err
@@ -95,6 +112,10 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		MinStakeIn:                 types.NewCoinFromInt64(1000 * types.Decimals),
 		VoterCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		VoterCoinReturnTimes:       int64(7),
+		TreasurySpendDecideSec:     int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:     types.NewDecFromRat(1, 2),
+		TreasurySpendQuorum:        types.NewDecFromRat(20, 100),
+		TreasurySpendMinDeposit:    types.NewCoinFromInt64(1000 * types.Decimals),
 	}
 	if err := ph.setVoteParam(ctx, voteParam); err != nil {
 		return err
@@ -189,6 +210,9 @@ func (ph ParamHolder) InitParamFromConfig(
 		return err
 	}
 
+	if !postParam.IsValid() {
+		return fmt.Errorf("invalid post param: %+v", postParam)
+	}
 	if err := ph.setPostParam(ctx, &postParam); err != nil {
 		return err
 	}
@@ -197,6 +221,9 @@ func (ph ParamHolder) InitParamFromConfig(
 		return err
 	}
 
+	if !validatorParam.IsValid() {
+		return fmt.Errorf("invalid validator param: %+v", validatorParam)
+	}
 	if err := ph.setValidatorParam(ctx, &validatorParam); err != nil {
 		return err
 	}
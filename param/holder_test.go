@@ -39,6 +39,11 @@ func TestGlobalAllocationParam(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(1, 100),
 		DeveloperAllocation:      types.NewDecFromRat(1, 100),
 		ValidatorAllocation:      types.NewDecFromRat(97, 100),
+		InflationPolicy:          FixedRateInflation,
+		TargetStakingRatio:       types.NewDecFromRat(50, 100),
+		GrowthRateAdjustment:     types.NewDecFromRat(3, 100),
+		GrowthRateDecay:          types.NewDecFromRat(10, 100),
+		TreasuryAllocation:       types.NewDecFromRat(0, 1),
 	}
 	err := ph.setGlobalAllocationParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -80,6 +85,13 @@ func TestValidatorParam(t *testing.T) {
 		StandbyInflationWeight:         int64(1),
 		MaxVotedValidators:             int64(3),
 		SlashLimitation:                int64(5),
+		MaxCommissionRate:              types.NewDecFromRat(50, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(5, 100),
+		CommissionChangeIntervalSec:    int64(24 * 3600),
+		JailDurationSec:                int64(24 * 3600),
+		ByzantineJailDurationSec:       int64(7 * 24 * 3600),
+		ByzantineSlashFraction:         types.NewDecFromRat(5, 100),
+		SignedBlocksWindow:             int64(2400),
 	}
 	err := ph.setValidatorParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -95,6 +107,10 @@ func TestVoteParam(t *testing.T) {
 		MinStakeIn:                 types.NewCoinFromInt64(1000 * types.Decimals),
 		VoterCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		VoterCoinReturnTimes:       int64(7),
+		TreasurySpendDecideSec:     int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:     types.NewDecFromRat(1, 2),
+		TreasurySpendQuorum:        types.NewDecFromRat(20, 100),
+		TreasurySpendMinDeposit:    types.NewCoinFromInt64(1000 * types.Decimals),
 	}
 	err := ph.setVoteParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -186,6 +202,11 @@ func TestInitParam(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(85, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		InflationPolicy:          FixedRateInflation,
+		TargetStakingRatio:       types.NewDecFromRat(50, 100),
+		GrowthRateAdjustment:     types.NewDecFromRat(3, 100),
+		GrowthRateDecay:          types.NewDecFromRat(10, 100),
+		TreasuryAllocation:       types.NewDecFromRat(0, 1),
 	}
 
 	developerParam := DeveloperParam{
@@ -208,12 +229,23 @@ func TestInitParam(t *testing.T) {
 		StandbyInflationWeight:         int64(1),
 		MaxVotedValidators:             int64(3),
 		SlashLimitation:                int64(5),
+		MaxCommissionRate:              types.NewDecFromRat(50, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(5, 100),
+		CommissionChangeIntervalSec:    int64(24 * 3600),
+		JailDurationSec:                int64(24 * 3600),
+		ByzantineJailDurationSec:       int64(7 * 24 * 3600),
+		ByzantineSlashFraction:         types.NewDecFromRat(5, 100),
+		SignedBlocksWindow:             int64(2400),
 	}
 
 	voteParam := VoteParam{
 		MinStakeIn:                 types.NewCoinFromInt64(1000 * types.Decimals),
 		VoterCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		VoterCoinReturnTimes:       int64(7),
+		TreasurySpendDecideSec:     int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:     types.NewDecFromRat(1, 2),
+		TreasurySpendQuorum:        types.NewDecFromRat(20, 100),
+		TreasurySpendMinDeposit:    types.NewCoinFromInt64(1000 * types.Decimals),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -253,7 +285,11 @@ func TestInitParam(t *testing.T) {
 		MinimumBalance: types.NewCoinFromInt64(0),
 		RegisterFee:    types.NewCoinFromInt64(1 * types.Decimals),
 	}
-	postParam := PostParam{}
+	postParam := PostParam{
+		ContentBonusPolicy:  ConsumptionContentBonus,
+		ReputationWeight:    types.NewDecFromRat(50, 100),
+		RecentDonationsSize: 20,
+	}
 	repParam := ReputationParam{
 		BestContentIndexN: 200,
 		UserMaxN:          50,
@@ -279,6 +315,11 @@ func TestInitParamFromConfig(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(85, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		InflationPolicy:          FixedRateInflation,
+		TargetStakingRatio:       types.NewDecFromRat(50, 100),
+		GrowthRateAdjustment:     types.NewDecFromRat(3, 100),
+		GrowthRateDecay:          types.NewDecFromRat(10, 100),
+		TreasuryAllocation:       types.NewDecFromRat(0, 1),
 	}
 
 	developerParam := DeveloperParam{
@@ -301,12 +342,23 @@ func TestInitParamFromConfig(t *testing.T) {
 		StandbyInflationWeight:         int64(1),
 		MaxVotedValidators:             int64(3),
 		SlashLimitation:                int64(5),
+		MaxCommissionRate:              types.NewDecFromRat(50, 100),
+		MaxCommissionChangeRate:        types.NewDecFromRat(5, 100),
+		CommissionChangeIntervalSec:    int64(24 * 3600),
+		JailDurationSec:                int64(24 * 3600),
+		ByzantineJailDurationSec:       int64(7 * 24 * 3600),
+		ByzantineSlashFraction:         types.NewDecFromRat(5, 100),
+		SignedBlocksWindow:             int64(2400),
 	}
 
 	voteParam := VoteParam{
 		MinStakeIn:                 types.NewCoinFromInt64(1000 * types.Decimals),
 		VoterCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		VoterCoinReturnTimes:       int64(7),
+		TreasurySpendDecideSec:     int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:     types.NewDecFromRat(1, 2),
+		TreasurySpendQuorum:        types.NewDecFromRat(20, 100),
+		TreasurySpendMinDeposit:    types.NewCoinFromInt64(1000 * types.Decimals),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -346,7 +398,11 @@ func TestInitParamFromConfig(t *testing.T) {
 		MinimumBalance: types.NewCoinFromInt64(0),
 		RegisterFee:    types.NewCoinFromInt64(1 * types.Decimals),
 	}
-	postParam := PostParam{}
+	postParam := PostParam{
+		ContentBonusPolicy:  ConsumptionContentBonus,
+		ReputationWeight:    types.NewDecFromRat(50, 100),
+		RecentDonationsSize: 20,
+	}
 	repParam := ReputationParam{
 		BestContentIndexN: 200,
 		UserMaxN:          40,
@@ -375,6 +431,22 @@ func TestInitParamFromConfig(t *testing.T) {
 	checkStorage(t, ctx, ph, globalAllocationParam,
 		developerParam, validatorParam, voteParam,
 		proposalParam, bandwidthParam, accountParam, postParam, repParam, priceParam)
+
+	// a zero signing window disables liveness tracking.
+	validatorParam.SignedBlocksWindow = 0
+	err = ph.InitParamFromConfig(
+		ctx, globalAllocationParam,
+		postParam,
+		developerParam,
+		validatorParam,
+		voteParam,
+		proposalParam,
+		bandwidthParam,
+		accountParam,
+		repParam,
+		priceParam,
+	)
+	assert.NotNil(t, err)
 }
 
 func checkStorage(t *testing.T, ctx sdk.Context, ph ParamHolder, expectGlobalAllocationParam GlobalAllocationParam,
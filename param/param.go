@@ -9,15 +9,39 @@ import (
 // Parameter - parameter in Lino Blockchain
 type Parameter interface{}
 
+// InflationPolicy - how the annual growth rate of supply is decided.
+type InflationPolicy string
+
+const (
+	// FixedRateInflation - supply grows at GlobalGrowthRate.
+	FixedRateInflation InflationPolicy = "fixed"
+	// TargetStakingInflation - growth rate moves toward the ceiling when less than
+	// TargetStakingRatio of supply is staked in vote, and toward the floor otherwise.
+	TargetStakingInflation InflationPolicy = "target_staking"
+	// DecayingInflation - growth rate starts at GlobalGrowthRate and
+	// decays by GrowthRateDecay every year, till the floor.
+	DecayingInflation InflationPolicy = "decaying"
+)
+
 // GlobalAllocationParam - global allocation parameters
 // ContentCreatorAllocation - percentage for all content creator related allocation
 // DeveloperAllocation - percentage of inflation for developers
 // ValidatorAllocation - percentage of inflation for validators
+// InflationPolicy - policy of growth rate, empty means fixed rate
+// TargetStakingRatio - target percentage of supply staked in vote
+// GrowthRateAdjustment - max change of growth rate in one year under target staking policy
+// GrowthRateDecay - percentage of growth rate decayed every year under decaying policy
+// TreasuryAllocation - percentage of inflation for treasury, empty means zero
 type GlobalAllocationParam struct {
-	GlobalGrowthRate         sdk.Dec `json:"global_growth_rate"`
-	ContentCreatorAllocation sdk.Dec `json:"content_creator_allocation"`
-	DeveloperAllocation      sdk.Dec `json:"developer_allocation"`
-	ValidatorAllocation      sdk.Dec `json:"validator_allocation"`
+	GlobalGrowthRate         sdk.Dec         `json:"global_growth_rate"`
+	ContentCreatorAllocation sdk.Dec         `json:"content_creator_allocation"`
+	DeveloperAllocation      sdk.Dec         `json:"developer_allocation"`
+	ValidatorAllocation      sdk.Dec         `json:"validator_allocation"`
+	InflationPolicy          InflationPolicy `json:"inflation_policy"`
+	TargetStakingRatio       sdk.Dec         `json:"target_staking_ratio"`
+	GrowthRateAdjustment     sdk.Dec         `json:"growth_rate_adjustment"`
+	GrowthRateDecay          sdk.Dec         `json:"growth_rate_decay"`
+	TreasuryAllocation       sdk.Dec         `json:"treasury_allocation"`
 }
 
 func (gp GlobalAllocationParam) IsValid() bool {
@@ -25,17 +49,45 @@ func (gp GlobalAllocationParam) IsValid() bool {
 	sum = sum.Add(gp.ContentCreatorAllocation)
 	sum = sum.Add(gp.DeveloperAllocation)
 	sum = sum.Add(gp.ValidatorAllocation)
-	return sum.Equal(sdk.NewDec(1))
+	if !gp.TreasuryAllocation.IsNil() {
+		if gp.TreasuryAllocation.IsNegative() {
+			return false
+		}
+		sum = sum.Add(gp.TreasuryAllocation)
+	}
+	if !sum.Equal(sdk.NewDec(1)) {
+		return false
+	}
+	isRatio := func(d sdk.Dec) bool {
+		return !d.IsNil() && !d.IsNegative() && d.LTE(sdk.OneDec())
+	}
+	switch gp.InflationPolicy {
+	case "", FixedRateInflation:
+		return true
+	case TargetStakingInflation:
+		return isRatio(gp.TargetStakingRatio) && isRatio(gp.GrowthRateAdjustment)
+	case DecayingInflation:
+		return isRatio(gp.GrowthRateDecay)
+	}
+	return false
 }
 
 // VoteParam - vote parameters
 // MinStakeIn - minimum stake for stake in msg
 // VoterCoinReturnIntervalSec - when withdraw or revoke, the deposit return to voter by return event
 // VoterCoinReturnTimes - when withdraw or revoke, the deposit return to voter by return event
+// TreasurySpendDecideSec - seconds after treasury spend proposal created till decided
+// TreasurySpendPassRatio - approve stake over voted stake required to pass treasury spend
+// TreasurySpendQuorum - voted stake over total stake in vote required to pass treasury spend
+// TreasurySpendMinDeposit - minimum deposit to propose treasury spend
 type VoteParam struct {
 	MinStakeIn                 types.Coin `json:"min_stake_in"`
 	VoterCoinReturnIntervalSec int64      `json:"voter_coin_return_interval_second"`
 	VoterCoinReturnTimes       int64      `json:"voter_coin_return_times"`
+	TreasurySpendDecideSec     int64      `json:"treasury_spend_decide_second"`
+	TreasurySpendPassRatio     sdk.Dec    `json:"treasury_spend_pass_ratio"`
+	TreasurySpendQuorum        sdk.Dec    `json:"treasury_spend_quorum"`
+	TreasurySpendMinDeposit    types.Coin `json:"treasury_spend_min_deposit"`
 }
 
 // ProposalParam - proposal parameters
@@ -85,8 +137,8 @@ type DeveloperParam struct {
 // minus PenaltyMissCommit amount of Coin from validator deposit
 // PenaltyMissCommit - when missing block till AbsentCommitLimitation, minus PenaltyMissCommit amount of Coin from validator deposit
 // PenaltyByzantine - when validator acts as byzantine (double sign, for example),
-// minus PenaltyByzantine amount of Coin from validator deposit
-// AbsentCommitLimitation - absent block limitation till penalty
+// minus at least PenaltyByzantine amount of Coin from validator deposit
+// AbsentCommitLimitation - max missed blocks in the signing window till penalty and jail
 // OncallSize - the size of oncall validators
 // StandbySize - the size of standby validators
 // ValidatorRevokePendingSec - how many seconds before unassign validator duty
@@ -94,6 +146,14 @@ type DeveloperParam struct {
 // StandbyInflationWeight - standby validator's weight when distributing inflation
 // MaxVotedValidators - the number of max validators one voter can vote
 // SlashLimitation - slash limitation till into jail
+// MaxCommissionRate - the max share of inflation a validator can keep as commission
+// MaxCommissionChangeRate - the max change of commission rate in one update
+// CommissionChangeIntervalSec - the min interval between two commission rate updates
+// JailDurationSec - how many seconds a jailed validator must wait before unjail
+// ByzantineJailDurationSec - how many seconds a byzantine validator must wait before unjail
+// ByzantineSlashFraction - the fraction of stake slashed from byzantine validator and of votes
+// slashed from its voters
+// SignedBlocksWindow - the number of recent blocks in which missed blocks are counted
 type ValidatorParam struct {
 	ValidatorMinDeposit            types.Coin `json:"validator_min_deposit"`
 	ValidatorCoinReturnIntervalSec int64      `json:"validator_coin_return_second"`
@@ -108,6 +168,20 @@ type ValidatorParam struct {
 	StandbyInflationWeight         int64      `json:"standby_inflation_weight"`
 	MaxVotedValidators             int64      `json:"max_voted_validators"`
 	SlashLimitation                int64      `json:"slash_limitation"`
+	MaxCommissionRate              sdk.Dec    `json:"max_commission_rate"`
+	MaxCommissionChangeRate        sdk.Dec    `json:"max_commission_change_rate"`
+	CommissionChangeIntervalSec    int64      `json:"commission_change_interval_sec"`
+	JailDurationSec                int64      `json:"jail_duration_sec"`
+	ByzantineJailDurationSec       int64      `json:"byzantine_jail_duration_sec"`
+	ByzantineSlashFraction         sdk.Dec    `json:"byzantine_slash_fraction"`
+	SignedBlocksWindow             int64      `json:"signed_blocks_window"`
+}
+
+// IsValid - the signing window can not be empty, which disables liveness tracking,
+// and the missed blocks limit must be reachable within the window.
+func (vp ValidatorParam) IsValid() bool {
+	return vp.SignedBlocksWindow > 0 &&
+		vp.AbsentCommitLimitation >= 0 && vp.AbsentCommitLimitation < vp.SignedBlocksWindow
 }
 
 // BandwidthParam - bandwidth parameters
@@ -150,8 +224,42 @@ type AccountParam struct {
 	RegisterFee    types.Coin `json:"register_fee"`
 }
 
-// PostParam - empty, reserved.
+// ContentBonusPolicy - how the content bonus of a donation is weighted.
+type ContentBonusPolicy string
+
+const (
+	// ConsumptionContentBonus - weighted by the impact of the donation.
+	ConsumptionContentBonus ContentBonusPolicy = "consumption"
+	// ReputationContentBonus - weighted by a blend of the impact of the donation
+	// and the reputation of the donor.
+	ReputationContentBonus ContentBonusPolicy = "reputation"
+)
+
+// PostParam - post parameters
+// ContentBonusPolicy - policy of content bonus, empty means consumption
+// ReputationWeight - weight of donor reputation in the blend under reputation policy
+// KeepRevisionContent - keep title and content of each revision of a post,
+// besides its content hash.
+// RecentDonationsSize - number of recent donations kept for each post, 0 keeps none.
 type PostParam struct {
+	ContentBonusPolicy  ContentBonusPolicy `json:"content_bonus_policy"`
+	ReputationWeight    sdk.Dec            `json:"reputation_weight"`
+	KeepRevisionContent bool               `json:"keep_revision_content"`
+	RecentDonationsSize int64              `json:"recent_donations_size"`
+}
+
+func (pp PostParam) IsValid() bool {
+	if pp.RecentDonationsSize < 0 || pp.RecentDonationsSize > types.MaximumRecentDonationsSize {
+		return false
+	}
+	switch pp.ContentBonusPolicy {
+	case "", ConsumptionContentBonus:
+		return true
+	case ReputationContentBonus:
+		return !pp.ReputationWeight.IsNil() && !pp.ReputationWeight.IsNegative() &&
+			pp.ReputationWeight.LTE(sdk.OneDec())
+	}
+	return false
 }
 
 // ReputationParam: parameters of reputation
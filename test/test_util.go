@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -62,6 +63,9 @@ func loggerAndDB() (log.Logger, dbm.DB) {
 
 func NewTestLinoBlockchain(t *testing.T, numOfValidators int, beginBlockTime time.Time) *app.LinoBlockchain {
 	logger, db := loggerAndDB()
+	// check invariants at every block, halt on violation.
+	viper.Set(app.FlagInvCheckPeriod, 1)
+	viper.Set(app.FlagInvHalt, true)
 	lb := app.NewLinoBlockchain(logger, db, nil)
 	genesisState := app.GenesisState{
 		GenesisPools: app.GenesisPools{
@@ -69,9 +73,13 @@ func NewTestLinoBlockchain(t *testing.T, numOfValidators int, beginBlockTime tim
 				{Name: types.InflationDeveloperPool},
 				{Name: types.InflationValidatorPool},
 				{Name: types.InflationConsumptionPool},
+				{Name: types.InflationTreasuryPool},
 				{Name: types.VoteStakeInPool},
 				{Name: types.VoteStakeReturnPool},
 				{Name: types.VoteFrictionPool},
+				{Name: types.VoteTreasuryDepositPool},
+				{Name: types.PostCensorshipDepositPool},
+				{Name: types.PostDonationEscrowPool},
 				{
 					Name: types.DevIDAReservePool,
 				},
@@ -202,8 +202,9 @@ func TestFireIncompetentValidator(t *testing.T) {
 	if err != nil {
 		t.Errorf("%s: failed to get validator, got err %v", testName, err)
 	}
-	if val1.AbsentCommit != 0 {
-		t.Errorf("%s: expect 0 absent commit for val1, got %v", testName, val1.AbsentCommit)
+	// missed block is still in signing window after val1 signed.
+	if val1.AbsentCommit != 1 {
+		t.Errorf("%s: expect 1 absent commit for val1, got %v", testName, val1.AbsentCommit)
 	}
 
 	// set val0 to miss 601 times
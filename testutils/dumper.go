@@ -61,20 +61,26 @@ func NewDumper(key sdk.StoreKey, storeCdc *wire.Codec, options ...OptionCodec) *
 	}
 }
 
-func (d *Dumper) RegisterType(t interface{}, name string, subStore []byte) {
-	d.prefixes[string(subStore)] = prefixMatcher{
-		subStore,
-		func() interface{} {
-			return reflect.New(reflect.ValueOf(t).Elem().Type()).Interface()
-		},
-		false}
+// RegisterType - values under subStores are of type t.
+func (d *Dumper) RegisterType(t interface{}, name string, subStores ...[]byte) {
+	for _, subStore := range subStores {
+		d.prefixes[string(subStore)] = prefixMatcher{
+			subStore,
+			func() interface{} {
+				return reflect.New(reflect.ValueOf(t).Elem().Type()).Interface()
+			},
+			false}
+	}
 	d.dumperCdc.RegisterConcrete(t, name, nil)
 }
 
-func (d *Dumper) RegisterRawString(subStore []byte) {
-	d.prefixes[string(subStore)] = prefixMatcher{
-		prefix: subStore,
-		raw:    true,
+// RegisterRawString - values under subStores are dumped as raw strings.
+func (d *Dumper) RegisterRawString(subStores ...[]byte) {
+	for _, subStore := range subStores {
+		d.prefixes[string(subStore)] = prefixMatcher{
+			prefix: subStore,
+			raw:    true,
+		}
 	}
 }
 
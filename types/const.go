@@ -95,6 +95,22 @@ const (
 	// MaxPostContentLength - maximum length of post content
 	MaxPostContentLength = 1000
 
+	// InlineContentBytesPerMsg - post msgs are charged bandwidth as one more msg
+	// per this many bytes of inline content
+	InlineContentBytesPerMsg = 500
+
+	// MaxContentRefURILength - maximum length of content reference uri
+	MaxContentRefURILength = 256
+
+	// MaxContentRefMimeTypeLength - maximum length of content reference mime type
+	MaxContentRefMimeTypeLength = 100
+
+	// MaxTagsPerPost - maximum number of tags of a post
+	MaxTagsPerPost = 5
+
+	// MaxTagLength - maximum length of a post tag
+	MaxTagLength = 32
+
 	// MaxGranPermValiditySec - maximum validity period, 10 years
 	MaxGranPermValiditySec = 10 * 3600 * 24 * 365
 
@@ -128,6 +144,12 @@ const (
 	// MaximumNumOfLinks - maximum number of links per post
 	MaximumNumOfLinks = 10
 
+	// MaximumBatchDonateEntries - maximum number of donations in a batch donate msg
+	MaximumBatchDonateEntries = 20
+
+	// MaximumRecentDonationsSize - maximum number of recent donations kept for a post
+	MaximumRecentDonationsSize = 100
+
 	// MaximumLengthOfDeveloperWebsite - maximum length of developer website
 	MaximumLengthOfDeveloperWebsite = 100
 
@@ -164,6 +164,18 @@ const (
 	CodeNonPositiveIDAAmount  sdk.CodeType = 447
 	CodePostDeleted           sdk.CodeType = 448
 	CodeDonateAmountTooLittle sdk.CodeType = 449
+	CodeInvalidParentPermlink sdk.CodeType = 450
+	CodeReplyNotFound         sdk.CodeType = 451
+	CodeRevisionNotFound      sdk.CodeType = 452
+	CodePostCensored          sdk.CodeType = 453
+	CodeInvalidBeneficiaries  sdk.CodeType = 454
+	CodeInvalidBatchDonation  sdk.CodeType = 455
+	CodeEscrowNotFound        sdk.CodeType = 456
+	CodeRefundWindowClosed    sdk.CodeType = 457
+	CodeInvalidContentRef     sdk.CodeType = 458
+	CodeContentRefNotFound    sdk.CodeType = 459
+	CodeInvalidTags           sdk.CodeType = 460
+	CodePostIndexNotFound     sdk.CodeType = 461
 
 	// Lino validator errors reserve 500 ~ 599
 	CodeValidatorNotFound              sdk.CodeType = 500
@@ -179,6 +191,18 @@ const (
 	CodeInvalidVotedValidators         sdk.CodeType = 510
 	CodeElectionListNotFound           sdk.CodeType = 511
 	CodeInvalidValidator               sdk.CodeType = 512
+	CodeInvalidCommissionRate          sdk.CodeType = 513
+	CodeCommissionUpdateTooFrequent    sdk.CodeType = 514
+	CodeCommissionChangeTooLarge       sdk.CodeType = 515
+	CodeNoValidatorReward              sdk.CodeType = 516
+	CodeValidatorNotJailed             sdk.CodeType = 517
+	CodeUnjailTooEarly                 sdk.CodeType = 518
+	CodeValidatorPubKeyTombstoned      sdk.CodeType = 519
+	CodeKeyRotationPending             sdk.CodeType = 520
+	CodeInvalidValidatorPubKey         sdk.CodeType = 521
+	CodeInvalidVoteChanges             sdk.CodeType = 522
+	CodeInvalidLedgerTimeRange         sdk.CodeType = 523
+	CodeValidatorJailed                sdk.CodeType = 524
 
 	// Lino global errors reserve 600 ~ 699
 	CodeContentCreatorCoinConversion           sdk.CodeType = 601
@@ -209,6 +233,7 @@ const (
 	CodeFailedToParseEventCacheList            sdk.CodeType = 626
 	CodeGlobalQueryFailed                      sdk.CodeType = 627
 	CodeRegisterInvalidEvent                   sdk.CodeType = 628
+	CodeEventNotFound                          sdk.CodeType = 629
 
 	// Vote errors reserve 700 ~ 799
 	CodeVoterNotFound                  sdk.CodeType = 700
@@ -232,6 +257,10 @@ const (
 	CodeNoDuty                         sdk.CodeType = 718
 	CodeStakeStatNotFound              sdk.CodeType = 719
 	CodeNegativeFrozenAmount           sdk.CodeType = 717
+	CodeDutyNotRegistered              sdk.CodeType = 720
+	CodeInvalidTreasurySpend           sdk.CodeType = 721
+	CodeTreasurySpendNotFound          sdk.CodeType = 722
+	CodeTreasurySpendClosed            sdk.CodeType = 723
 
 	// Lino developer errors reserve 900 ~ 999
 	CodeDeveloperListNotFound          sdk.CodeType = 900
@@ -327,9 +356,11 @@ const (
 	CodeIllegalParameter                sdk.CodeType = 1116
 	CodeReasonTooLong                   sdk.CodeType = 1117
 	CodeProposalQueryFailed             sdk.CodeType = 1118
+	CodeInvalidCensorship               sdk.CodeType = 1119
 
 	// reputation errors reserve 1200 ~ 1299
 	CodeReputationQueryFailed sdk.CodeType = 1200
+	CodeShadowNotRegistered   sdk.CodeType = 1201
 
 	// bandwidth errors reserve 1300 ~ 1399
 	CodeBandwidthInfoNotFound    sdk.CodeType = 1300
@@ -18,6 +18,13 @@ type AddrMsg interface {
 	GetAccOrAddrSigners() []AccOrAddr
 }
 
+// BatchMsg - msg that carries multiple entries or large inline data, and is
+// charged bandwidth as GetBatchSize() msgs.
+type BatchMsg interface {
+	sdk.Msg
+	GetBatchSize() int64
+}
+
 // Register the lino message type
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterInterface((*Msg)(nil), nil)
@@ -5,17 +5,23 @@ const (
 	InflationDeveloperPool   PoolName = "inflation/developer"
 	InflationValidatorPool   PoolName = "inflation/validator"
 	InflationConsumptionPool PoolName = "inflation/consumption"
+	InflationTreasuryPool    PoolName = "inflation/treasury"
 
 	// account
 	AccountVestingPool PoolName = "account/vesting"
 
 	// vote
-	VoteStakeInPool     PoolName = "vote/stake-in"
-	VoteStakeReturnPool PoolName = "vote/stake-return"
-	VoteFrictionPool    PoolName = "vote/friction"
+	VoteStakeInPool         PoolName = "vote/stake-in"
+	VoteStakeReturnPool     PoolName = "vote/stake-return"
+	VoteFrictionPool        PoolName = "vote/friction"
+	VoteTreasuryDepositPool PoolName = "vote/treasury-deposit"
 
 	// developer
 	DevIDAReservePool PoolName = "dev/ida-reserve-pool"
+
+	// post
+	PostCensorshipDepositPool PoolName = "post/censorship-deposit"
+	PostDonationEscrowPool    PoolName = "post/donation-escrow"
 )
 
 func ListPools() []PoolName {
@@ -23,10 +29,14 @@ func ListPools() []PoolName {
 		InflationDeveloperPool,
 		InflationValidatorPool,
 		InflationConsumptionPool,
+		InflationTreasuryPool,
 		AccountVestingPool,
 		VoteStakeInPool,
 		VoteStakeReturnPool,
 		VoteFrictionPool,
+		VoteTreasuryDepositPool,
 		DevIDAReservePool,
+		PostCensorshipDepositPool,
+		PostDonationEscrowPool,
 	}
 }
@@ -48,6 +48,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			"supply",
 			types.QuerierRoute, types.QuerySupply,
 			0, &model.Supply{})(cdc),
+		utils.SimpleQueryCmd(
+			"mint-projection",
+			"mint-projection prints projected minting of the next 12 months",
+			types.QuerierRoute, types.QueryMintProjection,
+			0, &[]model.MintProjection{})(cdc),
 		getQueryPoolCmds(cdc),
 	)...)
 	return cmd
@@ -21,6 +21,7 @@ type AccountKeeper interface {
 	MoveToPool(
 		ctx sdk.Context, poolName types.PoolName, from types.AccOrAddr, amount types.Coin) sdk.Error
 	MoveBetweenPools(ctx sdk.Context, from, to types.PoolName, amount types.Coin) sdk.Error
+	BurnFromPool(ctx sdk.Context, poolName types.PoolName, amount types.Coin) sdk.Error
 	Mint(ctx sdk.Context) sdk.Error
 
 	DoesAccountExist(ctx sdk.Context, username types.AccountKey) bool
@@ -37,6 +38,7 @@ type AccountKeeper interface {
 	GetSequence(ctx sdk.Context, address sdk.Address) (uint64, sdk.Error)
 	GetAddress(ctx sdk.Context, username types.AccountKey) (sdk.AccAddress, sdk.Error)
 	GetSupply(ctx sdk.Context) model.Supply
+	GetMintProjection(ctx sdk.Context) ([]model.MintProjection, sdk.Error)
 	IncreaseSequenceByOne(ctx sdk.Context, address sdk.Address) sdk.Error
 	AddPending(
 		ctx sdk.Context, username types.AccountKey, amount types.Coin) sdk.Error
@@ -53,6 +55,9 @@ type AccountKeeper interface {
 	GetBankByAddress(ctx sdk.Context, addr sdk.AccAddress) (*model.AccountBank, sdk.Error)
 	GetMeta(ctx sdk.Context, username types.AccountKey) (*model.AccountMeta, sdk.Error)
 
+	// invariants
+	RegisterInvariants(ir sdk.InvariantRegistry)
+
 	// import export
 	ExportToFile(ctx sdk.Context, cdc *codec.Codec, filepath string) error
 	ImportFromFile(ctx sdk.Context, cdc *codec.Codec, filepath string) error
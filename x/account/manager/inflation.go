@@ -0,0 +1,149 @@
+package manager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lino-network/lino/param"
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+)
+
+// InflationPolicy - decides the annual growth rate of supply for every hourly mint.
+type InflationPolicy interface {
+	// GrowthRate returns the annual growth rate of the nth hour since chain start,
+	// given the rate of the previous hour and the ratio of supply staked in vote.
+	GrowthRate(nth int64, last, stakeRatio sdk.Dec) sdk.Dec
+}
+
+// NewInflationPolicy - returns the policy selected by the allocation param,
+// decayStart is the hour since chain start when the decaying policy was activated.
+func NewInflationPolicy(allocation *param.GlobalAllocationParam, decayStart int64) InflationPolicy {
+	switch allocation.InflationPolicy {
+	case param.TargetStakingInflation:
+		return targetStakingPolicy{
+			initial:    allocation.GlobalGrowthRate,
+			target:     allocation.TargetStakingRatio,
+			adjustment: allocation.GrowthRateAdjustment,
+		}
+	case param.DecayingInflation:
+		return decayingPolicy{
+			initial: allocation.GlobalGrowthRate,
+			decay:   allocation.GrowthRateDecay,
+			start:   decayStart,
+		}
+	default:
+		return fixedRatePolicy{rate: allocation.GlobalGrowthRate}
+	}
+}
+
+// fixedRatePolicy - supply always grows at the same rate.
+type fixedRatePolicy struct {
+	rate sdk.Dec
+}
+
+func (p fixedRatePolicy) GrowthRate(nth int64, last, stakeRatio sdk.Dec) sdk.Dec {
+	return p.rate
+}
+
+// targetStakingPolicy - growth rate increases when stake ratio is below target,
+// decreases when above, by at most adjustment per year, within the inflation bounds.
+type targetStakingPolicy struct {
+	initial    sdk.Dec
+	target     sdk.Dec
+	adjustment sdk.Dec
+}
+
+func (p targetStakingPolicy) GrowthRate(nth int64, last, stakeRatio sdk.Dec) sdk.Dec {
+	if last.IsNil() || last.IsZero() {
+		last = p.initial
+	}
+	// distance to target in [-1, 1], positive when under staked.
+	distance := sdk.OneDec().Neg()
+	if p.target.IsPositive() {
+		distance = sdk.OneDec().Sub(stakeRatio.Quo(p.target))
+		if distance.LT(sdk.OneDec().Neg()) {
+			distance = sdk.OneDec().Neg()
+		}
+	}
+	rate := last.Add(distance.Mul(p.adjustment).Quo(sdk.NewDec(nHourOfOneYear)))
+	return boundGrowthRate(rate)
+}
+
+// decayingPolicy - growth rate decays by a fixed percentage every year
+// since the policy was activated at the start hour.
+type decayingPolicy struct {
+	initial sdk.Dec
+	decay   sdk.Dec
+	start   int64
+}
+
+func (p decayingPolicy) GrowthRate(nth int64, last, stakeRatio sdk.Dec) sdk.Dec {
+	floor := param.AnnualInflationFloor
+	if p.initial.LT(floor) {
+		return p.initial
+	}
+	rate := p.initial
+	remain := sdk.OneDec().Sub(p.decay)
+	for year := (nth - 1 - p.start) / nHourOfOneYear; year > 0 && rate.GT(floor); year-- {
+		rate = rate.Mul(remain)
+	}
+	if rate.LT(floor) {
+		return floor
+	}
+	return rate
+}
+
+func boundGrowthRate(rate sdk.Dec) sdk.Dec {
+	if rate.GT(param.AnnualInflationCeiling) {
+		return param.AnnualInflationCeiling
+	}
+	if rate.LT(param.AnnualInflationFloor) {
+		return param.AnnualInflationFloor
+	}
+	return rate
+}
+
+// activatePolicy - record the hour when the decaying policy is activated,
+// nLast is the last minted hour.
+func activatePolicy(supply *model.Supply, allocation *param.GlobalAllocationParam, nLast int64) {
+	if allocation.InflationPolicy != param.DecayingInflation {
+		supply.Decaying = false
+		supply.DecayStartHour = 0
+		return
+	}
+	if !supply.Decaying {
+		supply.Decaying = true
+		supply.DecayStartHour = nLast
+	}
+}
+
+// mintOneHour - update supply by the nth hour of inflation, return the minted amount.
+func mintOneHour(supply *model.Supply, policy InflationPolicy, staked linotypes.Coin, nth int64) linotypes.Coin {
+	stakeRatio := sdk.ZeroDec()
+	if supply.Total.IsPositive() {
+		stakeRatio = staked.ToDec().Quo(supply.Total.ToDec())
+	}
+	rate := policy.GrowthRate(nth, supply.GrowthRate, stakeRatio)
+	minted := linotypes.DecToCoin(
+		supply.LastYearTotal.ToDec().Mul(rate).
+			Mul(linotypes.NewDecFromRat(1, nHourOfOneYear)))
+	supply.GrowthRate = rate
+	supply.Total = supply.Total.Plus(minted)
+	if nth%nHourOfOneYear == 0 {
+		supply.LastYearTotal = supply.Total
+	}
+	return minted
+}
+
+// splitMinted - split minted coins into content creator, validator, treasury and developer parts.
+func splitMinted(allocation *param.GlobalAllocationParam, minted linotypes.Coin) (
+	contentCreator, validator, treasury, developer linotypes.Coin) {
+	contentCreator = linotypes.DecToCoin(minted.ToDec().Mul(allocation.ContentCreatorAllocation))
+	validator = linotypes.DecToCoin(minted.ToDec().Mul(allocation.ValidatorAllocation))
+	treasury = linotypes.NewCoinFromInt64(0)
+	if !allocation.TreasuryAllocation.IsNil() {
+		treasury = linotypes.DecToCoin(minted.ToDec().Mul(allocation.TreasuryAllocation))
+	}
+	developer = minted.Minus(contentCreator).Minus(validator).Minus(treasury)
+	return
+}
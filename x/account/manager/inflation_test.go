@@ -0,0 +1,159 @@
+package manager
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lino-network/lino/param"
+	linotypes "github.com/lino-network/lino/types"
+)
+
+func TestInflationPolicy(t *testing.T) {
+	initial := sdk.MustNewDecFromStr("0.05")
+	hourly := sdk.MustNewDecFromStr("0.03").Quo(sdk.NewDec(nHourOfOneYear))
+	allocation := &param.GlobalAllocationParam{
+		GlobalGrowthRate:     initial,
+		TargetStakingRatio:   sdk.MustNewDecFromStr("0.5"),
+		GrowthRateAdjustment: sdk.MustNewDecFromStr("0.03"),
+		GrowthRateDecay:      sdk.MustNewDecFromStr("0.1"),
+	}
+
+	testCases := []struct {
+		testName   string
+		policy     param.InflationPolicy
+		decayStart int64
+		nth        int64
+		last       sdk.Dec
+		stakeRatio sdk.Dec
+		expected   sdk.Dec
+	}{
+		{
+			testName:   "empty policy is fixed rate",
+			policy:     "",
+			nth:        10000,
+			last:       sdk.MustNewDecFromStr("0.08"),
+			stakeRatio: sdk.ZeroDec(),
+			expected:   initial,
+		},
+		{
+			testName:   "fixed rate",
+			policy:     param.FixedRateInflation,
+			nth:        1,
+			last:       sdk.Dec{},
+			stakeRatio: sdk.OneDec(),
+			expected:   initial,
+		},
+		{
+			testName:   "target staking starts from global growth rate",
+			policy:     param.TargetStakingInflation,
+			nth:        1,
+			last:       sdk.Dec{},
+			stakeRatio: sdk.MustNewDecFromStr("0.5"),
+			expected:   initial,
+		},
+		{
+			testName:   "target staking increases when under staked",
+			policy:     param.TargetStakingInflation,
+			nth:        2,
+			last:       initial,
+			stakeRatio: sdk.ZeroDec(),
+			expected:   initial.Add(hourly),
+		},
+		{
+			testName:   "target staking decreases when over staked",
+			policy:     param.TargetStakingInflation,
+			nth:        2,
+			last:       initial,
+			stakeRatio: sdk.OneDec(),
+			expected:   initial.Sub(hourly),
+		},
+		{
+			testName:   "target staking is bounded by ceiling",
+			policy:     param.TargetStakingInflation,
+			nth:        2,
+			last:       param.AnnualInflationCeiling,
+			stakeRatio: sdk.MustNewDecFromStr("0.1"),
+			expected:   param.AnnualInflationCeiling,
+		},
+		{
+			testName:   "target staking is bounded by floor",
+			policy:     param.TargetStakingInflation,
+			nth:        2,
+			last:       param.AnnualInflationFloor,
+			stakeRatio: sdk.MustNewDecFromStr("0.9"),
+			expected:   param.AnnualInflationFloor,
+		},
+		{
+			testName:   "decaying in first year",
+			policy:     param.DecayingInflation,
+			nth:        nHourOfOneYear,
+			last:       sdk.Dec{},
+			stakeRatio: sdk.ZeroDec(),
+			expected:   initial,
+		},
+		{
+			testName:   "decaying in second year",
+			policy:     param.DecayingInflation,
+			nth:        nHourOfOneYear + 1,
+			last:       initial,
+			stakeRatio: sdk.ZeroDec(),
+			expected:   sdk.MustNewDecFromStr("0.045"),
+		},
+		{
+			testName:   "decaying till floor",
+			policy:     param.DecayingInflation,
+			nth:        10*nHourOfOneYear + 1,
+			last:       initial,
+			stakeRatio: sdk.ZeroDec(),
+			expected:   param.AnnualInflationFloor,
+		},
+		{
+			testName:   "decaying counts from activation",
+			policy:     param.DecayingInflation,
+			decayStart: 10 * nHourOfOneYear,
+			nth:        11 * nHourOfOneYear,
+			last:       initial,
+			stakeRatio: sdk.ZeroDec(),
+			expected:   initial,
+		},
+		{
+			testName:   "decaying in second year since activation",
+			policy:     param.DecayingInflation,
+			decayStart: 10 * nHourOfOneYear,
+			nth:        11*nHourOfOneYear + 1,
+			last:       initial,
+			stakeRatio: sdk.ZeroDec(),
+			expected:   sdk.MustNewDecFromStr("0.045"),
+		},
+	}
+
+	for _, tc := range testCases {
+		allocation.InflationPolicy = tc.policy
+		policy := NewInflationPolicy(allocation, tc.decayStart)
+		rate := policy.GrowthRate(tc.nth, tc.last, tc.stakeRatio)
+		assert.True(t, tc.expected.Equal(rate), "%s: expect %s, got %s", tc.testName, tc.expected, rate)
+	}
+}
+
+func TestSplitMinted(t *testing.T) {
+	minted := linotypes.NewCoinFromInt64(1000)
+	allocation := &param.GlobalAllocationParam{
+		ContentCreatorAllocation: sdk.MustNewDecFromStr("0.5"),
+		ValidatorAllocation:      sdk.MustNewDecFromStr("0.2"),
+		DeveloperAllocation:      sdk.MustNewDecFromStr("0.2"),
+		TreasuryAllocation:       sdk.MustNewDecFromStr("0.1"),
+	}
+	cc, val, treasury, dev := splitMinted(allocation, minted)
+	assert.Equal(t, linotypes.NewCoinFromInt64(500), cc)
+	assert.Equal(t, linotypes.NewCoinFromInt64(200), val)
+	assert.Equal(t, linotypes.NewCoinFromInt64(100), treasury)
+	assert.Equal(t, linotypes.NewCoinFromInt64(200), dev)
+
+	// params stored before treasury was introduced.
+	allocation.TreasuryAllocation = sdk.Dec{}
+	_, _, treasury, dev = splitMinted(allocation, minted)
+	assert.Equal(t, linotypes.NewCoinFromInt64(0), treasury)
+	assert.Equal(t, linotypes.NewCoinFromInt64(300), dev)
+}
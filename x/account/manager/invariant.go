@@ -0,0 +1,38 @@
+package manager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+	"github.com/lino-network/lino/x/account/types"
+)
+
+// RegisterInvariants - register account invariants.
+func (am AccountManager) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(types.ModuleName, "supply", am.supplyInvariant)
+}
+
+// supplyInvariant - total supply equals the sum of account savings and pools.
+// Pending coins are records of coins still in pools, not counted.
+func (am AccountManager) supplyInvariant(ctx sdk.Context) (string, bool) {
+	substores := am.storage.PartialStoreMap(ctx)
+	savings := linotypes.NewCoinFromInt64(0)
+	substores[string(model.AccountBankSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		savings = savings.Plus(val.(*model.AccountBank).Saving)
+		return false
+	})
+	pools := linotypes.NewCoinFromInt64(0)
+	substores[string(model.AccountPoolSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		pools = pools.Plus(val.(*model.Pool).Balance)
+		return false
+	})
+	total := am.storage.GetSupply(ctx).Total
+	sum := savings.Plus(pools)
+	return sdk.FormatInvariant(types.ModuleName, "supply", fmt.Sprintf(
+			"\tsupply total: %s\n\tsum of savings: %s\n\tsum of pools: %s\n",
+			total, savings, pools)),
+		!total.IsEqual(sum)
+}
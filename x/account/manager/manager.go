@@ -21,8 +21,10 @@ const (
 	// HoursPerYear - as defined by a julian year of 365.25 days
 	nHourOfOneYear = 8766
 
-	exportVersion = 3
-	importVersion = 3
+	exportVersion = 4
+	importVersion = 4
+	// fixedRateVersion - export before growth rate of supply and treasury pool.
+	fixedRateVersion = 3
 )
 
 // AccountManager - account manager
@@ -57,6 +59,7 @@ func (am AccountManager) InitGenesis(ctx sdk.Context, total linotypes.Coin, pool
 		Total:             total,
 		ChainStartTime:    ctx.BlockTime().Unix(),
 		LastInflationTime: ctx.BlockTime().Unix(),
+		GrowthRate:        sdk.ZeroDec(),
 	})
 	for _, pool := range pools {
 		am.storage.SetPool(ctx, &pool)
@@ -148,6 +151,26 @@ func (am AccountManager) MoveBetweenPools(ctx sdk.Context, from, to linotypes.Po
 	return nil
 }
 
+// BurnFromPool - remove coins in pool from the total supply.
+func (am AccountManager) BurnFromPool(ctx sdk.Context, poolName linotypes.PoolName, amount linotypes.Coin) sdk.Error {
+	if amount.IsNegative() {
+		return types.ErrNegativeMoveAmount(amount)
+	}
+	pool, err := am.storage.GetPool(ctx, poolName)
+	if err != nil {
+		return err
+	}
+	if !pool.Balance.IsGTE(amount) {
+		return types.ErrPoolNotEnough(poolName)
+	}
+	pool.Balance = pool.Balance.Minus(amount)
+	am.storage.SetPool(ctx, pool)
+	supply := am.storage.GetSupply(ctx)
+	supply.Total = supply.Total.Minus(amount)
+	am.storage.SetSupply(ctx, supply)
+	return nil
+}
+
 // Mint - distribute the inflation to pools hourly.
 func (am AccountManager) Mint(ctx sdk.Context) sdk.Error {
 	supply := am.storage.GetSupply(ctx)
@@ -166,15 +189,16 @@ func (am AccountManager) Mint(ctx sdk.Context) sdk.Error {
 	// premise: lastInflation >= chainStartTime
 	// nCurrent > nLastInflation ==> blocktime > lastInflation
 	// after: lastInflation = blocktime > chainStartTime
+	allocation := am.paramHolder.GetGlobalAllocationParam(ctx)
+	activatePolicy(supply, allocation, nLastInflation)
+	policy := NewInflationPolicy(allocation, supply.DecayStartHour)
+	staked := am.stakedInVote(ctx)
 	for nth := nLastInflation + 1; nth <= nCurrent; nth++ {
 		// mint to pools
-		err := am.hourlyMintOn(ctx, supply)
-		if err != nil {
+		minted := mintOneHour(supply, policy, staked, nth)
+		if err := am.hourlyMintOn(ctx, allocation, minted); err != nil {
 			return err
 		}
-		if nth%nHourOfOneYear == 0 {
-			supply.LastYearTotal = supply.Total
-		}
 	}
 
 	supply.LastInflationTime = blockTime
@@ -182,17 +206,10 @@ func (am AccountManager) Mint(ctx sdk.Context) sdk.Error {
 	return nil
 }
 
-// will change supply after mint and allocate to pools.
-func (am AccountManager) hourlyMintOn(ctx sdk.Context, supply *model.Supply) sdk.Error {
-	allocation := am.paramHolder.GetGlobalAllocationParam(ctx)
-	growthRate := allocation.GlobalGrowthRate
-	minted := linotypes.DecToCoin(
-		supply.LastYearTotal.ToDec().Mul(growthRate).
-			Mul(linotypes.NewDecFromRat(1, nHourOfOneYear)))
-	contentCreator := linotypes.DecToCoin(minted.ToDec().Mul(allocation.ContentCreatorAllocation))
-	validator := linotypes.DecToCoin(minted.ToDec().Mul(allocation.ValidatorAllocation))
-	developer := minted.Minus(contentCreator).Minus(validator)
-
+// allocate minted coins to inflation pools.
+func (am AccountManager) hourlyMintOn(
+	ctx sdk.Context, allocation *param.GlobalAllocationParam, minted linotypes.Coin) sdk.Error {
+	contentCreator, validator, treasury, developer := splitMinted(allocation, minted)
 	if err := am.mintToPool(ctx, linotypes.InflationConsumptionPool, contentCreator); err != nil {
 		return err
 	}
@@ -202,10 +219,63 @@ func (am AccountManager) hourlyMintOn(ctx sdk.Context, supply *model.Supply) sdk
 	if err := am.mintToPool(ctx, linotypes.InflationDeveloperPool, developer); err != nil {
 		return err
 	}
-	supply.Total = supply.Total.Plus(minted)
+	// treasury pool may not exist before it is allocated any inflation.
+	if treasury.IsPositive() {
+		if err := am.mintToPool(ctx, linotypes.InflationTreasuryPool, treasury); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// GetMintProjection - project minting of the next 12 months, month by month,
+// assuming parameters and stake in vote stay unchanged.
+func (am AccountManager) GetMintProjection(ctx sdk.Context) ([]model.MintProjection, sdk.Error) {
+	supply := am.storage.GetSupply(ctx)
+	allocation := am.paramHolder.GetGlobalAllocationParam(ctx)
+	staked := am.stakedInVote(ctx)
+
+	start := (supply.LastInflationTime - supply.ChainStartTime) / nSecOfOneHour
+	activatePolicy(supply, allocation, start)
+	policy := NewInflationPolicy(allocation, supply.DecayStartHour)
+	nth := start + 1
+	rst := make([]model.MintProjection, 0, 12)
+	for month := int64(1); month <= 12; month++ {
+		end := start + month*nHourOfOneYear/12
+		projection := model.MintProjection{
+			Month:          month,
+			EndTime:        supply.ChainStartTime + end*nSecOfOneHour,
+			Minted:         linotypes.NewCoinFromInt64(0),
+			ContentCreator: linotypes.NewCoinFromInt64(0),
+			Validator:      linotypes.NewCoinFromInt64(0),
+			Developer:      linotypes.NewCoinFromInt64(0),
+			Treasury:       linotypes.NewCoinFromInt64(0),
+		}
+		for ; nth <= end; nth++ {
+			minted := mintOneHour(supply, policy, staked, nth)
+			cc, val, treasury, dev := splitMinted(allocation, minted)
+			projection.Minted = projection.Minted.Plus(minted)
+			projection.ContentCreator = projection.ContentCreator.Plus(cc)
+			projection.Validator = projection.Validator.Plus(val)
+			projection.Developer = projection.Developer.Plus(dev)
+			projection.Treasury = projection.Treasury.Plus(treasury)
+		}
+		projection.GrowthRate = supply.GrowthRate
+		projection.Total = supply.Total
+		rst = append(rst, projection)
+	}
+	return rst, nil
+}
+
+// stakedInVote - balance of vote stake in pool, zero if pool does not exist.
+func (am AccountManager) stakedInVote(ctx sdk.Context) linotypes.Coin {
+	pool, err := am.storage.GetPool(ctx, linotypes.VoteStakeInPool)
+	if err != nil {
+		return linotypes.NewCoinFromInt64(0)
+	}
+	return pool.Balance
+}
+
 func (am AccountManager) mintToPool(ctx sdk.Context, poolName linotypes.PoolName, amount linotypes.Coin) sdk.Error {
 	pool, err := am.storage.GetPool(ctx, poolName)
 	if err != nil {
@@ -627,7 +697,11 @@ func (am AccountManager) ImportFromFile(ctx sdk.Context, cdc *codec.Codec, filep
 	}
 	table := rst.(*model.AccountTablesIR)
 
-	if table.Version != importVersion {
+	switch table.Version {
+	case fixedRateVersion:
+		am.convertAccountTablesV3(ctx, table)
+	case importVersion:
+	default:
 		return fmt.Errorf("unsupported import version: %d", table.Version)
 	}
 
@@ -680,3 +754,22 @@ func (am AccountManager) ImportFromFile(ctx sdk.Context, cdc *codec.Codec, filep
 
 	return nil
 }
+
+// convertAccountTablesV3 - supply of v3 grows at the global growth rate,
+// and pools added since then, including the treasury pool, start empty.
+func (am AccountManager) convertAccountTablesV3(ctx sdk.Context, table *model.AccountTablesIR) {
+	table.Supply.GrowthRate = am.paramHolder.GetGlobalAllocationParam(ctx).GlobalGrowthRate
+	exists := make(map[linotypes.PoolName]bool)
+	for _, pool := range table.Pools {
+		exists[pool.Name] = true
+	}
+	for _, name := range linotypes.ListPools() {
+		if !exists[name] {
+			table.Pools = append(table.Pools, model.PoolIR{
+				Name:    name,
+				Balance: linotypes.NewCoinFromInt64(0),
+			})
+		}
+	}
+	table.Version = importVersion
+}
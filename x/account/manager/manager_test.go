@@ -22,6 +22,7 @@ import (
 	"github.com/lino-network/lino/testutils"
 	"github.com/lino-network/lino/types"
 	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/utils"
 	"github.com/lino-network/lino/x/account/model"
 	acctypes "github.com/lino-network/lino/x/account/types"
 )
@@ -131,6 +132,7 @@ func (suite *AccountManagerTestSuite) TestInitGenesis() {
 		Total:             total,
 		ChainStartTime:    ctx.BlockTime().Unix(),
 		LastInflationTime: ctx.BlockTime().Unix(),
+		GrowthRate:        sdk.ZeroDec(),
 	}, supply)
 
 	pool1, err := am.GetPool(ctx, linotypes.InflationValidatorPool)
@@ -418,6 +420,65 @@ func (suite *AccountManagerTestSuite) TestBetweenPools() {
 	}
 }
 
+func (suite *AccountManagerTestSuite) TestBurnFromPool() {
+	total := linotypes.NewCoinFromInt64(2000000)
+	cases := []struct {
+		name          string
+		pool          linotypes.PoolName
+		amount        linotypes.Coin
+		expectedErr   sdk.Error
+		expectedPool  linotypes.Coin
+		expectedTotal linotypes.Coin
+	}{
+		{
+			name:        "burn negative amount",
+			pool:        linotypes.InflationValidatorPool,
+			amount:      linotypes.NewCoinFromInt64(-1),
+			expectedErr: acctypes.ErrNegativeMoveAmount(linotypes.NewCoinFromInt64(-1)),
+		},
+		{
+			name:        "pool not exists",
+			pool:        "poolnotexists",
+			amount:      linotypes.NewCoinFromInt64(1),
+			expectedErr: acctypes.ErrPoolNotFound("poolnotexists"),
+		},
+		{
+			name:        "balance not enough",
+			pool:        linotypes.InflationValidatorPool,
+			amount:      linotypes.NewCoinFromInt64(124),
+			expectedErr: acctypes.ErrPoolNotEnough(linotypes.InflationValidatorPool),
+		},
+		{
+			name:          "succ",
+			pool:          linotypes.InflationValidatorPool,
+			amount:        linotypes.NewCoinFromInt64(23),
+			expectedPool:  linotypes.NewCoinFromInt64(100),
+			expectedTotal: linotypes.NewCoinFromInt64(1999977),
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.am.InitGenesis(suite.Ctx, total, []model.Pool{
+				{
+					Name:    linotypes.InflationValidatorPool,
+					Balance: linotypes.NewCoinFromInt64(123),
+				},
+			})
+			err := suite.am.BurnFromPool(suite.Ctx, tc.pool, tc.amount)
+			suite.Equal(tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				pool, _ := suite.am.GetPool(suite.Ctx, tc.pool)
+				suite.Equal(tc.expectedPool, pool)
+				suite.Equal(tc.expectedTotal, suite.am.GetSupply(suite.Ctx).Total)
+			} else {
+				suite.Equal(total, suite.am.GetSupply(suite.Ctx).Total)
+			}
+		})
+	}
+}
+
 // test mint schedule
 func (suite *AccountManagerTestSuite) TestMint() {
 	// Genesis
@@ -546,6 +607,152 @@ func (suite *AccountManagerTestSuite) TestMint() {
 	)
 }
 
+func (suite *AccountManagerTestSuite) TestMintTargetStaking() {
+	init := int64(123)
+	suite.NextBlock(time.Unix(init, 0))
+	total := linotypes.MustLinoToCoin("10000000000")
+	suite.am.InitGenesis(suite.Ctx, total, []model.Pool{
+		{Name: linotypes.InflationValidatorPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.InflationDeveloperPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.InflationConsumptionPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.VoteStakeInPool, Balance: linotypes.MustLinoToCoin("1000000000")},
+		{Name: linotypes.AccountVestingPool, Balance: linotypes.MustLinoToCoin("9000000000")},
+	})
+	rate := sdk.MustNewDecFromStr("0.065")
+	adjustment := sdk.MustNewDecFromStr("0.03")
+	suite.ph.On("GetGlobalAllocationParam", mock.Anything).Return(
+		&parammodel.GlobalAllocationParam{
+			GlobalGrowthRate:         rate,
+			ContentCreatorAllocation: sdk.MustNewDecFromStr("0.10"),
+			DeveloperAllocation:      sdk.MustNewDecFromStr("0.75"),
+			ValidatorAllocation:      sdk.MustNewDecFromStr("0.15"),
+			InflationPolicy:          parammodel.TargetStakingInflation,
+			TargetStakingRatio:       sdk.MustNewDecFromStr("0.2"),
+			GrowthRateAdjustment:     adjustment,
+		})
+
+	// 10% staked, half of the target, rate goes up by half of adjustment per year.
+	suite.NextBlock(time.Unix(init+nSecOfOneHour, 0))
+	suite.Nil(suite.am.Mint(suite.Ctx))
+	supply := suite.am.GetSupply(suite.Ctx)
+	stakeRatio := linotypes.MustLinoToCoin("1000000000").ToDec().Quo(total.ToDec())
+	expectedRate := rate.Add(sdk.OneDec().Sub(stakeRatio.Quo(sdk.MustNewDecFromStr("0.2"))).
+		Mul(adjustment).Quo(sdk.NewDec(nHourOfOneYear)))
+	suite.Equal(expectedRate, supply.GrowthRate)
+	minted := linotypes.DecToCoin(total.ToDec().Mul(expectedRate).Mul(
+		linotypes.NewDecFromRat(1, nHourOfOneYear)))
+	suite.Equal(total.Plus(minted), supply.Total)
+	suite.True(supply.GrowthRate.GT(rate))
+}
+
+func (suite *AccountManagerTestSuite) TestMintDecayingFromActivation() {
+	init := int64(123)
+	suite.NextBlock(time.Unix(init, 0))
+	total := linotypes.MustLinoToCoin("10000000000")
+	suite.am.InitGenesis(suite.Ctx, total, []model.Pool{
+		{Name: linotypes.InflationValidatorPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.InflationDeveloperPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.InflationConsumptionPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.AccountVestingPool, Balance: total},
+	})
+	rate := sdk.MustNewDecFromStr("0.065")
+	allocation := &parammodel.GlobalAllocationParam{
+		GlobalGrowthRate:         rate,
+		ContentCreatorAllocation: sdk.MustNewDecFromStr("0.10"),
+		DeveloperAllocation:      sdk.MustNewDecFromStr("0.75"),
+		ValidatorAllocation:      sdk.MustNewDecFromStr("0.15"),
+		InflationPolicy:          parammodel.FixedRateInflation,
+		GrowthRateDecay:          sdk.MustNewDecFromStr("0.1"),
+	}
+	suite.ph.On("GetGlobalAllocationParam", mock.Anything).Return(allocation)
+
+	// two years at fixed rate.
+	t := init + 2*nHourOfOneYear*nSecOfOneHour
+	suite.NextBlock(time.Unix(t, 0))
+	suite.Nil(suite.am.Mint(suite.Ctx))
+	suite.False(suite.am.GetSupply(suite.Ctx).Decaying)
+
+	// switching to decaying does not cut the rate at once.
+	allocation.InflationPolicy = parammodel.DecayingInflation
+	t += nSecOfOneHour
+	suite.NextBlock(time.Unix(t, 0))
+	suite.Nil(suite.am.Mint(suite.Ctx))
+	supply := suite.am.GetSupply(suite.Ctx)
+	suite.True(supply.Decaying)
+	suite.Equal(int64(2*nHourOfOneYear), supply.DecayStartHour)
+	suite.Equal(rate, supply.GrowthRate)
+
+	// first year since activation keeps the rate, then decays.
+	t = init + 3*nHourOfOneYear*nSecOfOneHour
+	suite.NextBlock(time.Unix(t, 0))
+	suite.Nil(suite.am.Mint(suite.Ctx))
+	suite.Equal(rate, suite.am.GetSupply(suite.Ctx).GrowthRate)
+	t += nSecOfOneHour
+	suite.NextBlock(time.Unix(t, 0))
+	suite.Nil(suite.am.Mint(suite.Ctx))
+	suite.Equal(rate.Mul(sdk.MustNewDecFromStr("0.9")), suite.am.GetSupply(suite.Ctx).GrowthRate)
+	suite.Equal(int64(2*nHourOfOneYear), suite.am.GetSupply(suite.Ctx).DecayStartHour)
+
+	// switching away resets the activation.
+	allocation.InflationPolicy = parammodel.FixedRateInflation
+	t += nSecOfOneHour
+	suite.NextBlock(time.Unix(t, 0))
+	suite.Nil(suite.am.Mint(suite.Ctx))
+	supply = suite.am.GetSupply(suite.Ctx)
+	suite.False(supply.Decaying)
+	suite.Equal(int64(0), supply.DecayStartHour)
+	suite.Equal(rate, supply.GrowthRate)
+}
+
+func (suite *AccountManagerTestSuite) TestGetMintProjection() {
+	init := int64(123)
+	suite.NextBlock(time.Unix(init, 0))
+	total := linotypes.MustLinoToCoin("10000000000")
+	suite.am.InitGenesis(suite.Ctx, total, []model.Pool{
+		{Name: linotypes.InflationValidatorPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.InflationDeveloperPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.InflationConsumptionPool, Balance: linotypes.NewCoinFromInt64(0)},
+		{Name: linotypes.AccountVestingPool, Balance: total},
+	})
+	rate := sdk.MustNewDecFromStr("0.065")
+	suite.ph.On("GetGlobalAllocationParam", mock.Anything).Return(
+		&parammodel.GlobalAllocationParam{
+			GlobalGrowthRate:         rate,
+			ContentCreatorAllocation: sdk.MustNewDecFromStr("0.10"),
+			DeveloperAllocation:      sdk.MustNewDecFromStr("0.75"),
+			ValidatorAllocation:      sdk.MustNewDecFromStr("0.15"),
+			InflationPolicy:          parammodel.FixedRateInflation,
+		})
+
+	projections, err := suite.am.GetMintProjection(suite.Ctx)
+	suite.Nil(err)
+	suite.Require().Equal(12, len(projections))
+
+	// projection does not change state.
+	suite.Equal(total, suite.am.GetSupply(suite.Ctx).Total)
+
+	hourly := linotypes.MustLinoToCoin("74150.12548")
+	suite.Equal(int64(1), projections[0].Month)
+	suite.Equal(init+730*nSecOfOneHour, projections[0].EndTime)
+	suite.Equal(linotypes.DecToCoin(hourly.ToDec().Mul(sdk.NewDec(730))), projections[0].Minted)
+	suite.Equal(rate, projections[0].GrowthRate)
+	sum := linotypes.NewCoinFromInt64(0)
+	for _, p := range projections {
+		suite.Equal(p.Minted, p.ContentCreator.Plus(p.Validator).Plus(p.Developer))
+		sum = sum.Plus(p.Minted)
+	}
+	suite.Equal(init+nHourOfOneYear*nSecOfOneHour, projections[11].EndTime)
+	suite.Equal(total.Plus(sum), projections[11].Total)
+	suite.Equal(linotypes.MustLinoToCoin("10649999999.95768"), projections[11].Total)
+
+	// minting a month matches the projection.
+	suite.NextBlock(time.Unix(projections[0].EndTime, 0))
+	suite.Nil(suite.am.Mint(suite.Ctx))
+	suite.Equal(projections[0].Total, suite.am.GetSupply(suite.Ctx).Total)
+	vpool, _ := suite.am.GetPool(suite.Ctx, linotypes.InflationValidatorPool)
+	suite.Equal(projections[0].Validator, vpool)
+}
+
 func (suite *AccountManagerTestSuite) TestDoesAccountExist() {
 	testCases := []struct {
 		testName     string
@@ -1568,6 +1775,52 @@ func (suite *AccountManagerTestSuite) TestImportExport() {
 	suite.Golden()
 }
 
+func (suite *AccountManagerTestSuite) TestImportV3() {
+	cdc := wire.New()
+	wire.RegisterCrypto(cdc)
+
+	dir, err := ioutil.TempDir("", "test")
+	suite.Require().Nil(err)
+	defer os.RemoveAll(dir) // clean up
+
+	tmpfn := filepath.Join(dir, "tmpfile")
+	err = utils.Save(tmpfn, cdc, &model.AccountTablesIR{
+		Version: fixedRateVersion,
+		Pools: []model.PoolIR{
+			{
+				Name:    linotypes.InflationValidatorPool,
+				Balance: linotypes.NewCoinFromInt64(123),
+			},
+		},
+		Supply: model.SupplyIR{
+			LastYearTotal:     linotypes.NewCoinFromInt64(1000),
+			Total:             linotypes.NewCoinFromInt64(2000),
+			ChainStartTime:    123,
+			LastInflationTime: 456,
+		},
+	})
+	suite.Require().Nil(err)
+
+	suite.SetupCtx(0, time.Unix(0, 0), kvStoreKey)
+	suite.ph = &param.ParamKeeper{}
+	suite.am = NewAccountManager(kvStoreKey, suite.ph)
+	rate := sdk.MustNewDecFromStr("0.065")
+	suite.ph.On("GetGlobalAllocationParam", mock.Anything).Return(
+		&parammodel.GlobalAllocationParam{GlobalGrowthRate: rate})
+	suite.Nil(suite.am.ImportFromFile(suite.Ctx, cdc, tmpfn))
+
+	supply := suite.am.GetSupply(suite.Ctx)
+	suite.Equal(rate, supply.GrowthRate)
+	suite.Equal(linotypes.NewCoinFromInt64(2000), supply.Total)
+
+	validator, err2 := suite.am.GetPool(suite.Ctx, linotypes.InflationValidatorPool)
+	suite.Nil(err2)
+	suite.Equal(linotypes.NewCoinFromInt64(123), validator)
+	treasury, err2 := suite.am.GetPool(suite.Ctx, linotypes.InflationTreasuryPool)
+	suite.Nil(err2)
+	suite.Equal(linotypes.NewCoinFromInt64(0), treasury)
+}
+
 // cdc := wire.New()
 // wire.RegisterCrypto(cdc)
 // keys := make([]crypto.PubKey, 0)
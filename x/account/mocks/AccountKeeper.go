@@ -36,6 +36,22 @@ func (_m *AccountKeeper) AddPending(ctx types.Context, username linotypes.Accoun
 	return r0
 }
 
+// BurnFromPool provides a mock function with given fields: ctx, poolName, amount
+func (_m *AccountKeeper) BurnFromPool(ctx types.Context, poolName linotypes.PoolName, amount linotypes.Coin) types.Error {
+	ret := _m.Called(ctx, poolName, amount)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.PoolName, linotypes.Coin) types.Error); ok {
+		r0 = rf(ctx, poolName, amount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
 // CheckSigningPubKeyOwner provides a mock function with given fields: ctx, me, signKey
 func (_m *AccountKeeper) CheckSigningPubKeyOwner(ctx types.Context, me linotypes.AccountKey, signKey crypto.PubKey) (linotypes.AccountKey, types.Error) {
 	ret := _m.Called(ctx, me, signKey)
@@ -244,6 +260,31 @@ func (_m *AccountKeeper) GetMeta(ctx types.Context, username linotypes.AccountKe
 	return r0, r1
 }
 
+// GetMintProjection provides a mock function with given fields: ctx
+func (_m *AccountKeeper) GetMintProjection(ctx types.Context) ([]model.MintProjection, types.Error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.MintProjection
+	if rf, ok := ret.Get(0).(func(types.Context) []model.MintProjection); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.MintProjection)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context) types.Error); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetPool provides a mock function with given fields: ctx, poolName
 func (_m *AccountKeeper) GetPool(ctx types.Context, poolName linotypes.PoolName) (linotypes.Coin, types.Error) {
 	ret := _m.Called(ctx, poolName)
@@ -524,6 +565,11 @@ func (_m *AccountKeeper) RegisterAccount(ctx types.Context, referrer linotypes.A
 	return r0
 }
 
+// RegisterInvariants provides a mock function with given fields: ir
+func (_m *AccountKeeper) RegisterInvariants(ir types.InvariantRegistry) {
+	_m.Called(ir)
+}
+
 // UpdateJSONMeta provides a mock function with given fields: ctx, username, JSONMeta
 func (_m *AccountKeeper) UpdateJSONMeta(ctx types.Context, username linotypes.AccountKey, JSONMeta string) types.Error {
 	ret := _m.Called(ctx, username, JSONMeta)
@@ -33,11 +33,31 @@ type Pool struct {
 }
 
 // Supply - stats of lino supply.
+// GrowthRate - the annual growth rate used by the latest hourly mint.
+// Decaying - if the latest hourly mint used the decaying policy.
+// DecayStartHour - hours since chain start when the decaying policy was activated.
 type Supply struct {
 	LastYearTotal     types.Coin `json:"last_year_total"`
 	Total             types.Coin `json:"total"`
 	ChainStartTime    int64      `json:"chain_start_time"`
 	LastInflationTime int64      `json:"last_inflation_time"`
+	GrowthRate        sdk.Dec    `json:"growth_rate"`
+	Decaying          bool       `json:"decaying,omitempty"`
+	DecayStartHour    int64      `json:"decay_start_hour,omitempty"`
+}
+
+// MintProjection - projected minting of one month, assuming parameters
+// and stake in vote stay unchanged.
+type MintProjection struct {
+	Month          int64      `json:"month"`
+	EndTime        int64      `json:"end_time"`
+	GrowthRate     sdk.Dec    `json:"growth_rate"`
+	Minted         types.Coin `json:"minted"`
+	ContentCreator types.Coin `json:"content_creator"`
+	Validator      types.Coin `json:"validator"`
+	Developer      types.Coin `json:"developer"`
+	Treasury       types.Coin `json:"treasury"`
+	Total          types.Coin `json:"total"`
 }
 
 // AccountMeta - stores optional fields.
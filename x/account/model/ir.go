@@ -44,6 +44,9 @@ type SupplyIR struct {
 	Total             types.Coin `json:"total"`
 	ChainStartTime    int64      `json:"chain_start_time"`
 	LastInflationTime int64      `json:"last_inflation_time"`
+	GrowthRate        sdk.Dec    `json:"growth_rate"`
+	Decaying          bool       `json:"decaying,omitempty"`
+	DecayStartHour    int64      `json:"decay_start_hour,omitempty"`
 }
 
 // AccountTablesIR -
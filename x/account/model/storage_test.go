@@ -209,6 +209,7 @@ func (suite *accountStoreTestSuite) TestSupply() {
 		Total:             linotypes.NewCoinFromInt64(512354),
 		ChainStartTime:    123,
 		LastInflationTime: 3245,
+		GrowthRate:        sdk.MustNewDecFromStr("0.065"),
 	}
 
 	store.SetSupply(ctx, supply)
@@ -53,6 +53,10 @@ func NewQuerier(am AccountKeeper) sdk.Querier {
 			return utils.NewQueryResolver(0, func(args ...string) (interface{}, sdk.Error) {
 				return am.GetSupply(ctx), nil
 			})(ctx, cdc, path)
+		case types.QueryMintProjection:
+			return utils.NewQueryResolver(0, func(args ...string) (interface{}, sdk.Error) {
+				return am.GetMintProjection(ctx)
+			})(ctx, cdc, path)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown account query endpoint")
 		}
@@ -21,4 +21,5 @@ const (
 	QueryTxAndAccountSequence   = "txAndSeq"
 	QueryPool                   = "pool"
 	QuerySupply                 = "supply"
+	QueryMintProjection         = "mintProjection"
 )
@@ -30,6 +30,14 @@ func getAccOrAddrSignersFromMsg(msg sdk.Msg) []types.AccOrAddr {
 	}
 }
 
+// getBandwidthNumFromMsg allows BatchMsg to be charged as multiple msgs.
+func getBandwidthNumFromMsg(msg sdk.Msg) int64 {
+	if v, ok := msg.(types.BatchMsg); ok {
+		return v.GetBatchSize()
+	}
+	return 1
+}
+
 type msgAndSigs struct {
 	msg     sdk.Msg
 	signers []types.AccOrAddr
@@ -150,7 +158,7 @@ func validateMsg(ctx sdk.Context, am acc.AccountKeeper, bm bandwidth.BandwidthKe
 		// 4. only pay fee in the end.
 		// only the first signer pays the fee
 		if !paid {
-			if err := bm.CheckBandwidth(ctx, signerAddr, fee); err != nil {
+			if err := bm.CheckBandwidth(ctx, signerAddr, fee, getBandwidthNumFromMsg(msgSigs.msg)); err != nil {
 				return err
 			}
 		}
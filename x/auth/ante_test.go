@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -56,6 +57,16 @@ func (msg TestMsg) GetConsumeAmount() types.Coin {
 	return msg.Amount
 }
 
+// TestBatchMsg - TestMsg with entries.
+type TestBatchMsg struct {
+	TestMsg
+	Entries int64
+}
+
+var _ types.BatchMsg = TestBatchMsg{}
+
+func (msg TestBatchMsg) GetBatchSize() int64 { return msg.Entries }
+
 func newTestMsg(accKeys ...types.AccountKey) TestMsg {
 	return TestMsg{
 		Signers:    accKeys,
@@ -139,7 +150,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	})
 
 	bm := &bandwidthmock.BandwidthKeeper{}
-	bm.On("CheckBandwidth", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	bm.On("CheckBandwidth", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	anteHandler := NewAnteHandler(am, bm)
 
 	suite.am = am
@@ -381,6 +392,11 @@ func (suite *AnteTestSuite) TestMultiSig() {
 	suite.checkValidTx(tx)
 }
 
+func TestGetBandwidthNumFromMsg(t *testing.T) {
+	assert.Equal(t, int64(1), getBandwidthNumFromMsg(newTestMsg("user1")))
+	assert.Equal(t, int64(3), getBandwidthNumFromMsg(TestBatchMsg{newTestMsg("user1"), 3}))
+}
+
 func TestAnteTestSuite(t *testing.T) {
 	suite.Run(t, &AnteTestSuite{})
 }
@@ -63,7 +63,8 @@ func (bm BandwidthManager) InitGenesis(ctx sdk.Context) error {
 	return nil
 }
 
-func (bm BandwidthManager) PrecheckAndConsumeBandwidthCredit(ctx sdk.Context, accKey linotypes.AccountKey) sdk.Error {
+// PrecheckAndConsumeBandwidthCredit - consume bandwidth credit of num msgs.
+func (bm BandwidthManager) PrecheckAndConsumeBandwidthCredit(ctx sdk.Context, accKey linotypes.AccountKey, num int64) sdk.Error {
 	appBandwidthInfo, err := bm.storage.GetAppBandwidthInfo(ctx, accKey)
 	if err != nil {
 		return err
@@ -73,19 +74,21 @@ func (bm BandwidthManager) PrecheckAndConsumeBandwidthCredit(ctx sdk.Context, ac
 	if err != nil {
 		return err
 	}
-	if appBandwidthInfo.CurBandwidthCredit.LT(blockInfo.CurU) {
+	cost := blockInfo.CurU.MulInt64(num)
+	if appBandwidthInfo.CurBandwidthCredit.LT(cost) {
 		return types.ErrAppBandwidthNotEnough()
 	}
 
 	// consume u at first
-	appBandwidthInfo.CurBandwidthCredit = appBandwidthInfo.CurBandwidthCredit.Sub(blockInfo.CurU)
+	appBandwidthInfo.CurBandwidthCredit = appBandwidthInfo.CurBandwidthCredit.Sub(cost)
 	if err := bm.storage.SetAppBandwidthInfo(ctx, accKey, appBandwidthInfo); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (bm BandwidthManager) IsUserMsgFeeEnough(ctx sdk.Context, fee auth.StdFee) bool {
+// IsUserMsgFeeEnough - fee is enough for num msgs.
+func (bm BandwidthManager) IsUserMsgFeeEnough(ctx sdk.Context, fee auth.StdFee, num int64) bool {
 	if !fee.Amount.IsValid() {
 		return false
 	}
@@ -94,7 +97,7 @@ func (bm BandwidthManager) IsUserMsgFeeEnough(ctx sdk.Context, fee auth.StdFee)
 	if err != nil {
 		return false
 	}
-	return providedFee.IsGTE(info.CurMsgFee)
+	return providedFee.IsGTE(msgFee(info.CurMsgFee, num))
 }
 
 func (bm BandwidthManager) AddMsgSignedByApp(ctx sdk.Context, accKey linotypes.AccountKey, num int64) sdk.Error {
@@ -112,7 +115,7 @@ func (bm BandwidthManager) AddMsgSignedByApp(ctx sdk.Context, accKey linotypes.A
 	if err != nil {
 		return err
 	}
-	appBandwidthInfo.MessagesInCurBlock += 1
+	appBandwidthInfo.MessagesInCurBlock += num
 	if err := bm.storage.SetAppBandwidthInfo(ctx, accKey, appBandwidthInfo); err != nil {
 		return err
 	}
@@ -419,7 +422,9 @@ func (bm BandwidthManager) ReCalculateAppBandwidthInfo(ctx sdk.Context) sdk.Erro
 	return nil
 }
 
-func (bm BandwidthManager) CheckBandwidth(ctx sdk.Context, addr sdk.AccAddress, fee auth.StdFee) sdk.Error {
+// CheckBandwidth - charge the signer for a msg that counts as num msgs,
+// batch msgs count as the number of their entries.
+func (bm BandwidthManager) CheckBandwidth(ctx sdk.Context, addr sdk.AccAddress, fee auth.StdFee, num int64) sdk.Error {
 	bank, err := bm.am.GetBankByAddress(ctx, addr)
 	if err != nil {
 		return err
@@ -433,12 +438,12 @@ func (bm BandwidthManager) CheckBandwidth(ctx sdk.Context, addr sdk.AccAddress,
 			}
 
 			// app bandwidth model
-			if err := bm.PrecheckAndConsumeBandwidthCredit(ctx, appName); err != nil {
+			if err := bm.PrecheckAndConsumeBandwidthCredit(ctx, appName, num); err != nil {
 				return err
 			}
 
 			// add app message stats
-			if err := bm.AddMsgSignedByApp(ctx, appName, 1); err != nil {
+			if err := bm.AddMsgSignedByApp(ctx, appName, num); err != nil {
 				return err
 			}
 			return nil
@@ -446,7 +451,7 @@ func (bm BandwidthManager) CheckBandwidth(ctx sdk.Context, addr sdk.AccAddress,
 	}
 
 	// msg fee for general message
-	if !bm.IsUserMsgFeeEnough(ctx, fee) {
+	if !bm.IsUserMsgFeeEnough(ctx, fee, num) {
 		return types.ErrUserMsgFeeNotEnough()
 	}
 
@@ -459,19 +464,24 @@ func (bm BandwidthManager) CheckBandwidth(ctx sdk.Context, addr sdk.AccAddress,
 	//  minus msg fee
 	if !BandwidthManagerTestMode {
 		err := bm.am.MoveToPool(ctx, linotypes.InflationValidatorPool,
-			linotypes.NewAccOrAddrFromAddr(addr), info.CurMsgFee)
+			linotypes.NewAccOrAddrFromAddr(addr), msgFee(info.CurMsgFee, num))
 		if err != nil {
 			return err
 		}
 	}
 	// add general message stats
-	if err := bm.AddMsgSignedByUser(ctx, 1); err != nil {
+	if err := bm.AddMsgSignedByUser(ctx, num); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// msgFee - fee of num msgs.
+func msgFee(fee linotypes.Coin, num int64) linotypes.Coin {
+	return linotypes.NewCoin(fee.Amount.MulRaw(num))
+}
+
 func (bm BandwidthManager) BeginBlocker(ctx sdk.Context) sdk.Error {
 	// calculate the new general msg fee for the current block
 	if err := bm.CalculateCurMsgFee(ctx); err != nil {
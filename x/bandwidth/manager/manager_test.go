@@ -444,7 +444,7 @@ func (suite *BandwidthManagerTestSuite) TestIsUserMsgFeeEnough() {
 		}
 		err := suite.bm.storage.SetBlockInfo(suite.Ctx, &info)
 		suite.NoError(err)
-		res := suite.bm.IsUserMsgFeeEnough(suite.Ctx, tc.providedFee)
+		res := suite.bm.IsUserMsgFeeEnough(suite.Ctx, tc.providedFee, 1)
 		suite.Equal(tc.expectedRes, res, "%s", tc.testName)
 	}
 }
@@ -786,7 +786,7 @@ func (suite *BandwidthManagerTestSuite) TestPrecheckAndConsumeBandwidthCredit()
 		err = suite.bm.storage.SetBlockInfo(suite.Ctx, &tc.blockInfo)
 		suite.Nil(err, "%s", tc.testName)
 
-		err = suite.bm.PrecheckAndConsumeBandwidthCredit(suite.Ctx, appName)
+		err = suite.bm.PrecheckAndConsumeBandwidthCredit(suite.Ctx, appName, 1)
 		suite.Equal(tc.expectedErr, err, "%s", tc.testName)
 
 		appInfo, err := suite.bm.storage.GetAppBandwidthInfo(suite.Ctx, appName)
@@ -922,7 +922,7 @@ func (suite *BandwidthManagerTestSuite) TestCheckAppBandwidth() {
 		err := suite.bm.storage.SetAppBandwidthInfo(suite.Ctx, appY, &tc.prevAppYInfo)
 		suite.Nil(err, "%s", tc.testName)
 
-		err = suite.bm.CheckBandwidth(suite.Ctx, appYAddr, tc.fee)
+		err = suite.bm.CheckBandwidth(suite.Ctx, appYAddr, tc.fee, 1)
 		suite.Equal(tc.expectedErr, err, "%s", tc.testName)
 
 		appYInfo, err := suite.bm.storage.GetAppBandwidthInfo(suite.Ctx, appY)
@@ -1004,7 +1004,7 @@ func (suite *BandwidthManagerTestSuite) TestCheckMsgFee() {
 		err := suite.bm.storage.SetBlockInfo(suite.Ctx, &tc.blockInfo)
 		suite.Nil(err, "%s", tc.testName)
 
-		err = suite.bm.CheckBandwidth(suite.Ctx, tc.address, tc.fee)
+		err = suite.bm.CheckBandwidth(suite.Ctx, tc.address, tc.fee, 1)
 		suite.Equal(tc.expectedErr, err, "%s", tc.testName)
 
 		blockInfo, err := suite.bm.storage.GetBlockInfo(suite.Ctx)
@@ -1012,3 +1012,43 @@ func (suite *BandwidthManagerTestSuite) TestCheckMsgFee() {
 		suite.Equal(tc.expectedBlockInfo, *blockInfo, "%s", tc.testName)
 	}
 }
+
+func (suite *BandwidthManagerTestSuite) TestCheckBandwidthBatch() {
+	appYAddr := sdk.AccAddress("appYAddr")
+	appY := linotypes.AccountKey("AppY")
+	err := suite.bm.storage.SetAppBandwidthInfo(suite.Ctx, appY, &model.AppBandwidthInfo{
+		Username:           appY,
+		MaxBandwidthCredit: sdk.NewDec(800 * 10),
+		CurBandwidthCredit: sdk.NewDec(2),
+		ExpectedMPS:        sdk.NewDec(800),
+		LastRefilledAt:     suite.Ctx.BlockHeader().Time.Unix(),
+	})
+	suite.Require().Nil(err)
+	err = suite.bm.storage.SetBlockInfo(suite.Ctx, &model.BlockInfo{
+		CurMsgFee: linotypes.NewCoinFromInt64(100),
+		CurU:      sdk.NewDec(1),
+	})
+	suite.Require().Nil(err)
+
+	// app: batch of 3 needs credit of 3.
+	suite.Equal(types.ErrAppBandwidthNotEnough(), suite.bm.CheckBandwidth(suite.Ctx, appYAddr, auth.StdFee{}, 3))
+	suite.Nil(suite.bm.CheckBandwidth(suite.Ctx, appYAddr, auth.StdFee{}, 2))
+	appYInfo, err := suite.bm.storage.GetAppBandwidthInfo(suite.Ctx, appY)
+	suite.Require().Nil(err)
+	suite.Equal(sdk.NewDec(0), appYInfo.CurBandwidthCredit)
+	suite.Equal(int64(2), appYInfo.MessagesInCurBlock)
+
+	// user: batch of 3 needs 3 times msg fee.
+	userXAddr := sdk.AccAddress("userXAddr")
+	suite.Equal(types.ErrUserMsgFeeNotEnough(), suite.bm.CheckBandwidth(suite.Ctx, userXAddr,
+		auth.StdFee{Amount: sdk.NewCoins(sdk.NewCoin(linotypes.LinoCoinDenom, sdk.NewInt(299)))}, 3))
+	suite.Nil(suite.bm.CheckBandwidth(suite.Ctx, userXAddr,
+		auth.StdFee{Amount: sdk.NewCoins(sdk.NewCoin(linotypes.LinoCoinDenom, sdk.NewInt(300)))}, 3))
+	suite.am.AssertCalled(suite.T(), "MoveToPool", mock.Anything, linotypes.InflationValidatorPool,
+		linotypes.NewAccOrAddrFromAddr(userXAddr), linotypes.NewCoinFromInt64(300))
+
+	blockInfo, err := suite.bm.storage.GetBlockInfo(suite.Ctx)
+	suite.Require().Nil(err)
+	suite.Equal(int64(2), blockInfo.TotalMsgSignedByApp)
+	suite.Equal(int64(3), blockInfo.TotalMsgSignedByUser)
+}
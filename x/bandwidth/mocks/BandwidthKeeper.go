@@ -35,13 +35,13 @@ func (_m *BandwidthKeeper) BeginBlocker(ctx types.Context) types.Error {
 	return r0
 }
 
-// CheckBandwidth provides a mock function with given fields: ctx, addr, fee
-func (_m *BandwidthKeeper) CheckBandwidth(ctx types.Context, addr types.AccAddress, fee authtypes.StdFee) types.Error {
-	ret := _m.Called(ctx, addr, fee)
+// CheckBandwidth provides a mock function with given fields: ctx, addr, fee, num
+func (_m *BandwidthKeeper) CheckBandwidth(ctx types.Context, addr types.AccAddress, fee authtypes.StdFee, num int64) types.Error {
+	ret := _m.Called(ctx, addr, fee, num)
 
 	var r0 types.Error
-	if rf, ok := ret.Get(0).(func(types.Context, types.AccAddress, authtypes.StdFee) types.Error); ok {
-		r0 = rf(ctx, addr, fee)
+	if rf, ok := ret.Get(0).(func(types.Context, types.AccAddress, authtypes.StdFee, int64) types.Error); ok {
+		r0 = rf(ctx, addr, fee, num)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(types.Error)
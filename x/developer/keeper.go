@@ -46,6 +46,9 @@ type DeveloperKeeper interface {
 	// Genesis
 	InitGenesis(ctx sdk.Context, reservePoolAmount linotypes.Coin) sdk.Error
 
+	// invariants
+	RegisterInvariants(ir sdk.InvariantRegistry)
+
 	// importer exporter
 	ImportFromFile(ctx sdk.Context, cdc *codec.Codec, filepath string) error
 	ExportToFile(ctx sdk.Context, cdc *codec.Codec, filepath string) error
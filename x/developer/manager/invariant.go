@@ -0,0 +1,69 @@
+package developer
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/developer/model"
+	"github.com/lino-network/lino/x/developer/types"
+)
+
+// RegisterInvariants - register developer invariants.
+func (dm DeveloperManager) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(types.ModuleName, "reserve-pool", dm.reservePoolInvariant)
+	ir.RegisterRoute(types.ModuleName, "ida-banks", dm.idaBanksInvariant)
+}
+
+// reservePoolInvariant - coins of reserve pool are held by the reserve pool of account.
+func (dm DeveloperManager) reservePoolInvariant(ctx sdk.Context) (string, bool) {
+	pool := dm.storage.GetReservePool(ctx)
+	balance, err := dm.acc.GetPool(ctx, linotypes.DevIDAReservePool)
+	if err != nil {
+		return sdk.FormatInvariant(types.ModuleName, "reserve-pool", err.Error()), true
+	}
+	return sdk.FormatInvariant(types.ModuleName, "reserve-pool", fmt.Sprintf(
+			"\treserve pool total: %s\n\taccount pool balance: %s\n", pool.Total, balance)),
+		!pool.Total.IsEqual(balance)
+}
+
+// idaBanksInvariant - minidollar of reserve pool equals the sum of all IDA banks,
+// and IDA stats of each app equals the sum of its banks.
+func (dm DeveloperManager) idaBanksInvariant(ctx sdk.Context) (string, bool) {
+	sum := linotypes.NewMiniDollar(0)
+	apps := make(map[linotypes.AccountKey]linotypes.MiniDollar)
+	dm.storage.StoreMap(ctx)[string(model.IdaBalanceSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		app, _ := model.ParseIDABalanceKey(key)
+		bank := val.(*model.IDABank)
+		sum = sum.Plus(bank.Balance)
+		if total, ok := apps[app]; ok {
+			apps[app] = total.Plus(bank.Balance)
+		} else {
+			apps[app] = bank.Balance
+		}
+		return false
+	})
+
+	msg := ""
+	broken := false
+	pool := dm.storage.GetReservePool(ctx)
+	if !pool.TotalMiniDollar.Equal(sum) {
+		broken = true
+		msg += fmt.Sprintf("\treserve pool minidollar: %s, sum of IDA banks: %s\n", pool.TotalMiniDollar, sum)
+	}
+	dm.storage.StoreMap(ctx)[string(model.IdaStatsSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		app := linotypes.AccountKey(key)
+		stats := val.(*model.AppIDAStats)
+		total, ok := apps[app]
+		if !ok {
+			total = linotypes.NewMiniDollar(0)
+		}
+		if !stats.Total.Equal(total) {
+			broken = true
+			msg += fmt.Sprintf("\tapp %s IDA stats: %s, sum of banks: %s\n", app, stats.Total, total)
+		}
+		return false
+	})
+	return sdk.FormatInvariant(types.ModuleName, "ida-banks", msg), broken
+}
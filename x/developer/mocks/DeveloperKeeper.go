@@ -380,6 +380,11 @@ func (_m *DeveloperKeeper) RegisterDeveloper(ctx types.Context, username linotyp
 	return r0
 }
 
+// RegisterInvariants provides a mock function with given fields: ir
+func (_m *DeveloperKeeper) RegisterInvariants(ir types.InvariantRegistry) {
+	_m.Called(ir)
+}
+
 // ReportConsumption provides a mock function with given fields: ctx, username, consumption
 func (_m *DeveloperKeeper) ReportConsumption(ctx types.Context, username linotypes.AccountKey, consumption linotypes.MiniDollar) types.Error {
 	ret := _m.Called(ctx, username, consumption)
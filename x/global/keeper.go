@@ -20,6 +20,7 @@ type GlobalKeeper interface {
 
 	// module events
 	RegisterEventAtTime(ctx sdk.Context, unixTime int64, event linotypes.Event) sdk.Error
+	RemoveEventAtTime(ctx sdk.Context, unixTime int64, event linotypes.Event) sdk.Error
 	ExecuteEvents(ctx sdk.Context, exec linotypes.EventExec)
 
 	// Getter
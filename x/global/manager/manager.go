@@ -131,6 +131,25 @@ func (gm GlobalManager) RegisterEventAtTime(ctx sdk.Context, unixTime int64, eve
 	return nil
 }
 
+// RemoveEventAtTime - remove the first event registered at @p unixTime
+// that is identical to @p event.
+func (gm GlobalManager) RemoveEventAtTime(ctx sdk.Context, unixTime int64, event linotypes.Event) sdk.Error {
+	eventList := gm.storage.GetTimeEventList(ctx, unixTime)
+	for i, e := range eventList.Events {
+		if !gm.storage.IsSameEvent(e, event) {
+			continue
+		}
+		eventList.Events = append(eventList.Events[:i], eventList.Events[i+1:]...)
+		if len(eventList.Events) == 0 {
+			gm.storage.RemoveTimeEventList(ctx, unixTime)
+		} else {
+			gm.storage.SetTimeEventList(ctx, unixTime, eventList)
+		}
+		return nil
+	}
+	return types.ErrEventNotFound(unixTime)
+}
+
 func (gm GlobalManager) runEventIsolated(ctx sdk.Context, exec linotypes.EventExec, event linotypes.Event) sdk.Error {
 	cachedCtx, write := ctx.CacheContext()
 	err := exec(cachedCtx, event)
@@ -18,6 +18,7 @@ import (
 	linotypes "github.com/lino-network/lino/types"
 	mapp "github.com/lino-network/lino/x/global/manager/mocks"
 	"github.com/lino-network/lino/x/global/model"
+	"github.com/lino-network/lino/x/global/types"
 )
 
 type testEvent struct {
@@ -187,6 +188,33 @@ func (suite *globalManagerTestSuite) TestRegisterEventsAndExec() {
 	suite.Golden()
 }
 
+func (suite *globalManagerTestSuite) TestRemoveEvent() {
+	init := int64(123456)
+	suite.NextBlock(time.Unix(init, 0))
+	suite.global.InitGenesis(suite.Ctx)
+
+	suite.Nil(suite.global.RegisterEventAtTime(suite.Ctx, init+30, testEvent{Id: 1}))
+	suite.Nil(suite.global.RegisterEventAtTime(suite.Ctx, init+30, testEvent{Id: 2}))
+	suite.Nil(suite.global.RegisterEventAtTime(suite.Ctx, init+30, testEvent{Id: 1}))
+
+	// not registered.
+	suite.Equal(types.ErrEventNotFound(init+30),
+		suite.global.RemoveEventAtTime(suite.Ctx, init+30, testEvent{Id: 3}))
+	suite.Equal(types.ErrEventNotFound(init+31),
+		suite.global.RemoveEventAtTime(suite.Ctx, init+31, testEvent{Id: 1}))
+
+	// only the first identical event is removed.
+	suite.Nil(suite.global.RemoveEventAtTime(suite.Ctx, init+30, testEvent{Id: 1}))
+	suite.Equal([]linotypes.Event{testEvent{Id: 2}, testEvent{Id: 1}},
+		suite.global.storage.GetTimeEventList(suite.Ctx, init+30).Events)
+
+	suite.Nil(suite.global.RemoveEventAtTime(suite.Ctx, init+30, testEvent{Id: 2}))
+	suite.Nil(suite.global.RemoveEventAtTime(suite.Ctx, init+30, testEvent{Id: 1}))
+	suite.Nil(suite.global.storage.GetTimeEventList(suite.Ctx, init+30).Events)
+	suite.Equal(types.ErrEventNotFound(init+30),
+		suite.global.RemoveEventAtTime(suite.Ctx, init+30, testEvent{Id: 1}))
+}
+
 func (suite *globalManagerTestSuite) TestEventOKWrite() {
 	init := int64(123456)
 	suite.NextBlock(time.Unix(init, 0))
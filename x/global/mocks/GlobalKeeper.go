@@ -156,3 +156,19 @@ func (_m *GlobalKeeper) RegisterEventAtTime(ctx types.Context, unixTime int64, e
 
 	return r0
 }
+
+// RemoveEventAtTime provides a mock function with given fields: ctx, unixTime, event
+func (_m *GlobalKeeper) RemoveEventAtTime(ctx types.Context, unixTime int64, event linotypes.Event) types.Error {
+	ret := _m.Called(ctx, unixTime, event)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, int64, linotypes.Event) types.Error); ok {
+		r0 = rf(ctx, unixTime, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"bytes"
 	"strconv"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -37,6 +38,19 @@ func (gs GlobalStorage) CanEncode(event types.Event) bool {
 	return err == nil
 }
 
+// IsSameEvent - return true if two events have the same encoding.
+func (gs GlobalStorage) IsSameEvent(a, b types.Event) bool {
+	abz, err := gs.cdc.MarshalBinaryLengthPrefixed(a)
+	if err != nil {
+		return false
+	}
+	bbz, err := gs.cdc.MarshalBinaryLengthPrefixed(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(abz, bbz)
+}
+
 // GetTimeEventList - get time event list at given unix time
 func (gs GlobalStorage) GetTimeEventList(ctx sdk.Context, unixTime int64) *types.TimeEventList {
 	store := ctx.KVStore(gs.key)
@@ -32,3 +32,8 @@ func ErrRegisterInvalidEvent() sdk.Error {
 	return types.NewError(
 		types.CodeRegisterInvalidEvent, fmt.Sprintf("event is invalid, cannot be wired"))
 }
+
+// ErrEventNotFound - error when the event to remove is not registered at the time
+func ErrEventNotFound(unixTime int64) sdk.Error {
+	return types.NewError(types.CodeEventNotFound, fmt.Sprintf("event not found at time %v", unixTime))
+}
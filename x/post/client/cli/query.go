@@ -27,6 +27,62 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			"cw",
 			"cw prints the consumption competition metadata, unit: miniDollar",
 			types.QuerierRoute, types.QueryConsumptionWindow, 0, &linotypes.MiniDollar{})(cdc),
+		utils.SimpleQueryCmd(
+			"content-bonus <author> <post-id> <consumer> <evaluate>",
+			"content-bonus prints the content bonus of a reward event under each policy, evaluate unit: miniDollar",
+			types.QuerierRoute, types.QueryContentBonus, 4, &model.ContentBonusDryRun{})(cdc),
+		utils.SimpleQueryCmd(
+			"history <permlink>",
+			"history prints content hashes and donations of all revisions of the post",
+			types.QuerierRoute, types.QueryHistory, 1, &model.PostHistory{})(cdc),
+		utils.SimpleQueryCmd(
+			"post-stats <permlink>",
+			"post-stats prints donation stats of the post",
+			types.QuerierRoute, types.QueryPostStats, 1, &model.DonationStats{})(cdc),
+		utils.SimpleQueryCmd(
+			"author-stats <author>",
+			"author-stats prints donation stats of all posts of the author",
+			types.QuerierRoute, types.QueryAuthorStats, 1, &model.DonationStats{})(cdc),
+		utils.SimpleQueryCmd(
+			"donations <permlink>",
+			"donations prints recent donations of the post with their memos, newest first",
+			types.QuerierRoute, types.QueryRecentDonations, 1, &[]model.Donation{})(cdc),
+		utils.SimpleQueryCmd(
+			"escrow <id>",
+			"escrow prints the escrowed donation, released or refunded donations are not kept",
+			types.QuerierRoute, types.QueryEscrow, 1, &model.EscrowedDonation{})(cdc),
+		utils.SimpleQueryCmd(
+			"content-ref <permlink>",
+			"content-ref prints the off chain content reference of the post, fetch the content and verify its sha256 hash",
+			types.QuerierRoute, types.QueryContentRef, 1, &types.ContentRef{})(cdc),
+		utils.SimpleQueryCmd(
+			"censorship <id>", "censorship <id>",
+			types.QuerierRoute, types.QueryCensorship,
+			1, &model.Censorship{})(cdc),
+		utils.SimpleQueryCmd(
+			"censorships", "censorships",
+			types.QuerierRoute, types.QueryCensorships,
+			0, &[]model.Censorship{})(cdc),
+		utils.SimpleQueryCmd(
+			"censorship-votes <id>", "censorship-votes <id>",
+			types.QuerierRoute, types.QueryCensorshipVotes,
+			1, &[]model.CensorshipVote{})(cdc),
+		utils.SimpleQueryCmd(
+			"replies <permlink> <start> <limit>",
+			"replies prints at most limit replies of the post, starting from the start-th one",
+			types.QuerierRoute, types.QueryReplies, 3, &model.Replies{})(cdc),
+		utils.SimpleQueryCmd(
+			"posts-by-author <author> <start> <limit>",
+			"posts-by-author prints at most limit posts of the author, starting from the start-th one",
+			types.QuerierRoute, types.QueryPostsByAuthor, 3, &model.PostList{})(cdc),
+		utils.SimpleQueryCmd(
+			"posts-by-app <app> <start> <limit>",
+			"posts-by-app prints at most limit posts created by the app, starting from the start-th one",
+			types.QuerierRoute, types.QueryPostsByApp, 3, &model.PostList{})(cdc),
+		utils.SimpleQueryCmd(
+			"posts-by-tag <tag> <start> <limit>",
+			"posts-by-tag prints at most limit posts with the tag, starting from the start-th one",
+			types.QuerierRoute, types.QueryPostsByTag, 3, &model.PostList{})(cdc),
 	)...)
 	return cmd
 }
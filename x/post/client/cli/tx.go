@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,17 +15,31 @@ import (
 )
 
 const (
-	FlagAuthor  = "author"
-	FlagPostID  = "post-id"
-	FlagTitle   = "title"
-	FlagContent = "content"
-	FlagPreauth = "preauth"
+	FlagAuthor             = "author"
+	FlagPostID             = "post-id"
+	FlagTitle              = "title"
+	FlagContent            = "content"
+	FlagPreauth            = "preauth"
+	FlagParent             = "parent"
+	FlagBeneficiaries      = "beneficiaries"
+	FlagClearBeneficiaries = "clear-beneficiaries"
+	FlagContentHash        = "content-hash"
+	FlagContentURI         = "content-uri"
+	FlagContentSize        = "content-size"
+	FlagContentMime        = "content-mime"
+	FlagTags               = "tags"
 
 	FlagDonator = "donator"
 	FlagAmount  = "amount"
 	FlagMemo    = "memo"
 	FlagApp     = "app"
 	FlagSigner  = "signer"
+	FlagEntries = "entries"
+	FlagEscrow  = "escrow"
+
+	FlagDeposit = "deposit"
+	FlagReason  = "reason"
+	FlagApprove = "approve"
 )
 
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
@@ -39,6 +57,12 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdUpdatePost(cdc),
 		GetCmdDonate(cdc),
 		GetCmdIDADonate(cdc),
+		GetCmdDonateToAccount(cdc),
+		GetCmdBatchDonate(cdc),
+		GetCmdBatchIDADonate(cdc),
+		GetCmdFlag(cdc),
+		GetCmdVoteCensorship(cdc),
+		GetCmdRefundDonation(cdc),
 	)...)
 
 	return cmd
@@ -48,11 +72,15 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 func GetCmdCreatePost(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create ",
-		Short: "create <created-by> --author <author> --post-id <id> --title <title> --content <content> --preauth=true/false",
+		Short: "create <created-by> --author <author> --post-id <id> --title <title> --content <content> --preauth=true/false --parent <permlink> --beneficiaries <account:weight,...> --tags <tag,...> [--content-hash <sha256> --content-uri <uri> --content-size <bytes> --content-mime <type>]",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
 			createdBy := linotypes.AccountKey(args[0])
+			beneficiaries, err := parseBeneficiaries(viper.GetString(FlagBeneficiaries))
+			if err != nil {
+				return err
+			}
 			msg := types.CreatePostMsg{
 				Author:    linotypes.AccountKey(viper.GetString(FlagAuthor)),
 				PostID:    viper.GetString(FlagPostID),
@@ -60,6 +88,11 @@ func GetCmdCreatePost(cdc *codec.Codec) *cobra.Command {
 				Content:   viper.GetString(FlagContent),
 				CreatedBy: createdBy,
 				Preauth:   viper.GetBool(FlagPreauth),
+
+				ParentPermlink: linotypes.Permlink(viper.GetString(FlagParent)),
+				Beneficiaries:  beneficiaries,
+				ContentRef:     getContentRef(),
+				Tags:           parseTags(viper.GetString(FlagTags)),
 			}
 			return ctx.DoTxPrintResponse(msg)
 		},
@@ -69,6 +102,10 @@ func GetCmdCreatePost(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagTitle, "", "title for the post")
 	cmd.Flags().String(FlagContent, "", "content for the post")
 	cmd.Flags().Bool(FlagPreauth, false, "application(developer) that creates the post")
+	cmd.Flags().String(FlagParent, "", "permlink of the post this post replies to")
+	cmd.Flags().String(FlagTags, "", "tags of the post, e.g. music,live")
+	cmd.Flags().String(FlagBeneficiaries, "", "beneficiaries and their weights in basis points, e.g. alice:2500,bob:1000")
+	addContentRefFlags(cmd)
 	for _, v := range []string{FlagAuthor, FlagPostID, FlagPreauth} {
 		_ = cmd.MarkFlagRequired(v)
 	}
@@ -79,27 +116,86 @@ func GetCmdCreatePost(cdc *codec.Codec) *cobra.Command {
 func GetCmdUpdatePost(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update",
-		Short: "update <author> <postid> --title <title> --content <content>",
+		Short: "update <author> <postid> --title <title> --content <content> --beneficiaries <account:weight,...> --clear-beneficiaries [--content-hash <sha256> --content-uri <uri> --content-size <bytes> --content-mime <type>]",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
 			author := linotypes.AccountKey(args[0])
 			postid := args[1]
+			beneficiaries, err := parseBeneficiaries(viper.GetString(FlagBeneficiaries))
+			if err != nil {
+				return err
+			}
 
 			msg := types.UpdatePostMsg{
-				Author:  author,
-				PostID:  postid,
-				Title:   viper.GetString(FlagTitle),
-				Content: viper.GetString(FlagContent),
+				Author:             author,
+				PostID:             postid,
+				Title:              viper.GetString(FlagTitle),
+				Content:            viper.GetString(FlagContent),
+				Beneficiaries:      beneficiaries,
+				ContentRef:         getContentRef(),
+				ClearBeneficiaries: viper.GetBool(FlagClearBeneficiaries),
 			}
 			return ctx.DoTxPrintResponse(msg)
 		},
 	}
 	cmd.Flags().String(FlagTitle, "", "title for the post")
 	cmd.Flags().String(FlagContent, "", "content for the post")
+	cmd.Flags().String(FlagBeneficiaries, "", "beneficiaries and their weights in basis points, e.g. alice:2500,bob:1000, current ones are kept if empty")
+	cmd.Flags().Bool(FlagClearBeneficiaries, false, "remove all beneficiaries of the post")
+	addContentRefFlags(cmd)
 	return cmd
 }
 
+func addContentRefFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagContentHash, "", "sha256 hash in hex of the off chain content, content must be empty if set")
+	cmd.Flags().String(FlagContentURI, "", "uri of the off chain content")
+	cmd.Flags().Int64(FlagContentSize, 0, "size in bytes of the off chain content")
+	cmd.Flags().String(FlagContentMime, "", "mime type of the off chain content")
+}
+
+// getContentRef - content reference from flags, nil if no content hash is given.
+func getContentRef() *types.ContentRef {
+	hash := viper.GetString(FlagContentHash)
+	if hash == "" {
+		return nil
+	}
+	return &types.ContentRef{
+		Hash:     hash,
+		URI:      viper.GetString(FlagContentURI),
+		Size:     viper.GetInt64(FlagContentSize),
+		MimeType: viper.GetString(FlagContentMime),
+	}
+}
+
+// parseTags - parse tags in format of tag,tag.
+func parseTags(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// parseBeneficiaries - parse beneficiaries in format of account:weight,account:weight.
+func parseBeneficiaries(s string) ([]types.Beneficiary, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	rst := make([]types.Beneficiary, 0)
+	for _, v := range strings.Split(s, ",") {
+		kv := strings.Split(v, ":")
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid beneficiary: %s", v)
+		}
+		weight, err := strconv.ParseInt(kv[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of beneficiary %s: %s", kv[0], err)
+		}
+		rst = append(rst, types.Beneficiary{Account: linotypes.AccountKey(kv[0]), Weight: weight})
+	}
+	return rst, nil
+}
+
 // GetCmdDeletePost -
 func GetCmdDeletePost(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -124,7 +220,7 @@ func GetCmdDeletePost(cdc *codec.Codec) *cobra.Command {
 func GetCmdDonate(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "donate",
-		Short: "donate <donator> --amount <amount> --author <author> --post-id <id> --app <app> --memo <memo>",
+		Short: "donate <donator> --amount <amount> --author <author> --post-id <id> --app <app> --memo <memo> --escrow",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
@@ -136,6 +232,7 @@ func GetCmdDonate(cdc *codec.Codec) *cobra.Command {
 				PostID:   viper.GetString(FlagPostID),
 				FromApp:  linotypes.AccountKey(viper.GetString(FlagApp)),
 				Memo:     viper.GetString(FlagMemo),
+				Escrow:   viper.GetBool(FlagEscrow),
 			}
 			return ctx.DoTxPrintResponse(msg)
 		},
@@ -145,12 +242,42 @@ func GetCmdDonate(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagAmount, "", "amount of the donation")
 	cmd.Flags().String(FlagMemo, "", "memo of this donation")
 	cmd.Flags().String(FlagApp, "", "donation comes from app")
+	cmd.Flags().Bool(FlagEscrow, false, "hold the donation in escrow, refundable by the app until its reward event")
 	for _, v := range []string{FlagAuthor, FlagPostID, FlagAmount} {
 		_ = cmd.MarkFlagRequired(v)
 	}
 	return cmd
 }
 
+// GetCmdDonateToAccount - donate to the channel of an author.
+func GetCmdDonateToAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "donate-to-account",
+		Short: "donate-to-account <donator> --amount <amount> --author <author> --app <app> --memo <memo>",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			from := linotypes.AccountKey(args[0])
+			msg := types.DonateToAccountMsg{
+				Username: from,
+				Amount:   viper.GetString(FlagAmount),
+				Author:   linotypes.AccountKey(viper.GetString(FlagAuthor)),
+				FromApp:  linotypes.AccountKey(viper.GetString(FlagApp)),
+				Memo:     viper.GetString(FlagMemo),
+			}
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().String(FlagAuthor, "", "author to donate to")
+	cmd.Flags().String(FlagAmount, "", "amount of the donation")
+	cmd.Flags().String(FlagMemo, "", "memo of this donation")
+	cmd.Flags().String(FlagApp, "", "donation comes from app")
+	for _, v := range []string{FlagAuthor, FlagAmount} {
+		_ = cmd.MarkFlagRequired(v)
+	}
+	return cmd
+}
+
 // GetCmdIDADonate -
 func GetCmdIDADonate(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -183,3 +310,157 @@ func GetCmdIDADonate(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+// GetCmdBatchDonate -
+func GetCmdBatchDonate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-donate",
+		Short: "batch-donate <donator> --entries <author#post-id:amount,...> --app <app> --memo <memo>",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			entries, err := parseDonateEntries(viper.GetString(FlagEntries))
+			if err != nil {
+				return err
+			}
+			msg := types.BatchDonateMsg{
+				Username: linotypes.AccountKey(args[0]),
+				FromApp:  linotypes.AccountKey(viper.GetString(FlagApp)),
+				Entries:  entries,
+				Memo:     viper.GetString(FlagMemo),
+			}
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().String(FlagEntries, "", "donations in format of author#post-id:amount, separated by comma")
+	cmd.Flags().String(FlagMemo, "", "memo of this donation")
+	cmd.Flags().String(FlagApp, "", "donation comes from app")
+	_ = cmd.MarkFlagRequired(FlagEntries)
+	return cmd
+}
+
+// GetCmdBatchIDADonate -
+func GetCmdBatchIDADonate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-ida-donate",
+		Short: "batch-ida-donate <signer> --entries <author#post-id:amount,...> --app <app> --memo <memo> --donator <donator>",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			entries, err := parseDonateEntries(viper.GetString(FlagEntries))
+			if err != nil {
+				return err
+			}
+			msg := types.BatchIDADonateMsg{
+				Username: linotypes.AccountKey(viper.GetString(FlagDonator)),
+				App:      linotypes.AccountKey(viper.GetString(FlagApp)),
+				Entries:  entries,
+				Memo:     viper.GetString(FlagMemo),
+				Signer:   linotypes.AccountKey(args[0]),
+			}
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().String(FlagDonator, "", "donator of this transaction")
+	cmd.Flags().String(FlagEntries, "", "donations in format of author#post-id:amount, separated by comma")
+	cmd.Flags().String(FlagMemo, "", "memo of this donation")
+	cmd.Flags().String(FlagApp, "", "App's IDA")
+	for _, v := range []string{FlagDonator, FlagEntries, FlagApp} {
+		_ = cmd.MarkFlagRequired(v)
+	}
+	return cmd
+}
+
+// parseDonateEntries - parse donations in format of author#post-id:amount,author#post-id:amount.
+func parseDonateEntries(s string) ([]types.DonateEntry, error) {
+	rst := make([]types.DonateEntry, 0)
+	for _, v := range strings.Split(s, ",") {
+		idx := strings.LastIndex(v, ":")
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid donation: %s", v)
+		}
+		target := strings.SplitN(v[:idx], "#", 2)
+		if len(target) != 2 {
+			return nil, fmt.Errorf("invalid permlink of donation: %s", v)
+		}
+		rst = append(rst, types.DonateEntry{
+			Author: linotypes.AccountKey(target[0]),
+			PostID: target[1],
+			Amount: v[idx+1:],
+		})
+	}
+	return rst, nil
+}
+
+// GetCmdFlag -
+func GetCmdFlag(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flag",
+		Short: "flag <flagger> <permlink> --deposit <lino> --reason <reason>",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			msg := types.FlagPostMsg{
+				Flagger:  linotypes.AccountKey(args[0]),
+				Permlink: linotypes.Permlink(args[1]),
+				Deposit:  viper.GetString(FlagDeposit),
+				Reason:   viper.GetString(FlagReason),
+			}
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().String(FlagDeposit, "", "deposit of the censorship")
+	cmd.Flags().String(FlagReason, "", "reason of the censorship")
+	_ = cmd.MarkFlagRequired(FlagDeposit)
+	return cmd
+}
+
+// GetCmdVoteCensorship -
+func GetCmdVoteCensorship(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-censorship",
+		Short: "vote-censorship <voter> <id> --approve=<true|false>",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			id, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.VoteCensorshipMsg{
+				Voter:   linotypes.AccountKey(args[0]),
+				ID:      id,
+				Approve: viper.GetBool(FlagApprove),
+			}
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().Bool(FlagApprove, false, "approve or reject the censorship")
+	_ = cmd.MarkFlagRequired(FlagApprove)
+	return cmd
+}
+
+// GetCmdRefundDonation - refund an escrowed donation of the app.
+func GetCmdRefundDonation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-donation",
+		Short: "refund-donation <signer> <id> --app <app>",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			id, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.RefundDonationMsg{
+				App:    linotypes.AccountKey(viper.GetString(FlagApp)),
+				ID:     id,
+				Signer: linotypes.AccountKey(args[0]),
+			}
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().String(FlagApp, "", "app of the escrowed donation")
+	_ = cmd.MarkFlagRequired(FlagApp)
+	return cmd
+}
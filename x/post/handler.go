@@ -15,6 +15,12 @@ type UpdatePostMsg = types.UpdatePostMsg
 type DeletePostMsg = types.DeletePostMsg
 type DonateMsg = types.DonateMsg
 type IDADonateMsg = types.IDADonateMsg
+type DonateToAccountMsg = types.DonateToAccountMsg
+type BatchDonateMsg = types.BatchDonateMsg
+type BatchIDADonateMsg = types.BatchIDADonateMsg
+type FlagPostMsg = types.FlagPostMsg
+type VoteCensorshipMsg = types.VoteCensorshipMsg
+type RefundDonationMsg = types.RefundDonationMsg
 
 // NewHandler - Handle all "post" type messages.
 func NewHandler(pm PostKeeper) sdk.Handler {
@@ -30,6 +36,18 @@ func NewHandler(pm PostKeeper) sdk.Handler {
 			return handleDonateMsg(ctx, msg, pm)
 		case IDADonateMsg:
 			return handleIDADonateMsg(ctx, msg, pm)
+		case DonateToAccountMsg:
+			return handleDonateToAccountMsg(ctx, msg, pm)
+		case BatchDonateMsg:
+			return handleBatchDonateMsg(ctx, msg, pm)
+		case BatchIDADonateMsg:
+			return handleBatchIDADonateMsg(ctx, msg, pm)
+		case FlagPostMsg:
+			return handleFlagPostMsg(ctx, msg, pm)
+		case VoteCensorshipMsg:
+			return handleVoteCensorshipMsg(ctx, msg, pm)
+		case RefundDonationMsg:
+			return handleRefundDonationMsg(ctx, msg, pm)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized post msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -39,7 +57,7 @@ func NewHandler(pm PostKeeper) sdk.Handler {
 
 // Handle createPostMsg
 func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostKeeper) sdk.Result {
-	err := pm.CreatePost(ctx, msg.Author, msg.PostID, msg.CreatedBy, msg.Content, msg.Title)
+	err := pm.CreatePost(ctx, msg.Author, msg.PostID, msg.CreatedBy, msg.Content, msg.Title, msg.ParentPermlink, msg.Beneficiaries, msg.ContentRef, msg.Tags)
 	if err != nil {
 		return err.Result()
 	}
@@ -47,7 +65,7 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostKeeper) sdk.
 }
 
 func handleUpdatePostMsg(ctx sdk.Context, msg UpdatePostMsg, pm PostKeeper) sdk.Result {
-	err := pm.UpdatePost(ctx, msg.Author, msg.PostID, msg.Title, msg.Content)
+	err := pm.UpdatePost(ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.GetBeneficiaries(), msg.ContentRef)
 	if err != nil {
 		return err.Result()
 	}
@@ -68,7 +86,23 @@ func handleDonateMsg(ctx sdk.Context, msg DonateMsg, pm PostKeeper) sdk.Result {
 	if err != nil {
 		return err.Result()
 	}
-	err = pm.LinoDonate(ctx, msg.Username, amount, msg.Author, msg.PostID, msg.FromApp)
+	if msg.Escrow {
+		_, err = pm.EscrowLinoDonate(ctx, msg.Username, amount, msg.Author, msg.PostID, msg.FromApp, msg.Memo)
+	} else {
+		err = pm.LinoDonate(ctx, msg.Username, amount, msg.Author, msg.PostID, msg.FromApp, msg.Memo)
+	}
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleDonateToAccountMsg(ctx sdk.Context, msg DonateToAccountMsg, pm PostKeeper) sdk.Result {
+	amount, err := linotypes.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	err = pm.DonateToAccount(ctx, msg.Username, amount, msg.Author, msg.FromApp, msg.Memo)
 	if err != nil {
 		return err.Result()
 	}
@@ -81,9 +115,50 @@ func handleIDADonateMsg(ctx sdk.Context, msg IDADonateMsg, pm PostKeeper) sdk.Re
 	if err != nil {
 		return err.Result()
 	}
-	err = pm.IDADonate(ctx, msg.Username, amount, msg.Author, msg.PostID, msg.App, msg.Signer)
+	err = pm.IDADonate(ctx, msg.Username, amount, msg.Author, msg.PostID, msg.App, msg.Signer, msg.Memo)
 	if err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
 }
+
+func handleBatchDonateMsg(ctx sdk.Context, msg BatchDonateMsg, pm PostKeeper) sdk.Result {
+	err := pm.BatchLinoDonate(ctx, msg.Username, msg.Entries, msg.FromApp, msg.Memo)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleBatchIDADonateMsg(ctx sdk.Context, msg BatchIDADonateMsg, pm PostKeeper) sdk.Result {
+	err := pm.BatchIDADonate(ctx, msg.Username, msg.Entries, msg.App, msg.Signer, msg.Memo)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleFlagPostMsg(ctx sdk.Context, msg FlagPostMsg, pm PostKeeper) sdk.Result {
+	deposit, err := linotypes.LinoToCoin(msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+	if _, err := pm.FlagPost(ctx, msg.Flagger, msg.Permlink, deposit, msg.Reason); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleVoteCensorshipMsg(ctx sdk.Context, msg VoteCensorshipMsg, pm PostKeeper) sdk.Result {
+	if err := pm.VoteCensorship(ctx, msg.Voter, msg.ID, msg.Approve); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleRefundDonationMsg(ctx sdk.Context, msg RefundDonationMsg, pm PostKeeper) sdk.Result {
+	if err := pm.RefundDonation(ctx, msg.App, msg.Signer, msg.ID); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
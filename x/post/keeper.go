@@ -15,15 +15,37 @@ import (
 type PostKeeper interface {
 	DoesPostExist(ctx sdk.Context, permlink linotypes.Permlink) bool
 	GetPost(ctx sdk.Context, permlink linotypes.Permlink) (model.Post, sdk.Error)
-	CreatePost(ctx sdk.Context, author linotypes.AccountKey, postID string, createdBy linotypes.AccountKey, content string, title string) sdk.Error
-	UpdatePost(ctx sdk.Context, author linotypes.AccountKey, postID, title, content string) sdk.Error
+	CreatePost(ctx sdk.Context, author linotypes.AccountKey, postID string, createdBy linotypes.AccountKey, content string, title string, parent linotypes.Permlink, beneficiaries []types.Beneficiary, ref *types.ContentRef, tags []string) sdk.Error
+	UpdatePost(ctx sdk.Context, author linotypes.AccountKey, postID, title, content string, beneficiaries []types.Beneficiary, ref *types.ContentRef) sdk.Error
 	DeletePost(ctx sdk.Context, permlink linotypes.Permlink) sdk.Error
-	LinoDonate(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey) sdk.Error
-	IDADonate(ctx sdk.Context, from linotypes.AccountKey, n linotypes.MiniIDA, author linotypes.AccountKey, postID string, app, signer linotypes.AccountKey) sdk.Error
+	LinoDonate(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey, memo string) sdk.Error
+	IDADonate(ctx sdk.Context, from linotypes.AccountKey, n linotypes.MiniIDA, author linotypes.AccountKey, postID string, app, signer linotypes.AccountKey, memo string) sdk.Error
+	DonateToAccount(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, app linotypes.AccountKey, memo string) sdk.Error
+	BatchLinoDonate(ctx sdk.Context, from linotypes.AccountKey, entries []types.DonateEntry, app linotypes.AccountKey, memo string) sdk.Error
+	BatchIDADonate(ctx sdk.Context, from linotypes.AccountKey, entries []types.DonateEntry, app, signer linotypes.AccountKey, memo string) sdk.Error
+	EscrowLinoDonate(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey, memo string) (int64, sdk.Error)
+	RefundDonation(ctx sdk.Context, app, signer linotypes.AccountKey, id int64) sdk.Error
 	ExecRewardEvent(ctx sdk.Context, reward types.RewardEvent) sdk.Error
+	FlagPost(ctx sdk.Context, flagger linotypes.AccountKey, permlink linotypes.Permlink, deposit linotypes.Coin, reason string) (int64, sdk.Error)
+	VoteCensorship(ctx sdk.Context, voter linotypes.AccountKey, id int64, approve bool) sdk.Error
+	ExecDecideCensorshipEvent(ctx sdk.Context, event types.DecideCensorshipEvent) sdk.Error
 
 	// querier
 	GetComsumptionWindow(ctx sdk.Context) linotypes.MiniDollar
+	GetContentBonusDryRun(ctx sdk.Context, event types.RewardEvent) (*model.ContentBonusDryRun, sdk.Error)
+	GetHistory(ctx sdk.Context, permlink linotypes.Permlink) (*model.PostHistory, sdk.Error)
+	GetReplies(ctx sdk.Context, permlink linotypes.Permlink, start, limit int64) (*model.Replies, sdk.Error)
+	GetContentRef(ctx sdk.Context, permlink linotypes.Permlink) (*types.ContentRef, sdk.Error)
+	GetPostsByAuthor(ctx sdk.Context, author linotypes.AccountKey, start, limit int64) (*model.PostList, sdk.Error)
+	GetPostsByApp(ctx sdk.Context, app linotypes.AccountKey, start, limit int64) (*model.PostList, sdk.Error)
+	GetPostsByTag(ctx sdk.Context, tag string, start, limit int64) (*model.PostList, sdk.Error)
+	GetCensorship(ctx sdk.Context, id int64) (*model.Censorship, sdk.Error)
+	GetCensorships(ctx sdk.Context) []model.Censorship
+	GetCensorshipVotes(ctx sdk.Context, id int64) ([]model.CensorshipVote, sdk.Error)
+	GetPostStats(ctx sdk.Context, permlink linotypes.Permlink) (*model.DonationStats, sdk.Error)
+	GetAuthorStats(ctx sdk.Context, author linotypes.AccountKey) (*model.DonationStats, sdk.Error)
+	GetRecentDonations(ctx sdk.Context, permlink linotypes.Permlink) ([]model.Donation, sdk.Error)
+	GetEscrowedDonation(ctx sdk.Context, id int64) (*model.EscrowedDonation, sdk.Error)
 
 	ImportFromFile(ctx sdk.Context, cdc *codec.Codec, filepath string) error
 	ExportToFile(ctx sdk.Context, cdc *codec.Codec, filepath string) error
@@ -0,0 +1,103 @@
+package manager
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	linotypes "github.com/lino-network/lino/types"
+	types "github.com/lino-network/lino/x/post/types"
+)
+
+func (suite *PostManagerTestSuite) TestBatchLinoDonate() {
+	from := suite.user2
+	author := suite.user1
+	app := suite.app1
+	for _, postID := range []string{"post1", "post2"} {
+		err := suite.pm.CreatePost(suite.Ctx, author, postID, author, "content", "title", "", nil, nil, nil)
+		suite.Require().Nil(err)
+	}
+	suite.price.On("CoinToMiniDollar", mock.Anything, mock.Anything).Return(linotypes.NewMiniDollar(1000), nil)
+	suite.rep.On("DonateAt", mock.Anything, from, mock.Anything, mock.Anything).Return(
+		linotypes.NewMiniDollar(10), nil)
+	suite.vote.On("RecordFriction", mock.Anything, mock.Anything).Return(nil)
+	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), mock.Anything).Return(nil)
+	suite.am.On("MoveCoin", mock.Anything, linotypes.NewAccOrAddrFromAcc(from),
+		linotypes.NewAccOrAddrFromAcc(author), mock.Anything).Return(nil)
+	suite.global.On("RegisterEventAtTime", mock.Anything,
+		int64(linotypes.ConsumptionFreezingPeriodSec), mock.Anything).Return(nil)
+
+	// second entry fails, nothing is recorded.
+	err := suite.pm.BatchLinoDonate(suite.Ctx, from, []types.DonateEntry{
+		{Author: author, PostID: "post1", Amount: "1"},
+		{Author: author, PostID: "notexist", Amount: "1"},
+	}, app, "")
+	suite.Equal(types.ErrPostNotFound(linotypes.GetPermlink(author, "notexist")), err)
+	stats, err := suite.pm.GetAuthorStats(suite.Ctx, author)
+	suite.Require().Nil(err)
+	suite.Equal(int64(0), stats.NumDonations)
+	suite.Equal(linotypes.NewMiniDollar(0), suite.pm.GetComsumptionWindow(suite.Ctx))
+
+	// each entry has its own friction and reward event.
+	err = suite.pm.BatchLinoDonate(suite.Ctx, from, []types.DonateEntry{
+		{Author: author, PostID: "post1", Amount: "1"},
+		{Author: author, PostID: "post2", Amount: "2"},
+	}, app, "")
+	suite.Nil(err)
+	for _, postID := range []string{"post1", "post2"} {
+		suite.global.AssertCalled(suite.T(), "RegisterEventAtTime", mock.Anything,
+			int64(linotypes.ConsumptionFreezingPeriodSec),
+			types.RewardEvent{
+				PostAuthor: author,
+				PostID:     postID,
+				Consumer:   from,
+				Evaluate:   linotypes.NewMiniDollar(10),
+				FromApp:    app,
+			})
+	}
+	suite.am.AssertCalled(suite.T(), "MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), linotypes.DecToCoin(linotypes.NewCoinFromInt64(100000).ToDec().Mul(suite.rate)))
+	suite.am.AssertCalled(suite.T(), "MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), linotypes.DecToCoin(linotypes.NewCoinFromInt64(200000).ToDec().Mul(suite.rate)))
+	stats, err = suite.pm.GetAuthorStats(suite.Ctx, author)
+	suite.Require().Nil(err)
+	suite.Equal(int64(2), stats.NumDonations)
+	suite.Equal(linotypes.NewCoinFromInt64(300000), stats.LinoDonations)
+	suite.Equal(linotypes.NewMiniDollar(20), suite.pm.GetComsumptionWindow(suite.Ctx))
+}
+
+func (suite *PostManagerTestSuite) TestBatchIDADonate() {
+	from := suite.user2
+	author := suite.user1
+	app := suite.app1
+	for _, postID := range []string{"post1", "post2"} {
+		err := suite.pm.CreatePost(suite.Ctx, author, postID, author, "content", "title", "", nil, nil, nil)
+		suite.Require().Nil(err)
+	}
+	suite.dev.On("BurnIDA", mock.Anything, app, from, mock.Anything).Return(linotypes.NewCoinFromInt64(78), nil)
+	suite.rep.On("DonateAt", mock.Anything, from, mock.Anything, mock.Anything).Return(
+		linotypes.NewMiniDollar(10), nil)
+	suite.vote.On("RecordFriction", mock.Anything, mock.Anything).Return(nil)
+	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), mock.Anything).Return(nil)
+	suite.dev.On("MoveIDA", mock.Anything, app, from, author, mock.Anything).Return(nil)
+	suite.global.On("RegisterEventAtTime", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// signer must be affiliated with app.
+	err := suite.pm.BatchIDADonate(suite.Ctx, from, []types.DonateEntry{
+		{Author: author, PostID: "post1", Amount: "20"},
+	}, app, suite.app2affiliated, "memo")
+	suite.Equal(types.ErrInvalidSigner(), err)
+
+	err = suite.pm.BatchIDADonate(suite.Ctx, from, []types.DonateEntry{
+		{Author: author, PostID: "post1", Amount: "20"},
+		{Author: author, PostID: "post2", Amount: "10"},
+	}, app, suite.app1affiliated, "memo")
+	suite.Nil(err)
+	suite.dev.AssertNumberOfCalls(suite.T(), "MoveIDA", 2)
+	suite.global.AssertNumberOfCalls(suite.T(), "RegisterEventAtTime", 2)
+	for _, postID := range []string{"post1", "post2"} {
+		stats, err := suite.pm.GetPostStats(suite.Ctx, linotypes.GetPermlink(author, postID))
+		suite.Require().Nil(err)
+		suite.Equal(int64(1), stats.NumDonations)
+	}
+}
@@ -0,0 +1,175 @@
+package manager
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	linotypes "github.com/lino-network/lino/types"
+	types "github.com/lino-network/lino/x/post/types"
+)
+
+func (suite *PostManagerTestSuite) TestPostBeneficiaries() {
+	author := suite.user1
+	postID := "post1"
+	permlink := linotypes.GetPermlink(author, postID)
+	beneficiaries := []types.Beneficiary{
+		{Account: suite.app2, Weight: 2500},
+		{Account: suite.app3, Weight: 1000},
+	}
+
+	err := suite.pm.CreatePost(suite.Ctx, author, postID, author, "content", "title", "",
+		[]types.Beneficiary{{Account: suite.unreg1, Weight: 2500}}, nil, nil)
+	suite.Equal(types.ErrAccountNotFound(suite.unreg1), err)
+	err = suite.pm.CreatePost(suite.Ctx, author, postID, author, "content", "title", "",
+		[]types.Beneficiary{{Account: author, Weight: 2500}}, nil, nil)
+	suite.Equal(types.ErrInvalidBeneficiaries("author can not be a beneficiary"), err)
+	err = suite.pm.CreatePost(suite.Ctx, author, postID, author, "content", "title", "", beneficiaries, nil, nil)
+	suite.Require().Nil(err)
+	post, err := suite.pm.GetPost(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	suite.Equal(beneficiaries, post.Beneficiaries)
+
+	// update replaces beneficiaries, nil keeps them and empty removes them.
+	err = suite.pm.UpdatePost(suite.Ctx, author, postID, "title", "content",
+		[]types.Beneficiary{{Account: suite.unreg1, Weight: 2500}}, nil)
+	suite.Equal(types.ErrAccountNotFound(suite.unreg1), err)
+	err = suite.pm.UpdatePost(suite.Ctx, author, postID, "title", "content", nil, nil)
+	suite.Require().Nil(err)
+	post, err = suite.pm.GetPost(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	suite.Equal(beneficiaries, post.Beneficiaries)
+	err = suite.pm.UpdatePost(suite.Ctx, author, postID, "title", "content", []types.Beneficiary{}, nil)
+	suite.Require().Nil(err)
+	post, err = suite.pm.GetPost(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	suite.Nil(post.Beneficiaries)
+	err = suite.pm.UpdatePost(suite.Ctx, author, postID, "title", "content", beneficiaries, nil)
+	suite.Require().Nil(err)
+	post, err = suite.pm.GetPost(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	suite.Equal(beneficiaries, post.Beneficiaries)
+}
+
+func (suite *PostManagerTestSuite) TestLinoDonateBeneficiaries() {
+	from := suite.user2
+	author := suite.user1
+	app := suite.app1
+	postID := "post1"
+	err := suite.pm.CreatePost(suite.Ctx, author, postID, author, "content", "title", "",
+		[]types.Beneficiary{
+			{Account: suite.app2, Weight: 2500},
+			{Account: suite.app3, Weight: 1000},
+		}, nil, nil)
+	suite.Require().Nil(err)
+
+	amount := linotypes.NewCoinFromInt64(100000)
+	tax := linotypes.DecToCoin(amount.ToDec().Mul(suite.rate))
+	// income: 90100
+	dollar := linotypes.NewMiniDollar(1000)
+	dp := linotypes.NewMiniDollar(33)
+	suite.price.On("CoinToMiniDollar", mock.Anything, amount).Return(dollar, nil)
+	suite.rep.On("DonateAt", mock.Anything, from, linotypes.GetPermlink(author, postID), dollar).Return(dp, nil).Once()
+	// beneficiaries are snapshotted in the reward event.
+	suite.global.On("RegisterEventAtTime", mock.Anything, mock.Anything, types.RewardEvent{
+		PostAuthor: author,
+		PostID:     postID,
+		Consumer:   from,
+		Evaluate:   dp,
+		FromApp:    app,
+		Beneficiaries: []types.Beneficiary{
+			{Account: suite.app2, Weight: 2500},
+			{Account: suite.app3, Weight: 1000},
+		},
+	}).Return(nil).Once()
+	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), tax).Return(nil).Once()
+	suite.vote.On("RecordFriction", mock.Anything, tax).Return(nil).Once()
+	for acc, income := range map[linotypes.AccountKey]int64{
+		suite.app2: 22525,
+		suite.app3: 9010,
+		author:     58565,
+	} {
+		suite.am.On("MoveCoin", mock.Anything,
+			linotypes.NewAccOrAddrFromAcc(from),
+			linotypes.NewAccOrAddrFromAcc(acc), linotypes.NewCoinFromInt64(income)).Return(nil).Once()
+	}
+	err = suite.pm.LinoDonate(suite.Ctx, from, amount, author, postID, app, "")
+	suite.Nil(err)
+	suite.am.AssertExpectations(suite.T())
+}
+
+func (suite *PostManagerTestSuite) TestIDADonateBeneficiaries() {
+	from := suite.user2
+	author := suite.user1
+	app := suite.app1
+	postID := "post1"
+	err := suite.pm.CreatePost(suite.Ctx, author, postID, author, "content", "title", "",
+		[]types.Beneficiary{
+			{Account: suite.app2, Weight: 2500},
+			{Account: suite.app3, Weight: 1000},
+		}, nil, nil)
+	suite.Require().Nil(err)
+
+	var amount linotypes.IDAStr = "20"
+	miniIDA, err := amount.ToMiniIDA()
+	suite.Require().Nil(err)
+	dollar := linotypes.MiniIDAToMiniDollar(miniIDA, suite.app1IDAPrice)
+	tax := linotypes.NewMiniDollarFromInt(dollar.ToDec().Mul(suite.rate).RoundInt())
+	taxcoins := linotypes.NewCoinFromInt64(78)
+	income := dollar.Minus(tax)
+	toApp2 := linotypes.NewMiniDollarFromInt(income.MulRaw(2500).QuoRaw(10000))
+	toApp3 := linotypes.NewMiniDollarFromInt(income.MulRaw(1000).QuoRaw(10000))
+	suite.dev.On("BurnIDA", mock.Anything, app, from, tax).Return(taxcoins, nil)
+	suite.rep.On("DonateAt", mock.Anything, from, linotypes.GetPermlink(author, postID), dollar).Return(
+		linotypes.NewMiniDollar(33), nil).Once()
+	suite.global.On("RegisterEventAtTime", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), taxcoins).Return(nil).Once()
+	suite.vote.On("RecordFriction", mock.Anything, taxcoins).Return(nil).Once()
+	suite.dev.On("MoveIDA", mock.Anything, app, from, suite.app2, toApp2).Return(nil).Once()
+	suite.dev.On("MoveIDA", mock.Anything, app, from, suite.app3, toApp3).Return(nil).Once()
+	suite.dev.On("MoveIDA", mock.Anything, app, from, author,
+		income.Minus(toApp2).Minus(toApp3)).Return(nil).Once()
+	err = suite.pm.IDADonate(suite.Ctx, from, miniIDA, author, postID, app, suite.app1affiliated, "")
+	suite.Nil(err)
+	suite.dev.AssertExpectations(suite.T())
+}
+
+func (suite *PostManagerTestSuite) TestExecRewardEventBeneficiaries() {
+	author := suite.user1
+	postID := "post1"
+	err := suite.pm.CreatePost(suite.Ctx, author, postID, author, "content", "title", "",
+		[]types.Beneficiary{
+			{Account: suite.app2, Weight: 2500},
+			{Account: suite.app3, Weight: 1000},
+		}, nil, nil)
+	suite.Require().Nil(err)
+	// beneficiaries removed after the donation still get their share of its content bonus.
+	err = suite.pm.UpdatePost(suite.Ctx, author, postID, "title", "content", []types.Beneficiary{}, nil)
+	suite.Require().Nil(err)
+
+	suite.pm.postStorage.SetConsumptionWindow(suite.Ctx, linotypes.NewMiniDollar(300))
+	suite.am.On("GetPool", mock.Anything, linotypes.InflationConsumptionPool).Return(
+		linotypes.NewCoinFromInt64(10000), nil)
+	// reward: 3333
+	for acc, reward := range map[linotypes.AccountKey]int64{
+		suite.app2: 833,
+		suite.app3: 333,
+		author:     2167,
+	} {
+		suite.am.On("MoveFromPool", mock.Anything, linotypes.InflationConsumptionPool,
+			linotypes.NewAccOrAddrFromAcc(acc), linotypes.NewCoinFromInt64(reward)).Return(nil).Once()
+	}
+	err = suite.pm.ExecRewardEvent(suite.Ctx, types.RewardEvent{
+		PostAuthor: author,
+		PostID:     postID,
+		Consumer:   suite.user2,
+		Evaluate:   linotypes.NewMiniDollar(100),
+		FromApp:    suite.user2,
+		Beneficiaries: []types.Beneficiary{
+			{Account: suite.app2, Weight: 2500},
+			{Account: suite.app3, Weight: 1000},
+		},
+	})
+	suite.Nil(err)
+	suite.am.AssertExpectations(suite.T())
+}
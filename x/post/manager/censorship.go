@@ -0,0 +1,172 @@
+package manager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	"github.com/lino-network/lino/x/post/types"
+)
+
+// FlagPost - flag a post for censorship, flagger must be a voter and deposit at least
+// ContentCensorshipMinDeposit, which is held in the censorship deposit pool until
+// votes are tallied after ContentCensorshipDecideSec seconds.
+// A post can only have one pending censorship at a time.
+func (pm PostManager) FlagPost(ctx sdk.Context, flagger linotypes.AccountKey, permlink linotypes.Permlink,
+	deposit linotypes.Coin, reason string) (int64, sdk.Error) {
+	param, err := pm.ph.GetProposalParam(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !pm.vote.DoesVoterExist(ctx, flagger) {
+		return 0, types.ErrInvalidCensorship("flagger is not a voter")
+	}
+	post, err := pm.postStorage.GetPost(ctx, permlink)
+	if err != nil {
+		return 0, err
+	}
+	if post.IsDeleted {
+		return 0, types.ErrPostDeleted(permlink)
+	}
+	if post.IsCensored {
+		return 0, types.ErrPostCensored(permlink)
+	}
+	if post.CensorshipID > 0 {
+		last, err := pm.postStorage.GetCensorship(ctx, post.CensorshipID)
+		if err != nil {
+			return 0, err
+		}
+		if last.Status == types.CensorshipPending {
+			return 0, types.ErrInvalidCensorship("post has a pending censorship")
+		}
+	}
+	if param.ContentCensorshipMinDeposit.IsGT(deposit) {
+		return 0, types.ErrInvalidCensorship("deposit is less than minimum deposit")
+	}
+	if err := pm.am.MoveToPool(ctx, linotypes.PostCensorshipDepositPool,
+		linotypes.NewAccOrAddrFromAcc(flagger), deposit); err != nil {
+		return 0, err
+	}
+
+	id := pm.postStorage.GetCensorshipNextID(ctx)
+	now := ctx.BlockTime().Unix()
+	censorship := &model.Censorship{
+		ID:        id,
+		Permlink:  permlink,
+		Flagger:   flagger,
+		Deposit:   deposit,
+		Reason:    reason,
+		CreatedAt: now,
+		DecideAt:  now + param.ContentCensorshipDecideSec,
+		Status:    types.CensorshipPending,
+		Approve:   linotypes.NewCoinFromInt64(0),
+		Reject:    linotypes.NewCoinFromInt64(0),
+	}
+	if err := pm.gm.RegisterEventAtTime(
+		ctx, censorship.DecideAt, types.DecideCensorshipEvent{ID: id}); err != nil {
+		return 0, err
+	}
+	pm.postStorage.SetCensorship(ctx, censorship)
+	pm.postStorage.SetCensorshipNextID(ctx, id+1)
+	post.CensorshipID = id
+	pm.postStorage.SetPost(ctx, post)
+	return id, nil
+}
+
+// VoteCensorship - vote on a pending censorship, a later vote of
+// the same voter overrides the earlier one.
+func (pm PostManager) VoteCensorship(ctx sdk.Context, voter linotypes.AccountKey, id int64, approve bool) sdk.Error {
+	if !pm.vote.DoesVoterExist(ctx, voter) {
+		return types.ErrInvalidCensorship("voter is not a voter")
+	}
+	censorship, err := pm.postStorage.GetCensorship(ctx, id)
+	if err != nil {
+		return err
+	}
+	if censorship.Status != types.CensorshipPending || ctx.BlockTime().Unix() >= censorship.DecideAt {
+		return types.ErrCensorshipClosed(id)
+	}
+	pm.postStorage.SetCensorshipVote(ctx, id, &model.CensorshipVote{
+		Voter:   voter,
+		Approve: approve,
+	})
+	return nil
+}
+
+// ExecDecideCensorshipEvent - tally votes weighted by current lino stake of voters.
+// The censorship passes when voted stake reaches ContentCensorshipPassVotes and
+// approve stake is more than ContentCensorshipPassRatio of voted stake.
+// On pass, the post is marked as censored and the deposit is refunded to the flagger,
+// otherwise the deposit is burned.
+func (pm PostManager) ExecDecideCensorshipEvent(ctx sdk.Context, event types.DecideCensorshipEvent) sdk.Error {
+	censorship, err := pm.postStorage.GetCensorship(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	if censorship.Status != types.CensorshipPending {
+		return types.ErrCensorshipClosed(event.ID)
+	}
+
+	for _, vote := range pm.postStorage.GetCensorshipVotes(ctx, event.ID) {
+		stake, err := pm.vote.GetLinoStake(ctx, vote.Voter)
+		if err != nil {
+			return err
+		}
+		if vote.Approve {
+			censorship.Approve = censorship.Approve.Plus(stake)
+		} else {
+			censorship.Reject = censorship.Reject.Plus(stake)
+		}
+	}
+
+	param, err := pm.ph.GetProposalParam(ctx)
+	if err != nil {
+		return err
+	}
+	voted := censorship.Approve.Plus(censorship.Reject)
+	if voted.IsPositive() && voted.IsGTE(param.ContentCensorshipPassVotes) &&
+		censorship.Approve.ToDec().GT(voted.ToDec().Mul(param.ContentCensorshipPassRatio)) {
+		censorship.Status = types.CensorshipPassed
+		post, err := pm.postStorage.GetPost(ctx, censorship.Permlink)
+		if err != nil {
+			return err
+		}
+		post.IsCensored = true
+		pm.postStorage.SetPost(ctx, post)
+		if err := pm.am.MoveFromPool(ctx, linotypes.PostCensorshipDepositPool,
+			linotypes.NewAccOrAddrFromAcc(censorship.Flagger), censorship.Deposit); err != nil {
+			return err
+		}
+	} else {
+		censorship.Status = types.CensorshipRejected
+		if err := pm.am.BurnFromPool(
+			ctx, linotypes.PostCensorshipDepositPool, censorship.Deposit); err != nil {
+			return err
+		}
+	}
+	pm.postStorage.SetCensorship(ctx, censorship)
+	return nil
+}
+
+// GetCensorship - get censorship by id.
+func (pm PostManager) GetCensorship(ctx sdk.Context, id int64) (*model.Censorship, sdk.Error) {
+	return pm.postStorage.GetCensorship(ctx, id)
+}
+
+// GetCensorships - history of all censorships, sorted by id.
+func (pm PostManager) GetCensorships(ctx sdk.Context) []model.Censorship {
+	rst := make([]model.Censorship, 0)
+	pm.postStorage.PartialStoreMap(ctx)[string(model.CensorshipSubStore)].Iterate(func(key []byte, val interface{}) bool {
+		rst = append(rst, *val.(*model.Censorship))
+		return false
+	})
+	return rst
+}
+
+// GetCensorshipVotes - votes on censorship, sorted by voter.
+func (pm PostManager) GetCensorshipVotes(ctx sdk.Context, id int64) ([]model.CensorshipVote, sdk.Error) {
+	if _, err := pm.postStorage.GetCensorship(ctx, id); err != nil {
+		return nil, err
+	}
+	return pm.postStorage.GetCensorshipVotes(ctx, id), nil
+}
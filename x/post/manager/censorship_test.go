@@ -0,0 +1,304 @@
+package manager
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	linotypes "github.com/lino-network/lino/types"
+	acctypes "github.com/lino-network/lino/x/account/types"
+	"github.com/lino-network/lino/x/post/model"
+	types "github.com/lino-network/lino/x/post/types"
+)
+
+// setCensorshipBackground - user2, app1 and app2 are voters with stake, post1 of user1 exists.
+func (suite *PostManagerTestSuite) setCensorshipBackground() linotypes.Permlink {
+	for voter, stake := range map[linotypes.AccountKey]int64{
+		suite.user2: 600,
+		suite.app1:  300,
+		suite.app2:  200,
+	} {
+		suite.vote.On("DoesVoterExist", mock.Anything, voter).Return(true).Maybe()
+		suite.vote.On("GetLinoStake", mock.Anything, voter).Return(
+			linotypes.NewCoinFromInt64(stake), nil).Maybe()
+	}
+	for _, v := range []linotypes.AccountKey{suite.user1, suite.unreg1} {
+		suite.vote.On("DoesVoterExist", mock.Anything, v).Return(false).Maybe()
+	}
+	suite.am.On("MoveToPool", mock.Anything, linotypes.PostCensorshipDepositPool,
+		mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.global.On("RegisterEventAtTime", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	err := suite.pm.CreatePost(suite.Ctx, suite.user1, "post1", suite.user1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	return linotypes.GetPermlink(suite.user1, "post1")
+}
+
+func (suite *PostManagerTestSuite) TestFlagPost() {
+	permlink := suite.setCensorshipBackground()
+	err := suite.pm.CreatePost(suite.Ctx, suite.user1, "deleted", suite.user1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	err = suite.pm.DeletePost(suite.Ctx, linotypes.GetPermlink(suite.user1, "deleted"))
+	suite.Require().Nil(err)
+	err = suite.pm.CreatePost(suite.Ctx, suite.user1, "censored", suite.user1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	censored, err := suite.pm.postStorage.GetPost(suite.Ctx, linotypes.GetPermlink(suite.user1, "censored"))
+	suite.Require().Nil(err)
+	censored.IsCensored = true
+	suite.pm.postStorage.SetPost(suite.Ctx, censored)
+
+	testCases := []struct {
+		testName   string
+		flagger    linotypes.AccountKey
+		permlink   linotypes.Permlink
+		deposit    linotypes.Coin
+		expectedID int64
+		expectErr  sdk.Error
+	}{
+		{
+			testName:  "flagger is not a voter",
+			flagger:   suite.unreg1,
+			permlink:  permlink,
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrInvalidCensorship("flagger is not a voter"),
+		},
+		{
+			testName:  "post not found",
+			flagger:   suite.user2,
+			permlink:  linotypes.GetPermlink(suite.user1, "notexist"),
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrPostNotFound(linotypes.GetPermlink(suite.user1, "notexist")),
+		},
+		{
+			testName:  "post deleted",
+			flagger:   suite.user2,
+			permlink:  linotypes.GetPermlink(suite.user1, "deleted"),
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrPostDeleted(linotypes.GetPermlink(suite.user1, "deleted")),
+		},
+		{
+			testName:  "post censored",
+			flagger:   suite.user2,
+			permlink:  linotypes.GetPermlink(suite.user1, "censored"),
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrPostCensored(linotypes.GetPermlink(suite.user1, "censored")),
+		},
+		{
+			testName:  "deposit less than minimum",
+			flagger:   suite.user2,
+			permlink:  permlink,
+			deposit:   linotypes.NewCoinFromInt64(99),
+			expectErr: types.ErrInvalidCensorship("deposit is less than minimum deposit"),
+		},
+		{
+			testName:   "succ",
+			flagger:    suite.user2,
+			permlink:   permlink,
+			deposit:    linotypes.NewCoinFromInt64(100),
+			expectedID: 1,
+		},
+		{
+			testName:  "pending censorship exists",
+			flagger:   suite.app1,
+			permlink:  permlink,
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrInvalidCensorship("post has a pending censorship"),
+		},
+	}
+
+	for _, tc := range testCases {
+		id, err := suite.pm.FlagPost(suite.Ctx, tc.flagger, tc.permlink, tc.deposit, "spam")
+		suite.Equal(tc.expectErr, err, "%s", tc.testName)
+		suite.Equal(tc.expectedID, id, "%s", tc.testName)
+	}
+
+	now := suite.Ctx.BlockTime().Unix()
+	censorship, err := suite.pm.GetCensorship(suite.Ctx, 1)
+	suite.Nil(err)
+	suite.Equal(&model.Censorship{
+		ID:        1,
+		Permlink:  permlink,
+		Flagger:   suite.user2,
+		Deposit:   linotypes.NewCoinFromInt64(100),
+		Reason:    "spam",
+		CreatedAt: now,
+		DecideAt:  now + 100,
+		Status:    types.CensorshipPending,
+		Approve:   linotypes.NewCoinFromInt64(0),
+		Reject:    linotypes.NewCoinFromInt64(0),
+	}, censorship)
+	post, err := suite.pm.GetPost(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(int64(1), post.CensorshipID)
+	suite.am.AssertCalled(suite.T(), "MoveToPool", mock.Anything, linotypes.PostCensorshipDepositPool,
+		linotypes.NewAccOrAddrFromAcc(suite.user2), linotypes.NewCoinFromInt64(100))
+	suite.global.AssertCalled(suite.T(), "RegisterEventAtTime", mock.Anything,
+		now+100, types.DecideCensorshipEvent{ID: 1})
+}
+
+func (suite *PostManagerTestSuite) TestVoteCensorship() {
+	permlink := suite.setCensorshipBackground()
+	id, err := suite.pm.FlagPost(suite.Ctx, suite.user2, permlink, linotypes.NewCoinFromInt64(100), "spam")
+	suite.Require().Nil(err)
+
+	suite.Equal(types.ErrInvalidCensorship("voter is not a voter"),
+		suite.pm.VoteCensorship(suite.Ctx, suite.unreg1, id, true))
+	suite.Equal(types.ErrCensorshipNotFound(id+1),
+		suite.pm.VoteCensorship(suite.Ctx, suite.app1, id+1, true))
+	suite.Nil(suite.pm.VoteCensorship(suite.Ctx, suite.app1, id, true))
+	suite.Nil(suite.pm.VoteCensorship(suite.Ctx, suite.app2, id, true))
+	// later vote overrides.
+	suite.Nil(suite.pm.VoteCensorship(suite.Ctx, suite.app1, id, false))
+
+	votes, err := suite.pm.GetCensorshipVotes(suite.Ctx, id)
+	suite.Nil(err)
+	suite.Equal([]model.CensorshipVote{
+		{Voter: suite.app1, Approve: false},
+		{Voter: suite.app2, Approve: true},
+	}, votes)
+	_, err = suite.pm.GetCensorshipVotes(suite.Ctx, id+1)
+	suite.Equal(types.ErrCensorshipNotFound(id+1), err)
+
+	// closed at decide time.
+	suite.NextBlock(time.Unix(suite.Ctx.BlockTime().Unix()+100, 0))
+	suite.Equal(types.ErrCensorshipClosed(id),
+		suite.pm.VoteCensorship(suite.Ctx, suite.user2, id, true))
+}
+
+func (suite *PostManagerTestSuite) TestExecDecideCensorshipEvent() {
+	testCases := []struct {
+		testName       string
+		votes          map[linotypes.AccountKey]bool
+		expectedStatus types.CensorshipStatus
+		approve        int64
+		reject         int64
+	}{
+		{
+			testName:       "no votes",
+			votes:          map[linotypes.AccountKey]bool{},
+			expectedStatus: types.CensorshipRejected,
+		},
+		{
+			testName: "not enough votes",
+			votes: map[linotypes.AccountKey]bool{
+				suite.app1: true,
+				suite.app2: true,
+			},
+			expectedStatus: types.CensorshipRejected,
+			approve:        500,
+		},
+		{
+			testName: "ratio not reached",
+			votes: map[linotypes.AccountKey]bool{
+				suite.user2: false,
+				suite.app1:  true,
+				suite.app2:  true,
+			},
+			expectedStatus: types.CensorshipRejected,
+			approve:        500,
+			reject:         600,
+		},
+		{
+			testName: "pass",
+			votes: map[linotypes.AccountKey]bool{
+				suite.user2: true,
+				suite.app1:  true,
+				suite.app2:  false,
+			},
+			expectedStatus: types.CensorshipPassed,
+			approve:        900,
+			reject:         200,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.testName, func() {
+			suite.SetupTest()
+			permlink := suite.setCensorshipBackground()
+			id, err := suite.pm.FlagPost(suite.Ctx, suite.user2, permlink, linotypes.NewCoinFromInt64(100), "spam")
+			suite.Require().Nil(err)
+			for voter, approve := range tc.votes {
+				suite.Require().Nil(suite.pm.VoteCensorship(suite.Ctx, voter, id, approve))
+			}
+			if tc.expectedStatus == types.CensorshipPassed {
+				suite.am.On("MoveFromPool", mock.Anything, linotypes.PostCensorshipDepositPool,
+					linotypes.NewAccOrAddrFromAcc(suite.user2), linotypes.NewCoinFromInt64(100)).Return(nil).Once()
+			} else {
+				suite.am.On("BurnFromPool", mock.Anything, linotypes.PostCensorshipDepositPool,
+					linotypes.NewCoinFromInt64(100)).Return(nil).Once()
+			}
+
+			suite.NextBlock(time.Unix(suite.Ctx.BlockTime().Unix()+100, 0))
+			err = suite.pm.ExecDecideCensorshipEvent(suite.Ctx, types.DecideCensorshipEvent{ID: id})
+			suite.Nil(err)
+			suite.am.AssertExpectations(suite.T())
+
+			censorship, err := suite.pm.GetCensorship(suite.Ctx, id)
+			suite.Require().Nil(err)
+			suite.Equal(tc.expectedStatus, censorship.Status)
+			suite.Equal(linotypes.NewCoinFromInt64(tc.approve), censorship.Approve)
+			suite.Equal(linotypes.NewCoinFromInt64(tc.reject), censorship.Reject)
+			post, err := suite.pm.GetPost(suite.Ctx, permlink)
+			suite.Require().Nil(err)
+			suite.Equal(tc.expectedStatus == types.CensorshipPassed, post.IsCensored)
+
+			// decided censorship can not be executed again.
+			suite.Equal(types.ErrCensorshipClosed(id),
+				suite.pm.ExecDecideCensorshipEvent(suite.Ctx, types.DecideCensorshipEvent{ID: id}))
+			suite.Equal([]model.Censorship{*censorship}, suite.pm.GetCensorships(suite.Ctx))
+		})
+	}
+}
+
+func (suite *PostManagerTestSuite) TestCensoredPost() {
+	permlink := suite.setCensorshipBackground()
+	post, err := suite.pm.postStorage.GetPost(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	post.IsCensored = true
+	suite.pm.postStorage.SetPost(suite.Ctx, post)
+
+	// censored post is still readable.
+	suite.True(suite.pm.DoesPostExist(suite.Ctx, permlink))
+
+	suite.Equal(types.ErrPostCensored(permlink),
+		suite.pm.UpdatePost(suite.Ctx, suite.user1, "post1", "title2", "content2", nil, nil))
+	suite.Equal(types.ErrPostCensored(permlink),
+		suite.pm.CreatePost(suite.Ctx, suite.user2, "reply", suite.user2, "content", "title", permlink, nil, nil, nil))
+	suite.Equal(types.ErrPostCensored(permlink),
+		suite.pm.LinoDonate(suite.Ctx, suite.user2, linotypes.NewCoinFromInt64(100), suite.user1, "post1", "", ""))
+	suite.Equal(types.ErrPostCensored(permlink),
+		suite.pm.IDADonate(suite.Ctx, suite.user2, sdk.NewInt(100), suite.user1, "post1",
+			suite.app1, suite.app1, ""))
+
+	// pending content bonus goes to treasury pool.
+	suite.pm.postStorage.SetConsumptionWindow(suite.Ctx, linotypes.NewMiniDollar(100))
+	suite.am.On("GetPool", mock.Anything, linotypes.InflationConsumptionPool).Return(
+		linotypes.NewCoinFromInt64(10000), nil)
+	suite.dev.On("ReportConsumption", mock.Anything, suite.app1, mock.Anything).Return(nil).Maybe()
+	suite.am.On("MoveBetweenPools", mock.Anything, linotypes.InflationConsumptionPool,
+		linotypes.InflationTreasuryPool, linotypes.NewCoinFromInt64(10000)).Return(nil).Once()
+	err = suite.pm.ExecRewardEvent(suite.Ctx, types.RewardEvent{
+		PostAuthor: suite.user1,
+		PostID:     "post1",
+		Consumer:   suite.user2,
+		Evaluate:   linotypes.NewMiniDollar(100),
+		FromApp:    suite.app1,
+	})
+	suite.Nil(err)
+	suite.am.AssertExpectations(suite.T())
+	suite.am.AssertNotCalled(suite.T(), "MoveFromPool", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// pool errors are returned.
+	suite.am.On("MoveBetweenPools", mock.Anything, linotypes.InflationConsumptionPool,
+		linotypes.InflationTreasuryPool, mock.Anything).Return(
+		acctypes.ErrPoolNotFound(linotypes.InflationTreasuryPool)).Once()
+	suite.pm.postStorage.SetConsumptionWindow(suite.Ctx, linotypes.NewMiniDollar(100))
+	err = suite.pm.ExecRewardEvent(suite.Ctx, types.RewardEvent{
+		PostAuthor: suite.user1,
+		PostID:     "post1",
+		Consumer:   suite.user2,
+		Evaluate:   linotypes.NewMiniDollar(100),
+		FromApp:    suite.app1,
+	})
+	suite.Equal(acctypes.ErrPoolNotFound(linotypes.InflationTreasuryPool), err)
+}
@@ -0,0 +1,30 @@
+package manager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	"github.com/lino-network/lino/x/post/types"
+)
+
+// DonateToAccount - donate lino to the channel of author, handled as in LinoDonate
+// with the channel as the target: friction, reputation, stats and the reward
+// event are accounted against the channel permlink, and the channel has no beneficiaries.
+func (pm PostManager) DonateToAccount(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, app linotypes.AccountKey, memo string) sdk.Error {
+	return pm.LinoDonate(ctx, from, amount, author, types.ChannelPostID, app, memo)
+}
+
+// getDonationTarget - the post, or a synthetic post for the channel of author.
+func (pm PostManager) getDonationTarget(ctx sdk.Context, author linotypes.AccountKey, postID string) (*model.Post, sdk.Error) {
+	if postID == types.ChannelPostID {
+		return &model.Post{Author: author, PostID: types.ChannelPostID}, nil
+	}
+	return pm.postStorage.GetPost(ctx, linotypes.GetPermlink(author, postID))
+}
+
+// isChannel - returns true if permlink is the channel of an existing account.
+func (pm PostManager) isChannel(ctx sdk.Context, permlink linotypes.Permlink) bool {
+	author, ok := types.ParseChannelPermlink(permlink)
+	return ok && pm.am.DoesAccountExist(ctx, author)
+}
@@ -0,0 +1,101 @@
+package manager
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	types "github.com/lino-network/lino/x/post/types"
+)
+
+func (suite *PostManagerTestSuite) TestDonateToAccountInvalid() {
+	amount := linotypes.NewCoinFromInt64(100000)
+	suite.Equal(types.ErrCannotDonateToSelf(suite.user1),
+		suite.pm.DonateToAccount(suite.Ctx, suite.user1, amount, suite.user1, "", ""))
+	suite.Equal(types.ErrAccountNotFound(suite.unreg1),
+		suite.pm.DonateToAccount(suite.Ctx, suite.user1, amount, suite.unreg1, "", ""))
+	suite.Equal(types.ErrDeveloperNotFound(suite.unreg1),
+		suite.pm.DonateToAccount(suite.Ctx, suite.user2, amount, suite.user1, suite.unreg1, ""))
+	suite.Equal(types.ErrInvalidDonationAmount(linotypes.NewCoinFromInt64(0)),
+		suite.pm.DonateToAccount(suite.Ctx, suite.user2, linotypes.NewCoinFromInt64(0), suite.user1, "", ""))
+	suite.Equal(types.ErrDonateAmountTooLittle(),
+		suite.pm.DonateToAccount(suite.Ctx, suite.user2, linotypes.NewCoinFromInt64(1), suite.user1, "", ""))
+}
+
+func (suite *PostManagerTestSuite) TestDonateToAccount() {
+	from := suite.user2
+	author := suite.user1
+	app := suite.app1
+	channel := types.GetChannelPermlink(author)
+	amount := linotypes.NewCoinFromInt64(100000)
+	tax := linotypes.DecToCoin(amount.ToDec().Mul(suite.rate))
+	income := amount.Minus(tax)
+	dollar := linotypes.NewMiniDollar(1000)
+	dp := linotypes.NewMiniDollar(33)
+
+	_, err := suite.pm.GetPostStats(suite.Ctx, types.GetChannelPermlink(suite.unreg1))
+	suite.Equal(types.ErrPostNotFound(types.GetChannelPermlink(suite.unreg1)), err)
+	stats, err := suite.pm.GetPostStats(suite.Ctx, channel)
+	suite.Nil(err)
+	suite.Equal(model.NewDonationStats(), stats)
+
+	event := types.RewardEvent{
+		PostAuthor: author,
+		PostID:     types.ChannelPostID,
+		Consumer:   from,
+		Evaluate:   dp,
+		FromApp:    app,
+	}
+	suite.price.On("CoinToMiniDollar", mock.Anything, amount).Return(dollar, nil)
+	suite.rep.On("DonateAt", mock.Anything, from, channel, dollar).Return(dp, nil).Once()
+	suite.global.On("RegisterEventAtTime", mock.Anything,
+		int64(linotypes.ConsumptionFreezingPeriodSec), event).Return(nil).Once()
+	suite.am.On("MoveCoin", mock.Anything,
+		linotypes.NewAccOrAddrFromAcc(from),
+		linotypes.NewAccOrAddrFromAcc(author), income).Return(nil).Once()
+	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), tax).Return(nil).Once()
+	suite.vote.On("RecordFriction", mock.Anything, tax).Return(nil).Once()
+	err = suite.pm.DonateToAccount(suite.Ctx, from, amount, author, app, "keep it up")
+	suite.Nil(err)
+	suite.price.AssertExpectations(suite.T())
+	suite.rep.AssertExpectations(suite.T())
+	suite.global.AssertExpectations(suite.T())
+	suite.am.AssertExpectations(suite.T())
+	suite.Equal(dp, suite.pm.GetComsumptionWindow(suite.Ctx))
+
+	// channel and author stats.
+	expected := &model.DonationStats{
+		LinoDonations: amount,
+		IDADonations:  linotypes.NewMiniDollar(0),
+		NumDonations:  1,
+		NumDonors:     1,
+		Impact:        dp,
+		ContentBonus:  linotypes.NewCoinFromInt64(0),
+	}
+	stats, err = suite.pm.GetPostStats(suite.Ctx, channel)
+	suite.Nil(err)
+	suite.Equal(expected, stats)
+	stats, err = suite.pm.GetAuthorStats(suite.Ctx, author)
+	suite.Nil(err)
+	suite.Equal(expected, stats)
+	donations, err := suite.pm.GetRecentDonations(suite.Ctx, channel)
+	suite.Nil(err)
+	suite.Require().Len(donations, 1)
+	suite.Equal("keep it up", donations[0].Memo)
+	suite.False(suite.pm.DoesPostExist(suite.Ctx, channel))
+
+	// content bonus goes to the author.
+	suite.am.On("GetPool", mock.Anything, linotypes.InflationConsumptionPool).Return(
+		linotypes.NewCoinFromInt64(1000), nil)
+	suite.am.On("MoveFromPool", mock.Anything, linotypes.InflationConsumptionPool,
+		linotypes.NewAccOrAddrFromAcc(author), linotypes.NewCoinFromInt64(1000)).Return(nil).Once()
+	suite.dev.On("ReportConsumption", mock.Anything, app, dp).Return(nil).Once()
+	err = suite.pm.ExecRewardEvent(suite.Ctx, event)
+	suite.Nil(err)
+	suite.am.AssertExpectations(suite.T())
+	suite.dev.AssertExpectations(suite.T())
+	stats, err = suite.pm.GetPostStats(suite.Ctx, channel)
+	suite.Nil(err)
+	suite.Equal(linotypes.NewCoinFromInt64(1000), stats.ContentBonus)
+}
@@ -0,0 +1,58 @@
+package manager
+
+import (
+	linotypes "github.com/lino-network/lino/types"
+	types "github.com/lino-network/lino/x/post/types"
+)
+
+func (suite *PostManagerTestSuite) TestContentRef() {
+	author := suite.user1
+	app := suite.app1
+	postID := "post1"
+	permlink := linotypes.GetPermlink(author, postID)
+	ref := &types.ContentRef{
+		Hash:     types.ContentHash("", "content"),
+		URI:      "ipfs://content",
+		Size:     7,
+		MimeType: "text/plain",
+	}
+	ref2 := &types.ContentRef{
+		Hash:     types.ContentHash("", "content2"),
+		URI:      "ipfs://content2",
+		Size:     8,
+		MimeType: "text/markdown",
+	}
+
+	// post with inline content has no reference.
+	err := suite.pm.CreatePost(suite.Ctx, author, "inline", app, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	_, err = suite.pm.GetContentRef(suite.Ctx, linotypes.GetPermlink(author, "inline"))
+	suite.Equal(types.ErrContentRefNotFound(linotypes.GetPermlink(author, "inline")), err)
+
+	err = suite.pm.CreatePost(suite.Ctx, author, postID, app, "", "title", "", nil, ref, nil)
+	suite.Require().Nil(err)
+	rst, err := suite.pm.GetContentRef(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(ref, rst)
+
+	err = suite.pm.UpdatePost(suite.Ctx, author, postID, "title", "", nil, ref2)
+	suite.Require().Nil(err)
+	rst, err = suite.pm.GetContentRef(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(ref2, rst)
+	history, err := suite.pm.GetHistory(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	suite.Require().Len(history.Revisions, 2)
+	suite.Equal(ref, history.Revisions[0].ContentRef)
+	suite.Equal(ref2, history.Revisions[1].ContentRef)
+
+	// only hashes of references are kept after deletion.
+	err = suite.pm.DeletePost(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	_, err = suite.pm.GetContentRef(suite.Ctx, permlink)
+	suite.Equal(types.ErrPostDeleted(permlink), err)
+	history, err = suite.pm.GetHistory(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	suite.Equal(&types.ContentRef{Hash: ref.Hash}, history.Revisions[0].ContentRef)
+	suite.Equal(&types.ContentRef{Hash: ref2.Hash}, history.Revisions[1].ContentRef)
+}
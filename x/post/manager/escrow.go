@@ -0,0 +1,118 @@
+package manager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	"github.com/lino-network/lino/x/post/types"
+)
+
+// EscrowLinoDonate - donate lino as in LinoDonate, but shares of the author and
+// beneficiaries are held in the donation escrow pool until the reward event of
+// the donation is executed. Before that, the app can refund the donation,
+// so app can not be empty. Returns the id of the escrowed donation.
+func (pm PostManager) EscrowLinoDonate(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey, memo string) (int64, sdk.Error) {
+	if app == "" {
+		return 0, types.ErrDeveloperNotFound(app)
+	}
+	return pm.linoDonate(ctx, from, amount, author, postID, app, memo, true)
+}
+
+// releaseEscrow - move held shares of the escrowed donation to their accounts.
+func (pm PostManager) releaseEscrow(ctx sdk.Context, id int64) sdk.Error {
+	escrow, err := pm.postStorage.GetEscrowedDonation(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, share := range escrow.Shares {
+		err := pm.am.MoveFromPool(ctx, linotypes.PostDonationEscrowPool,
+			linotypes.NewAccOrAddrFromAcc(share.Account), linotypes.NewCoin(share.Amount))
+		if err != nil {
+			return err
+		}
+	}
+	pm.postStorage.DeleteEscrowedDonation(ctx, id)
+	return nil
+}
+
+// RefundDonation - refund the escrowed donation to the donor before its reward
+// event is executed, signer must be the app of the donation or affiliated with it.
+// The reward event is cancelled, the donation is removed from recent donations
+// of the post, and its impact is reverted from the consumption and reputation
+// windows, the donor's reputation and donation stats.
+// Friction is not refunded, and the reputation impact is only reverted
+// if the donation was made in the current reputation round.
+func (pm PostManager) RefundDonation(ctx sdk.Context, app, signer linotypes.AccountKey, id int64) sdk.Error {
+	escrow, err := pm.postStorage.GetEscrowedDonation(ctx, id)
+	if err != nil {
+		return err
+	}
+	signerApp, err := pm.dev.GetAffiliatingApp(ctx, signer)
+	if err != nil || signerApp != app || escrow.App != app {
+		return types.ErrInvalidSigner()
+	}
+	if ctx.BlockHeader().Time.Unix() >= escrow.ReleaseAt {
+		return types.ErrRefundWindowClosed(id)
+	}
+
+	// cancel the reward event, which is identical to the one registered.
+	err = pm.gm.RemoveEventAtTime(ctx, escrow.ReleaseAt, types.RewardEvent{
+		PostAuthor:    escrow.Author,
+		PostID:        escrow.PostID,
+		Consumer:      escrow.From,
+		Evaluate:      escrow.Impact,
+		FromApp:       escrow.App,
+		EscrowID:      escrow.ID,
+		Weight:        escrow.Weight,
+		Beneficiaries: escrow.Beneficiaries,
+	})
+	if err != nil {
+		return err
+	}
+
+	refund := linotypes.NewCoinFromInt64(0)
+	for _, share := range escrow.Shares {
+		refund = refund.Plus(linotypes.NewCoin(share.Amount))
+	}
+	err = pm.am.MoveFromPool(ctx, linotypes.PostDonationEscrowPool,
+		linotypes.NewAccOrAddrFromAcc(escrow.From), refund)
+	if err != nil {
+		return err
+	}
+
+	consumptionWindow := pm.postStorage.GetConsumptionWindow(ctx)
+	pm.postStorage.SetConsumptionWindow(ctx, consumptionWindow.Minus(escrow.Impact))
+	if escrow.Weight != nil {
+		reputationWindow := pm.postStorage.GetReputationWindow(ctx)
+		pm.postStorage.SetReputationWindow(ctx, reputationWindow.Minus(*escrow.Weight))
+	}
+
+	permlink := linotypes.GetPermlink(escrow.Author, escrow.PostID)
+	_, err = pm.rep.RevertDonation(ctx, escrow.From, permlink, escrow.Dollar, escrow.Impact)
+	if err != nil {
+		return err
+	}
+
+	post, err := pm.getDonationTarget(ctx, escrow.Author, escrow.PostID)
+	if err != nil {
+		return err
+	}
+	if escrow.PostID != types.ChannelPostID {
+		if rev, err := pm.postStorage.GetRevision(ctx, permlink, escrow.Revision); err == nil {
+			rev.Donations = rev.Donations.Minus(escrow.Dollar)
+			rev.NumDonations--
+			pm.postStorage.SetRevision(ctx, permlink, rev)
+		}
+	}
+	pm.revertDonation(ctx, post, escrow.Amount, escrow.Impact)
+	pm.removeRecentDonation(ctx, permlink, escrow.ID)
+	pm.postStorage.DeleteEscrowedDonation(ctx, id)
+	return nil
+}
+
+// GetEscrowedDonation - get escrowed donation by id, released or refunded
+// donations are not kept.
+func (pm PostManager) GetEscrowedDonation(ctx sdk.Context, id int64) (*model.EscrowedDonation, sdk.Error) {
+	return pm.postStorage.GetEscrowedDonation(ctx, id)
+}
@@ -0,0 +1,173 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	types "github.com/lino-network/lino/x/post/types"
+)
+
+func (suite *PostManagerTestSuite) TestEscrowLinoDonateAndRefund() {
+	from := suite.user2
+	author := suite.user1
+	app := suite.app1
+	postID := "post1"
+	permlink := linotypes.GetPermlink(author, postID)
+	amount := linotypes.NewCoinFromInt64(100000)
+	tax := linotypes.DecToCoin(amount.ToDec().Mul(suite.rate))
+	income := amount.Minus(tax)
+	dollar := linotypes.NewMiniDollar(1000)
+	dp := linotypes.NewMiniDollar(33)
+	releaseAt := suite.Ctx.BlockHeader().Time.Unix() + linotypes.ConsumptionFreezingPeriodSec
+	event := types.RewardEvent{
+		PostAuthor: author,
+		PostID:     postID,
+		Consumer:   from,
+		Evaluate:   dp,
+		FromApp:    app,
+		EscrowID:   1,
+	}
+	err := suite.pm.CreatePost(suite.Ctx, author, postID, app, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+
+	// app is required.
+	_, err = suite.pm.EscrowLinoDonate(suite.Ctx, from, amount, author, postID, "", "")
+	suite.Equal(types.ErrDeveloperNotFound(""), err)
+
+	suite.price.On("CoinToMiniDollar", mock.Anything, amount).Return(dollar, nil)
+	suite.rep.On("DonateAt", mock.Anything, from, permlink, dollar).Return(dp, nil).Once()
+	suite.global.On("RegisterEventAtTime", mock.Anything, releaseAt, event).Return(nil).Once()
+	suite.am.On("MoveToPool", mock.Anything, linotypes.PostDonationEscrowPool,
+		linotypes.NewAccOrAddrFromAcc(from), income).Return(nil).Once()
+	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), tax).Return(nil).Once()
+	suite.vote.On("RecordFriction", mock.Anything, tax).Return(nil).Once()
+	id, err := suite.pm.EscrowLinoDonate(suite.Ctx, from, amount, author, postID, app, "refundable")
+	suite.Require().Nil(err)
+	suite.Equal(int64(1), id)
+	suite.global.AssertExpectations(suite.T())
+	suite.am.AssertExpectations(suite.T())
+
+	escrow, err := suite.pm.GetEscrowedDonation(suite.Ctx, id)
+	suite.Nil(err)
+	suite.Equal(&model.EscrowedDonation{
+		ID:        1,
+		From:      from,
+		Author:    author,
+		PostID:    postID,
+		App:       app,
+		Amount:    amount,
+		Shares:    []types.Share{{Account: author, Amount: income.Amount}},
+		Dollar:    dollar,
+		Impact:    dp,
+		CreatedAt: suite.Ctx.BlockHeader().Time.Unix(),
+		ReleaseAt: releaseAt,
+	}, escrow)
+	donations, err := suite.pm.GetRecentDonations(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Require().Len(donations, 1)
+	suite.Equal(int64(1), donations[0].EscrowID)
+	suite.Equal(dp, suite.pm.GetComsumptionWindow(suite.Ctx))
+
+	// only the app of the donation can refund.
+	suite.Equal(types.ErrEscrowNotFound(2), suite.pm.RefundDonation(suite.Ctx, app, app, 2))
+	suite.Equal(types.ErrInvalidSigner(), suite.pm.RefundDonation(suite.Ctx, suite.app2, suite.app2, id))
+	suite.Equal(types.ErrInvalidSigner(), suite.pm.RefundDonation(suite.Ctx, app, suite.app2, id))
+	suite.Equal(types.ErrInvalidSigner(), suite.pm.RefundDonation(suite.Ctx, app, from, id))
+
+	suite.NextBlock(time.Unix(releaseAt-1, 0))
+	suite.global.On("RemoveEventAtTime", mock.Anything, releaseAt, event).Return(nil).Once()
+	suite.am.On("MoveFromPool", mock.Anything, linotypes.PostDonationEscrowPool,
+		linotypes.NewAccOrAddrFromAcc(from), income).Return(nil).Once()
+	suite.rep.On("RevertDonation", mock.Anything, from, permlink, dollar, dp).Return(dp, nil).Once()
+	err = suite.pm.RefundDonation(suite.Ctx, app, suite.app1affiliated, id)
+	suite.Nil(err)
+	suite.global.AssertExpectations(suite.T())
+	suite.am.AssertExpectations(suite.T())
+	suite.rep.AssertExpectations(suite.T())
+
+	_, err = suite.pm.GetEscrowedDonation(suite.Ctx, id)
+	suite.Equal(types.ErrEscrowNotFound(id), err)
+	suite.Equal(linotypes.NewMiniDollar(0), suite.pm.GetComsumptionWindow(suite.Ctx))
+	expected := &model.DonationStats{
+		LinoDonations: linotypes.NewCoinFromInt64(0),
+		IDADonations:  linotypes.NewMiniDollar(0),
+		NumDonations:  0,
+		NumDonors:     1,
+		Impact:        linotypes.NewMiniDollar(0),
+		ContentBonus:  linotypes.NewCoinFromInt64(0),
+	}
+	stats, err := suite.pm.GetPostStats(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(expected, stats)
+	stats, err = suite.pm.GetAuthorStats(suite.Ctx, author)
+	suite.Nil(err)
+	suite.Equal(expected, stats)
+	history, err := suite.pm.GetHistory(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(linotypes.NewMiniDollar(0), history.Revisions[0].Donations)
+	suite.Equal(int64(0), history.Revisions[0].NumDonations)
+	donations, err = suite.pm.GetRecentDonations(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Empty(donations)
+
+	// refunded only once.
+	suite.Equal(types.ErrEscrowNotFound(id), suite.pm.RefundDonation(suite.Ctx, app, app, id))
+}
+
+func (suite *PostManagerTestSuite) TestEscrowRelease() {
+	from := suite.user2
+	author := suite.user1
+	app := suite.app1
+	postID := "post1"
+	permlink := linotypes.GetPermlink(author, postID)
+	amount := linotypes.NewCoinFromInt64(100000)
+	tax := linotypes.DecToCoin(amount.ToDec().Mul(suite.rate))
+	dollar := linotypes.NewMiniDollar(1000)
+	dp := linotypes.NewMiniDollar(0)
+	releaseAt := suite.Ctx.BlockHeader().Time.Unix() + linotypes.ConsumptionFreezingPeriodSec
+	beneficiaries := []types.Beneficiary{{Account: suite.app2, Weight: 1000}}
+	shares := types.SplitAmount(author, beneficiaries, amount.Minus(tax).Amount)
+	err := suite.pm.CreatePost(suite.Ctx, author, postID, app, "content", "title", "", beneficiaries, nil, nil)
+	suite.Require().Nil(err)
+
+	suite.price.On("CoinToMiniDollar", mock.Anything, amount).Return(dollar, nil)
+	suite.rep.On("DonateAt", mock.Anything, from, permlink, dollar).Return(dp, nil).Once()
+	suite.global.On("RegisterEventAtTime", mock.Anything, releaseAt, mock.Anything).Return(nil).Once()
+	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
+		linotypes.NewAccOrAddrFromAcc(from), tax).Return(nil).Once()
+	for _, share := range shares {
+		suite.am.On("MoveToPool", mock.Anything, linotypes.PostDonationEscrowPool,
+			linotypes.NewAccOrAddrFromAcc(from), linotypes.NewCoin(share.Amount)).Return(nil).Once()
+	}
+	suite.vote.On("RecordFriction", mock.Anything, tax).Return(nil).Once()
+	id, err := suite.pm.EscrowLinoDonate(suite.Ctx, from, amount, author, postID, app, "")
+	suite.Require().Nil(err)
+
+	// refund window closes at release time.
+	suite.NextBlock(time.Unix(releaseAt, 0))
+	suite.Equal(types.ErrRefundWindowClosed(id), suite.pm.RefundDonation(suite.Ctx, app, app, id))
+
+	// shares are released even if the post is deleted.
+	err = suite.pm.DeletePost(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	for _, share := range shares {
+		suite.am.On("MoveFromPool", mock.Anything, linotypes.PostDonationEscrowPool,
+			linotypes.NewAccOrAddrFromAcc(share.Account), linotypes.NewCoin(share.Amount)).Return(nil).Once()
+	}
+	err = suite.pm.ExecRewardEvent(suite.Ctx, types.RewardEvent{
+		PostAuthor: author,
+		PostID:     postID,
+		Consumer:   from,
+		Evaluate:   dp,
+		FromApp:    app,
+		EscrowID:   id,
+	})
+	suite.Nil(err)
+	suite.am.AssertExpectations(suite.T())
+	_, err = suite.pm.GetEscrowedDonation(suite.Ctx, id)
+	suite.Equal(types.ErrEscrowNotFound(id), err)
+}
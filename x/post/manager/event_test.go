@@ -3,7 +3,10 @@ package manager
 import (
 	"github.com/stretchr/testify/mock"
 
+	"github.com/lino-network/lino/param"
+	parammock "github.com/lino-network/lino/param/mocks"
 	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
 	types "github.com/lino-network/lino/x/post/types"
 )
 
@@ -12,7 +15,7 @@ func (suite *PostManagerTestSuite) TestExecRewardEvent() {
 	user2 := suite.user2
 	app1 := suite.app1
 	postID := "post1"
-	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title")
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 
 	// fixed pool in this test.
@@ -93,9 +96,9 @@ func (suite *PostManagerTestSuite) TestExecMultipleRewardEvents() {
 	user2 := suite.user2
 	app1 := suite.app1
 	postID := "post1"
-	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title")
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
-	err = suite.pm.CreatePost(suite.Ctx, user2, postID, app1, "content", "title")
+	err = suite.pm.CreatePost(suite.Ctx, user2, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 
 	totalConsumption := linotypes.NewMiniDollar(100)
@@ -139,3 +142,213 @@ func (suite *PostManagerTestSuite) TestExecMultipleRewardEvents() {
 	}
 	suite.am.AssertExpectations(suite.T())
 }
+
+func (suite *PostManagerTestSuite) TestExecRewardEventReputationPolicy() {
+	user1 := suite.user1
+	user2 := suite.user2
+	app1 := suite.app1
+	postID := "post1"
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+
+	suite.ph = &parammock.ParamKeeper{}
+	suite.pm.ph = suite.ph
+	suite.ph.On("GetPostParam", mock.Anything).Return(&param.PostParam{
+		ContentBonusPolicy: param.ReputationContentBonus,
+		ReputationWeight:   linotypes.NewDecFromRat(50, 100),
+	}, nil)
+	suite.am.On("GetPool", mock.Anything, linotypes.InflationConsumptionPool).Return(
+		linotypes.NewCoinFromInt64(10000), nil).Maybe()
+	suite.dev.On("ReportConsumption", mock.Anything, app1, mock.Anything).Return(nil).Maybe()
+
+	weight := func(v int64) *linotypes.MiniDollar {
+		w := linotypes.NewMiniDollar(v)
+		return &w
+	}
+	testCases := []struct {
+		testName                 string
+		weight                   *linotypes.MiniDollar
+		expectedReward           linotypes.Coin
+		expectedReputationWindow linotypes.MiniDollar
+	}{
+		{
+			testName:                 "share of weight in reputation window",
+			weight:                   weight(150),
+			expectedReward:           linotypes.NewCoinFromInt64(2500),
+			expectedReputationWindow: linotypes.NewMiniDollar(450),
+		},
+		{
+			testName:                 "high reputation donor of tiny donation",
+			weight:                   weight(500),
+			expectedReward:           linotypes.NewCoinFromInt64(8333),
+			expectedReputationWindow: linotypes.NewMiniDollar(100),
+		},
+		{
+			testName:                 "not blended falls back to consumption window",
+			weight:                   nil,
+			expectedReward:           linotypes.NewCoinFromInt64(3333),
+			expectedReputationWindow: linotypes.NewMiniDollar(600),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.testName, func() {
+			event := types.RewardEvent{
+				PostAuthor: user1,
+				PostID:     postID,
+				Consumer:   user2,
+				Evaluate:   linotypes.NewMiniDollar(100),
+				FromApp:    app1,
+				Weight:     tc.weight,
+			}
+			suite.pm.postStorage.SetConsumptionWindow(suite.Ctx, linotypes.NewMiniDollar(300))
+			suite.pm.postStorage.SetReputationWindow(suite.Ctx, linotypes.NewMiniDollar(600))
+			suite.am.On("MoveFromPool", mock.Anything, linotypes.InflationConsumptionPool,
+				linotypes.NewAccOrAddrFromAcc(user1), tc.expectedReward).Return(nil).Once()
+			err := suite.pm.ExecRewardEvent(suite.Ctx, event)
+			suite.Nil(err)
+			suite.Equal(linotypes.NewMiniDollar(200), suite.pm.GetComsumptionWindow(suite.Ctx))
+			suite.Equal(tc.expectedReputationWindow, suite.pm.postStorage.GetReputationWindow(suite.Ctx))
+			suite.am.AssertExpectations(suite.T())
+		})
+	}
+}
+
+func (suite *PostManagerTestSuite) TestDonateBlendsWeightUnderReputationPolicy() {
+	user1 := suite.user1
+	user2 := suite.user2
+	app1 := suite.app1
+	postID := "post1"
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+
+	suite.ph = &parammock.ParamKeeper{}
+	suite.pm.ph = suite.ph
+	suite.ph.On("GetPostParam", mock.Anything).Return(&param.PostParam{
+		ContentBonusPolicy: param.ReputationContentBonus,
+		ReputationWeight:   linotypes.NewDecFromRat(50, 100),
+	}, nil)
+	permlink := linotypes.GetPermlink(user1, postID)
+	eventTime := suite.Ctx.BlockHeader().Time.Unix() + linotypes.ConsumptionFreezingPeriodSec
+	suite.vote.On("RecordFriction", mock.Anything, linotypes.NewCoinFromInt64(1)).Return(nil)
+	suite.pm.postStorage.SetReputationWindow(suite.Ctx, linotypes.NewMiniDollar(1000))
+
+	// reputation of donor is capped by the impact.
+	suite.rep.On("DonateAt", mock.Anything, user2, permlink, linotypes.NewMiniDollar(100)).Return(
+		linotypes.NewMiniDollar(40), nil).Once()
+	suite.rep.On("GetReputation", mock.Anything, user2).Return(linotypes.NewMiniDollar(1000), nil).Once()
+	expected := linotypes.NewMiniDollar(40)
+	suite.global.On("RegisterEventAtTime", mock.Anything, eventTime, types.RewardEvent{
+		PostAuthor: user1,
+		PostID:     postID,
+		Consumer:   user2,
+		Evaluate:   linotypes.NewMiniDollar(40),
+		FromApp:    app1,
+		Weight:     &expected,
+	}).Return(nil).Once()
+	event, err := suite.pm.afterDonation(suite.Ctx, user1, postID, user2, linotypes.NewMiniDollar(100),
+		linotypes.NewCoinFromInt64(10), linotypes.NewMiniDollar(0), linotypes.NewCoinFromInt64(1), app1, 0)
+	suite.Nil(err)
+	suite.Equal(&expected, event.Weight)
+	// the donation's own weight is in the window it is divided by.
+	suite.Equal(linotypes.NewMiniDollar(1040), suite.pm.postStorage.GetReputationWindow(suite.Ctx))
+
+	// reputation of donor is used up, no impact and no weight.
+	suite.rep.On("DonateAt", mock.Anything, user2, permlink, linotypes.NewMiniDollar(100)).Return(
+		linotypes.NewMiniDollar(0), nil).Once()
+	suite.global.On("RegisterEventAtTime", mock.Anything, eventTime, types.RewardEvent{
+		PostAuthor: user1,
+		PostID:     postID,
+		Consumer:   user2,
+		Evaluate:   linotypes.NewMiniDollar(0),
+		FromApp:    app1,
+	}).Return(nil).Once()
+	event, err = suite.pm.afterDonation(suite.Ctx, user1, postID, user2, linotypes.NewMiniDollar(100),
+		linotypes.NewCoinFromInt64(10), linotypes.NewMiniDollar(0), linotypes.NewCoinFromInt64(1), app1, 0)
+	suite.Nil(err)
+	suite.Nil(event.Weight)
+	suite.Equal(linotypes.NewMiniDollar(1040), suite.pm.postStorage.GetReputationWindow(suite.Ctx))
+	suite.dev.On("ReportConsumption", mock.Anything, app1, mock.Anything).Return(nil).Maybe()
+	suite.Nil(suite.pm.ExecRewardEvent(suite.Ctx, event))
+	suite.Equal(linotypes.NewMiniDollar(1040), suite.pm.postStorage.GetReputationWindow(suite.Ctx))
+	suite.global.AssertExpectations(suite.T())
+	suite.rep.AssertExpectations(suite.T())
+}
+
+func (suite *PostManagerTestSuite) TestExecRewardEventOfDeletedPostReleasesWeight() {
+	user1 := suite.user1
+	postID := "post1"
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, suite.app1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	suite.Require().Nil(suite.pm.DeletePost(suite.Ctx, linotypes.GetPermlink(user1, postID)))
+
+	weight := linotypes.NewMiniDollar(40)
+	suite.pm.postStorage.SetReputationWindow(suite.Ctx, linotypes.NewMiniDollar(100))
+	err = suite.pm.ExecRewardEvent(suite.Ctx, types.RewardEvent{
+		PostAuthor: user1,
+		PostID:     postID,
+		Consumer:   suite.user2,
+		Evaluate:   linotypes.NewMiniDollar(40),
+		FromApp:    suite.app1,
+		Weight:     &weight,
+	})
+	suite.Nil(err)
+	suite.Equal(linotypes.NewMiniDollar(60), suite.pm.postStorage.GetReputationWindow(suite.Ctx))
+}
+
+func (suite *PostManagerTestSuite) TestGetContentBonusDryRun() {
+	user1 := suite.user1
+	user2 := suite.user2
+	app1 := suite.app1
+	postID := "post1"
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	suite.pm.postStorage.SetConsumptionWindow(suite.Ctx, linotypes.NewMiniDollar(300))
+	suite.pm.postStorage.SetReputationWindow(suite.Ctx, linotypes.NewMiniDollar(600))
+	suite.am.On("GetPool", mock.Anything, linotypes.InflationConsumptionPool).Return(
+		linotypes.NewCoinFromInt64(10000), nil).Maybe()
+	suite.rep.On("GetReputation", mock.Anything, user2).Return(linotypes.NewMiniDollar(50), nil).Maybe()
+
+	// the event is a new donation, whose weight is added to the window.
+	event := types.RewardEvent{
+		PostAuthor: user1,
+		PostID:     postID,
+		Consumer:   user2,
+		Evaluate:   linotypes.NewMiniDollar(100),
+	}
+	rst, err := suite.pm.GetContentBonusDryRun(suite.Ctx, event)
+	suite.Nil(err)
+	suite.Equal(&model.ContentBonusDryRun{
+		Policy:            param.ConsumptionContentBonus,
+		ConsumptionWindow: linotypes.NewMiniDollar(300),
+		ReputationWindow:  linotypes.NewMiniDollar(600),
+		RewardPool:        linotypes.NewCoinFromInt64(10000),
+		Bonuses: []model.ContentBonus{
+			{
+				Policy: param.ConsumptionContentBonus,
+				Weight: linotypes.NewMiniDollar(100),
+				Bonus:  linotypes.NewCoinFromInt64(2500),
+			},
+			{
+				Policy: param.ReputationContentBonus,
+				Weight: linotypes.NewMiniDollar(75),
+				Bonus:  linotypes.NewCoinFromInt64(1111),
+			},
+		},
+	}, rst)
+	// dry run does not change consumption window.
+	suite.Equal(linotypes.NewMiniDollar(300), suite.pm.GetComsumptionWindow(suite.Ctx))
+
+	// the first donation in empty windows takes the whole pool.
+	suite.pm.postStorage.SetConsumptionWindow(suite.Ctx, linotypes.NewMiniDollar(0))
+	suite.pm.postStorage.SetReputationWindow(suite.Ctx, linotypes.NewMiniDollar(0))
+	rst, err = suite.pm.GetContentBonusDryRun(suite.Ctx, event)
+	suite.Nil(err)
+	for _, bonus := range rst.Bonuses {
+		suite.Equal(linotypes.NewCoinFromInt64(10000), bonus.Bonus, "%s", bonus.Policy)
+	}
+
+	event.PostID = "notexist"
+	_, err = suite.pm.GetContentBonusDryRun(suite.Ctx, event)
+	suite.Equal(types.ErrPostNotFound(linotypes.GetPermlink(user1, "notexist")), err)
+}
@@ -0,0 +1,84 @@
+package manager
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+)
+
+// maxPostsPerPage - maximum number of posts returned by one query of post indexes.
+const maxPostsPerPage = 100
+
+// indexPost - append the post to indexes of its author, its tags and the app
+// that created it, if not created by the author.
+func (pm PostManager) indexPost(ctx sdk.Context, post *model.Post) {
+	permlink := linotypes.GetPermlink(post.Author, post.PostID)
+	pm.postStorage.AddPostToIndex(ctx, model.PostByAuthorSubStore, string(post.Author), permlink)
+	if post.CreatedBy != post.Author {
+		pm.postStorage.AddPostToIndex(ctx, model.PostByAppSubStore, string(post.CreatedBy), permlink)
+	}
+	for _, tag := range post.Tags {
+		pm.postStorage.AddPostToIndex(ctx, model.PostByTagSubStore, tag, permlink)
+	}
+}
+
+// rebuildIndexes - indexes are not exported, they are rebuilt from posts
+// in order of creation time, and permlink for posts created at the same time.
+func (pm PostManager) rebuildIndexes(ctx sdk.Context, posts []model.Post) {
+	sort.SliceStable(posts, func(i, j int) bool {
+		if posts[i].CreatedAt != posts[j].CreatedAt {
+			return posts[i].CreatedAt < posts[j].CreatedAt
+		}
+		return linotypes.GetPermlink(posts[i].Author, posts[i].PostID) <
+			linotypes.GetPermlink(posts[j].Author, posts[j].PostID)
+	})
+	for i := range posts {
+		pm.indexPost(ctx, &posts[i])
+	}
+}
+
+// GetPostsByAuthor - return at most limit posts of the author, starting from the start-th one.
+func (pm PostManager) GetPostsByAuthor(ctx sdk.Context, author linotypes.AccountKey, start, limit int64) (*model.PostList, sdk.Error) {
+	return pm.getPostList(ctx, model.PostByAuthorSubStore, string(author), start, limit)
+}
+
+// GetPostsByApp - return at most limit posts created by the app, starting from the start-th one.
+// Posts created by authors themselves are not indexed by app.
+func (pm PostManager) GetPostsByApp(ctx sdk.Context, app linotypes.AccountKey, start, limit int64) (*model.PostList, sdk.Error) {
+	return pm.getPostList(ctx, model.PostByAppSubStore, string(app), start, limit)
+}
+
+// GetPostsByTag - return at most limit posts with the tag, starting from the start-th one.
+func (pm PostManager) GetPostsByTag(ctx sdk.Context, tag string, start, limit int64) (*model.PostList, sdk.Error) {
+	return pm.getPostList(ctx, model.PostByTagSubStore, tag, start, limit)
+}
+
+func (pm PostManager) getPostList(ctx sdk.Context, index []byte, key string, start, limit int64) (*model.PostList, sdk.Error) {
+	if start < 0 || limit <= 0 {
+		return nil, linotypes.ErrInvalidQueryPath()
+	}
+	if limit > maxPostsPerPage {
+		limit = maxPostsPerPage
+	}
+	total := pm.postStorage.GetPostIndexSize(ctx, index, key)
+	rst := &model.PostList{
+		Total: total,
+		Start: start,
+		Posts: make([]model.Post, 0),
+	}
+	for i := start; i < total && i < start+limit; i++ {
+		permlink, err := pm.postStorage.GetPostFromIndex(ctx, index, key, i)
+		if err != nil {
+			return nil, err
+		}
+		post, err := pm.postStorage.GetPost(ctx, permlink)
+		if err != nil {
+			return nil, err
+		}
+		rst.Posts = append(rst.Posts, *post)
+	}
+	return rst, nil
+}
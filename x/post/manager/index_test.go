@@ -0,0 +1,141 @@
+package manager
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	codec "github.com/cosmos/cosmos-sdk/codec"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+)
+
+func (suite *PostManagerTestSuite) TestPostIndexes() {
+	user1 := suite.user1
+	user2 := suite.user2
+	app1 := suite.app1
+	// post1 and post2 are created by app1, post2 by its affiliated account.
+	err := suite.pm.CreatePost(suite.Ctx, user1, "post1", app1, "content", "title", "", nil, nil, []string{"music", "live"})
+	suite.Require().Nil(err)
+	suite.NextBlock(time.Unix(suite.Ctx.BlockHeader().Time.Unix()+1, 0))
+	err = suite.pm.CreatePost(suite.Ctx, user2, "post2", suite.app1affiliated, "content", "title", "", nil, nil, []string{"music"})
+	suite.Require().Nil(err)
+	suite.NextBlock(time.Unix(suite.Ctx.BlockHeader().Time.Unix()+1, 0))
+	err = suite.pm.CreatePost(suite.Ctx, user1, "post3", user1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	err = suite.pm.DeletePost(suite.Ctx, linotypes.GetPermlink(user1, "post3"))
+	suite.Require().Nil(err)
+
+	permlinks := func(list *model.PostList) []linotypes.Permlink {
+		rst := make([]linotypes.Permlink, 0)
+		for _, post := range list.Posts {
+			rst = append(rst, linotypes.GetPermlink(post.Author, post.PostID))
+		}
+		return rst
+	}
+	post1 := linotypes.GetPermlink(user1, "post1")
+	post2 := linotypes.GetPermlink(user2, "post2")
+	post3 := linotypes.GetPermlink(user1, "post3")
+
+	testCases := []struct {
+		testName  string
+		query     func(start, limit int64) (*model.PostList, error)
+		start     int64
+		limit     int64
+		total     int64
+		permlinks []linotypes.Permlink
+	}{
+		{
+			testName: "by author, deleted post kept",
+			query: func(start, limit int64) (*model.PostList, error) {
+				return suite.pm.GetPostsByAuthor(suite.Ctx, user1, start, limit)
+			},
+			start:     0,
+			limit:     10,
+			total:     2,
+			permlinks: []linotypes.Permlink{post1, post3},
+		},
+		{
+			testName: "by author, second page",
+			query: func(start, limit int64) (*model.PostList, error) {
+				return suite.pm.GetPostsByAuthor(suite.Ctx, user1, start, limit)
+			},
+			start:     1,
+			limit:     1,
+			total:     2,
+			permlinks: []linotypes.Permlink{post3},
+		},
+		{
+			testName: "by app, self created posts not indexed",
+			query: func(start, limit int64) (*model.PostList, error) {
+				return suite.pm.GetPostsByApp(suite.Ctx, app1, start, limit)
+			},
+			start:     0,
+			limit:     10,
+			total:     2,
+			permlinks: []linotypes.Permlink{post1, post2},
+		},
+		{
+			testName: "by tag",
+			query: func(start, limit int64) (*model.PostList, error) {
+				return suite.pm.GetPostsByTag(suite.Ctx, "music", start, limit)
+			},
+			start:     0,
+			limit:     10,
+			total:     2,
+			permlinks: []linotypes.Permlink{post1, post2},
+		},
+		{
+			testName: "by tag, start out of range",
+			query: func(start, limit int64) (*model.PostList, error) {
+				return suite.pm.GetPostsByTag(suite.Ctx, "live", start, limit)
+			},
+			start:     1,
+			limit:     10,
+			total:     1,
+			permlinks: []linotypes.Permlink{},
+		},
+		{
+			testName: "unknown tag",
+			query: func(start, limit int64) (*model.PostList, error) {
+				return suite.pm.GetPostsByTag(suite.Ctx, "news", start, limit)
+			},
+			start:     0,
+			limit:     10,
+			total:     0,
+			permlinks: []linotypes.Permlink{},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.testName, func() {
+			rst, err := tc.query(tc.start, tc.limit)
+			suite.Require().Nil(err)
+			suite.Equal(tc.total, rst.Total)
+			suite.Equal(tc.start, rst.Start)
+			suite.Equal(tc.permlinks, permlinks(rst))
+		})
+	}
+
+	_, err = suite.pm.GetPostsByAuthor(suite.Ctx, user1, -1, 10)
+	suite.Equal(linotypes.ErrInvalidQueryPath(), err)
+	_, err = suite.pm.GetPostsByAuthor(suite.Ctx, user1, 0, 0)
+	suite.Equal(linotypes.ErrInvalidQueryPath(), err)
+
+	// indexes are rebuilt in creation order on import.
+	cdc := codec.New()
+	dir, err2 := ioutil.TempDir("", "test")
+	suite.Require().Nil(err2)
+	defer os.RemoveAll(dir) // clean up
+	tmpfn := filepath.Join(dir, "tmpfile")
+	suite.Require().Nil(suite.pm.ExportToFile(suite.Ctx, cdc, tmpfn))
+	suite.SetupTest()
+	suite.Require().Nil(suite.pm.ImportFromFile(suite.Ctx, cdc, tmpfn))
+	for _, tc := range testCases {
+		rst, err := tc.query(tc.start, tc.limit)
+		suite.Require().Nil(err)
+		suite.Equal(tc.total, rst.Total, tc.testName)
+		suite.Equal(tc.permlinks, permlinks(rst), tc.testName)
+	}
+}
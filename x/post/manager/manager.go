@@ -6,6 +6,7 @@ import (
 	codec "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/lino-network/lino/param"
 	linotypes "github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/utils"
 	acc "github.com/lino-network/lino/x/account"
@@ -19,14 +20,20 @@ import (
 )
 
 const (
-	exportVersion = 2
-	importVersion = 2
+	exportVersion = 6
+	importVersion = 6
+	// postOnlyVersion - export of posts and consumption window only.
+	postOnlyVersion = 2
+
+	// maxRepliesPerPage - maximum number of replies returned by one GetReplies.
+	maxRepliesPerPage = 100
 )
 
 type PostManager struct {
 	postStorage model.PostStorage
 
 	// deps
+	ph    param.ParamKeeper
 	am    acc.AccountKeeper
 	gm    global.GlobalKeeper
 	dev   dev.DeveloperKeeper
@@ -36,9 +43,10 @@ type PostManager struct {
 }
 
 // NewPostManager - create a new post manager
-func NewPostManager(key sdk.StoreKey, am acc.AccountKeeper, gm global.GlobalKeeper, dev dev.DeveloperKeeper, rep rep.ReputationKeeper, price price.PriceKeeper, vote vote.VoteKeeper) PostManager {
+func NewPostManager(key sdk.StoreKey, ph param.ParamKeeper, am acc.AccountKeeper, gm global.GlobalKeeper, dev dev.DeveloperKeeper, rep rep.ReputationKeeper, price price.PriceKeeper, vote vote.VoteKeeper) PostManager {
 	return PostManager{
 		postStorage: model.NewPostStorage(key),
+		ph:          ph,
 		am:          am,
 		gm:          gm,
 		dev:         dev,
@@ -77,17 +85,38 @@ func (pm PostManager) GetPost(ctx sdk.Context, permlink linotypes.Permlink) (mod
 // 1. both author and post id exists.
 // 2. if createdBy is not author, then it must be an app.
 // 3. post's permlink does not exists.
-func (pm PostManager) CreatePost(ctx sdk.Context, author linotypes.AccountKey, postID string, createdBy linotypes.AccountKey, content string, title string) sdk.Error {
+// 4. if parent is not empty, parent exists and is neither deleted nor censored.
+// 5. beneficiaries exist.
+// If ref is not nil, the content is stored off chain and content is empty.
+// The post is indexed by its author, its tags and the app that created it.
+func (pm PostManager) CreatePost(ctx sdk.Context, author linotypes.AccountKey, postID string, createdBy linotypes.AccountKey, content string, title string, parent linotypes.Permlink, beneficiaries []types.Beneficiary, ref *types.ContentRef, tags []string) sdk.Error {
 	if !pm.am.DoesAccountExist(ctx, author) {
 		return types.ErrAccountNotFound(author)
 	}
 	if !pm.am.DoesAccountExist(ctx, createdBy) {
 		return types.ErrAccountNotFound(createdBy)
 	}
+	if err := pm.validateBeneficiaries(ctx, author, beneficiaries); err != nil {
+		return err
+	}
 	permlink := linotypes.GetPermlink(author, postID)
 	if pm.postStorage.HasPost(ctx, permlink) {
 		return types.ErrPostAlreadyExist(permlink)
 	}
+	var parentInfo *model.Post
+	if len(parent) > 0 {
+		var err sdk.Error
+		parentInfo, err = pm.postStorage.GetPost(ctx, parent)
+		if err != nil {
+			return err
+		}
+		if parentInfo.IsDeleted {
+			return types.ErrPostDeleted(parent)
+		}
+		if parentInfo.IsCensored {
+			return types.ErrPostCensored(parent)
+		}
+	}
 	if author != createdBy {
 		// if created by app, then createdBy must either be the app or an affiliated account of app.
 		dev := createdBy
@@ -107,8 +136,20 @@ func (pm PostManager) CreatePost(ctx sdk.Context, author linotypes.AccountKey, p
 		CreatedBy: createdBy,
 		CreatedAt: createdAt,
 		UpdatedAt: createdAt,
+
+		ParentPermlink: parent,
+		Beneficiaries:  beneficiaries,
+		ContentRef:     ref,
+		Tags:           tags,
 	}
 	pm.postStorage.SetPost(ctx, postInfo)
+	pm.postStorage.SetRevision(ctx, permlink, pm.newRevision(ctx, postInfo))
+	pm.indexPost(ctx, postInfo)
+	if parentInfo != nil {
+		pm.postStorage.SetReply(ctx, parent, parentInfo.ReplyCount, permlink)
+		parentInfo.ReplyCount++
+		pm.postStorage.SetPost(ctx, parentInfo)
+	}
 	return nil
 }
 
@@ -116,17 +157,36 @@ func (pm PostManager) CreatePost(ctx sdk.Context, author linotypes.AccountKey, p
 // stateful validation:
 // 1. author exist.
 // 2. post exist.
-func (pm PostManager) UpdatePost(ctx sdk.Context, author linotypes.AccountKey, postID, title, content string) sdk.Error {
+// 3. post is not censored.
+// 4. beneficiaries exist.
+// A new revision is recorded, previous revisions are kept in history.
+// Beneficiaries of the post are replaced if not nil, an empty list removes them all,
+// they apply to later donations and their content bonus.
+// So is the content reference, a post can switch between inline and referenced content.
+func (pm PostManager) UpdatePost(ctx sdk.Context, author linotypes.AccountKey, postID, title, content string, beneficiaries []types.Beneficiary, ref *types.ContentRef) sdk.Error {
 	permlink := linotypes.GetPermlink(author, postID)
 	postInfo, err := pm.postStorage.GetPost(ctx, permlink)
 	if err != nil {
 		// post not exists
 		return err
 	}
+	if postInfo.IsCensored {
+		return types.ErrPostCensored(permlink)
+	}
+	if beneficiaries != nil {
+		if err := pm.validateBeneficiaries(ctx, author, beneficiaries); err != nil {
+			return err
+		}
+		postInfo.Beneficiaries = beneficiaries
+	}
+	pm.getOrInitRevision(ctx, postInfo)
 	postInfo.Title = title
 	postInfo.Content = content
+	postInfo.ContentRef = ref
 	postInfo.UpdatedAt = ctx.BlockHeader().Time.Unix()
+	postInfo.Revision++
 	pm.postStorage.SetPost(ctx, postInfo)
+	pm.postStorage.SetRevision(ctx, permlink, pm.newRevision(ctx, postInfo))
 	return nil
 }
 
@@ -139,6 +199,11 @@ func (pm PostManager) UpdatePost(ctx sdk.Context, author linotypes.AccountKey, p
 // 1. manager.DoesPostExist will return false.
 // 2. manager.GetPost will return ErrPermlinkDeleted.
 // 3. manager.CreatePost will return ErrPostAlreadyExist.
+// Parent and reply count are kept, so are the post's position in its parent's
+// replies and its own replies, so that the thread keeps its shape.
+// Replying to a deleted post is not allowed.
+// Content hashes of revisions are kept, while their title and content are cleared,
+// so are content references except their hashes.
 func (pm PostManager) DeletePost(ctx sdk.Context, permlink linotypes.Permlink) sdk.Error {
 	post, err := pm.postStorage.GetPost(ctx, permlink)
 	if err != nil {
@@ -147,9 +212,24 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink linotypes.Permlink) s
 	if post.IsDeleted {
 		return types.ErrPostDeleted(permlink)
 	}
+	pm.getOrInitRevision(ctx, post)
+	for i := int64(0); i <= post.Revision; i++ {
+		rev, err := pm.postStorage.GetRevision(ctx, permlink, i)
+		if err != nil {
+			// revisions before history was recorded.
+			continue
+		}
+		rev.Title = ""
+		rev.Content = ""
+		if rev.ContentRef != nil {
+			rev.ContentRef = &types.ContentRef{Hash: rev.ContentRef.Hash}
+		}
+		pm.postStorage.SetRevision(ctx, permlink, rev)
+	}
 	post.IsDeleted = true
 	post.Title = ""
 	post.Content = ""
+	post.ContentRef = nil
 	pm.postStorage.SetPost(ctx, post)
 	return nil
 }
@@ -162,39 +242,87 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink linotypes.Permlink) s
 // 4. if app is not empty, then developer must exist.
 // 5. amount positive > 0.
 // 6. 9.9% of amount > 0 coin.
-func (pm PostManager) LinoDonate(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey) sdk.Error {
+func (pm PostManager) LinoDonate(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey, memo string) sdk.Error {
+	_, err := pm.linoDonate(ctx, from, amount, author, postID, app, memo, false)
+	return err
+}
+
+// linoDonate - donate lino, if escrow is true, shares of the author and beneficiaries
+// are held in the escrow pool, and the id of the escrowed donation is returned.
+func (pm PostManager) linoDonate(ctx sdk.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey, memo string, escrow bool) (int64, sdk.Error) {
 	if err := pm.validateLinoDonation(ctx, from, amount, author, postID, app); err != nil {
-		return err
+		return 0, err
 	}
 	// donation.
 	rate := sdk.MustNewDecFromStr(linotypes.ConsumptionFrictionRate)
 	frictionCoin := linotypes.DecToCoin(amount.ToDec().Mul(rate))
 	if frictionCoin.IsZero() {
-		return types.ErrDonateAmountTooLittle()
+		return 0, types.ErrDonateAmountTooLittle()
 	}
 	// friction goes to the friction pool for voters.
 	err := pm.am.MoveToPool(ctx,
 		linotypes.VoteFrictionPool, linotypes.NewAccOrAddrFromAcc(from), frictionCoin)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	// rest goes to the author.
-	err = pm.am.MoveCoin(ctx, linotypes.NewAccOrAddrFromAcc(from),
-		linotypes.NewAccOrAddrFromAcc(author), amount.Minus(frictionCoin))
+	// rest goes to the author and beneficiaries, or the escrow pool.
+	post, err := pm.getDonationTarget(ctx, author, postID)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	shares := types.SplitAmount(author, post.Beneficiaries, amount.Minus(frictionCoin).Amount)
+	for _, share := range shares {
+		if escrow {
+			err = pm.am.MoveToPool(ctx, linotypes.PostDonationEscrowPool,
+				linotypes.NewAccOrAddrFromAcc(from), linotypes.NewCoin(share.Amount))
+		} else {
+			err = pm.am.MoveCoin(ctx, linotypes.NewAccOrAddrFromAcc(from),
+				linotypes.NewAccOrAddrFromAcc(share.Account), linotypes.NewCoin(share.Amount))
+		}
+		if err != nil {
+			return 0, err
+		}
 	}
 
 	mdamount, err := pm.price.CoinToMiniDollar(ctx, amount)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return pm.afterDonation(ctx, author, postID, from, mdamount, frictionCoin, app)
+	escrowID := int64(0)
+	if escrow {
+		escrowID = pm.postStorage.GetEscrowNextID(ctx)
+		pm.postStorage.SetEscrowNextID(ctx, escrowID+1)
+	}
+	event, err := pm.afterDonation(ctx, author, postID, from, mdamount, amount, linotypes.NewMiniDollar(0), frictionCoin, app, escrowID)
+	if err != nil {
+		return 0, err
+	}
+	if escrow {
+		now := ctx.BlockHeader().Time.Unix()
+		pm.postStorage.SetEscrowedDonation(ctx, &model.EscrowedDonation{
+			ID:            escrowID,
+			From:          from,
+			Author:        author,
+			PostID:        postID,
+			Revision:      post.Revision,
+			App:           app,
+			Amount:        amount,
+			Shares:        shares,
+			Dollar:        mdamount,
+			Impact:        event.Evaluate,
+			CreatedAt:     now,
+			ReleaseAt:     now + linotypes.ConsumptionFreezingPeriodSec,
+			Weight:        event.Weight,
+			Beneficiaries: event.Beneficiaries,
+		})
+	}
+	pm.recordRecentDonation(ctx, linotypes.GetPermlink(author, postID), from, amount.Amount, types.CurrencyLino, app, memo, escrowID)
+	return escrowID, nil
 }
 
 // IDADonate - handle IDA donation.
-func (pm PostManager) IDADonate(ctx sdk.Context, from linotypes.AccountKey, n linotypes.MiniIDA, author linotypes.AccountKey, postID string, app, signer linotypes.AccountKey) sdk.Error {
+func (pm PostManager) IDADonate(ctx sdk.Context, from linotypes.AccountKey, n linotypes.MiniIDA, author linotypes.AccountKey, postID string, app, signer linotypes.AccountKey, memo string) sdk.Error {
 	if err := pm.validateIDADonate(ctx, from, n, author, postID, app); err != nil {
 		return err
 	}
@@ -232,50 +360,129 @@ func (pm PostManager) IDADonate(ctx sdk.Context, from linotypes.AccountKey, n li
 		return err
 	}
 
-	// rest goes to the author
-	if err := pm.dev.MoveIDA(ctx, app, from, author, dollarAmount.Minus(tax)); err != nil {
+	// rest goes to the author and beneficiaries.
+	post, err := pm.getDonationTarget(ctx, author, postID)
+	if err != nil {
+		return err
+	}
+	for _, share := range types.SplitAmount(author, post.Beneficiaries, dollarAmount.Minus(tax).Int) {
+		err := pm.dev.MoveIDA(ctx, app, from, share.Account, linotypes.NewMiniDollarFromInt(share.Amount))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = pm.afterDonation(ctx, author, postID, from, dollarAmount, linotypes.NewCoinFromInt64(0), dollarAmount, taxcoins, app, 0)
+	if err != nil {
 		return err
 	}
+	pm.recordRecentDonation(ctx, linotypes.GetPermlink(author, postID), from, n, types.CurrencyIDA, app, memo, 0)
+	return nil
+}
+
+// BatchLinoDonate - donate lino to posts of entries, each donation is handled as in
+// LinoDonate with its own friction and reward event, all succeed or none.
+func (pm PostManager) BatchLinoDonate(ctx sdk.Context, from linotypes.AccountKey, entries []types.DonateEntry, app linotypes.AccountKey, memo string) sdk.Error {
+	cacheCtx, write := ctx.CacheContext()
+	for _, entry := range entries {
+		amount, err := linotypes.LinoToCoin(entry.Amount)
+		if err != nil {
+			return err
+		}
+		if err := pm.LinoDonate(cacheCtx, from, amount, entry.Author, entry.PostID, app, memo); err != nil {
+			return err
+		}
+	}
+	write()
+	return nil
+}
 
-	return pm.afterDonation(ctx, author, postID, from, dollarAmount, taxcoins, app)
+// BatchIDADonate - donate IDA to posts of entries, each donation is handled as in
+// IDADonate with its own friction and reward event, all succeed or none.
+func (pm PostManager) BatchIDADonate(ctx sdk.Context, from linotypes.AccountKey, entries []types.DonateEntry, app, signer linotypes.AccountKey, memo string) sdk.Error {
+	cacheCtx, write := ctx.CacheContext()
+	for _, entry := range entries {
+		n, err := linotypes.IDAStr(entry.Amount).ToMiniIDA()
+		if err != nil {
+			return err
+		}
+		if err := pm.IDADonate(cacheCtx, from, n, entry.Author, entry.PostID, app, signer, memo); err != nil {
+			return err
+		}
+	}
+	write()
+	return nil
 }
 
-func (pm PostManager) afterDonation(ctx sdk.Context, author linotypes.AccountKey, postID string, from linotypes.AccountKey, damount linotypes.MiniDollar, friction linotypes.Coin, app linotypes.AccountKey) sdk.Error {
+// afterDonation - damount is the donation in MiniDollar, which is either
+// lino in LINO or ida in MiniDollar, friction included. escrowID is the escrowed
+// donation released by the reward event, 0 if not escrowed. Returns the registered reward event.
+func (pm PostManager) afterDonation(ctx sdk.Context, author linotypes.AccountKey, postID string, from linotypes.AccountKey, damount linotypes.MiniDollar, lino linotypes.Coin, ida linotypes.MiniDollar, friction linotypes.Coin, app linotypes.AccountKey, escrowID int64) (types.RewardEvent, sdk.Error) {
+	rewardEvent := types.RewardEvent{
+		PostAuthor: author,
+		PostID:     postID,
+		Consumer:   from,
+		FromApp:    app,
+		EscrowID:   escrowID,
+	}
 	// impact is the evaluated consumption.
 	impact, err := pm.rep.DonateAt(ctx, from, linotypes.GetPermlink(author, postID), damount)
 	if err != nil {
-		return err
+		return rewardEvent, err
+	}
+	rewardEvent.Evaluate = impact
+
+	// record donation on the revision that the donor paid for, channels have no revision.
+	post, err := pm.getDonationTarget(ctx, author, postID)
+	if err != nil {
+		return rewardEvent, err
 	}
+	rewardEvent.Beneficiaries = post.Beneficiaries
+	if postID != types.ChannelPostID {
+		rev := pm.getOrInitRevision(ctx, post)
+		rev.Donations = rev.Donations.Plus(damount)
+		rev.NumDonations++
+		pm.postStorage.SetRevision(ctx, linotypes.GetPermlink(author, postID), rev)
+	}
+	pm.recordDonation(ctx, post, from, lino, ida, impact)
 
 	// update consumptionm window
 	consumptionWindow := pm.postStorage.GetConsumptionWindow(ctx)
 	pm.postStorage.SetConsumptionWindow(ctx, consumptionWindow.Plus(impact))
 
+	// under the reputation policy, the weight is blended now and kept in the
+	// reputation window, so that the window sums the same weights the events are paid by.
+	// donations without impact are not paid, so they have no weight.
+	postParam := pm.getPostParam(ctx)
+	if postParam.ContentBonusPolicy == param.ReputationContentBonus && !impact.IsZero() {
+		weight, err := pm.reputationWeight(ctx, postParam, from, impact)
+		if err != nil {
+			return rewardEvent, err
+		}
+		rewardEvent.Weight = &weight
+		reputationWindow := pm.postStorage.GetReputationWindow(ctx)
+		pm.postStorage.SetReputationWindow(ctx, reputationWindow.Plus(weight))
+	}
+
 	// record friction stats.
 	err = pm.vote.RecordFriction(ctx, friction)
 	if err != nil {
-		return err
+		return rewardEvent, err
 	}
 
 	// add content bonus return event.
-	rewardEvent := types.RewardEvent{
-		PostAuthor: author,
-		PostID:     postID,
-		Consumer:   from,
-		Evaluate:   impact,
-		FromApp:    app,
-	}
 	eventTime := ctx.BlockHeader().Time.Unix() + linotypes.ConsumptionFreezingPeriodSec
 	if err := pm.gm.RegisterEventAtTime(ctx, eventTime, rewardEvent); err != nil {
-		return err
+		return rewardEvent, err
 	}
-	return nil
+	return rewardEvent, nil
 }
 
 // donation stateful basic validation:
-// 1. post exits
+// 1. post exits, unless the target is the channel of author.
 // 2. from/to account exists.
 // 3. no self donation.
+// 4. post is not censored.
 func (pm PostManager) validateDonationBasic(ctx sdk.Context, from linotypes.AccountKey, author linotypes.AccountKey, postID string) sdk.Error {
 	if from == author {
 		return types.ErrCannotDonateToSelf(from)
@@ -286,10 +493,16 @@ func (pm PostManager) validateDonationBasic(ctx sdk.Context, from linotypes.Acco
 	if !pm.am.DoesAccountExist(ctx, author) {
 		return types.ErrAccountNotFound(author)
 	}
+	if postID == types.ChannelPostID {
+		return nil
+	}
 	permlink := linotypes.GetPermlink(author, postID)
 	if !pm.DoesPostExist(ctx, permlink) {
 		return types.ErrPostNotFound(permlink)
 	}
+	if post, _ := pm.postStorage.GetPost(ctx, permlink); post.IsCensored {
+		return types.ErrPostCensored(permlink)
+	}
 	return nil
 }
 
@@ -331,12 +544,28 @@ func (pm PostManager) validateIDADonate(ctx sdk.Context, from linotypes.AccountK
 
 // ExecRewardEvent - execute reward events.
 func (pm PostManager) ExecRewardEvent(ctx sdk.Context, event types.RewardEvent) sdk.Error {
+	// escrowed shares are released even if the post is deleted, as if not escrowed.
+	if event.EscrowID != 0 {
+		if err := pm.releaseEscrow(ctx, event.EscrowID); err != nil {
+			return err
+		}
+	}
+	// the weight leaves the reputation window even if the event is skipped,
+	// otherwise the window only grows.
+	reputationWindow := pm.postStorage.GetReputationWindow(ctx)
+	if event.Weight != nil {
+		pm.postStorage.SetReputationWindow(ctx, reputationWindow.Minus(*event.Weight))
+	}
 	// check if post is deleted, Note that if post is deleted, it's ok to just
 	// skip this event. It does not return an error because errors will panic in events.
 	permlink := linotypes.GetPermlink(event.PostAuthor, event.PostID)
-	if !pm.DoesPostExist(ctx, permlink) {
+	if event.PostID != types.ChannelPostID && !pm.DoesPostExist(ctx, permlink) {
 		return nil
 	}
+	post, err := pm.getDonationTarget(ctx, event.PostAuthor, event.PostID)
+	if err != nil {
+		return err
+	}
 
 	// if developer exist, add to developer consumption
 	if pm.dev.DoesDeveloperExist(ctx, event.FromApp) {
@@ -344,10 +573,15 @@ func (pm PostManager) ExecRewardEvent(ctx sdk.Context, event types.RewardEvent)
 		_ = pm.dev.ReportConsumption(ctx, event.FromApp, event.Evaluate)
 	}
 
-	return pm.allocContentBonus(ctx, event.Evaluate, event.PostAuthor)
+	return pm.allocContentBonus(ctx, event, post, reputationWindow)
 }
 
-func (pm PostManager) allocContentBonus(ctx sdk.Context, impact linotypes.MiniDollar, author linotypes.AccountKey) sdk.Error {
+// allocContentBonus - content bonus is split among the author and beneficiaries of the post
+// when the donation was made,
+// content bonus of censored posts is redirected to the treasury pool.
+// reputationWindow is the reputation window before the weight of the event left it.
+func (pm PostManager) allocContentBonus(ctx sdk.Context, event types.RewardEvent, post *model.Post, reputationWindow linotypes.MiniDollar) sdk.Error {
+	impact := event.Evaluate
 	if impact.IsZero() {
 		return nil
 	}
@@ -356,21 +590,246 @@ func (pm PostManager) allocContentBonus(ctx sdk.Context, impact linotypes.MiniDo
 	consumptionWindow := pm.postStorage.GetConsumptionWindow(ctx)
 	pm.postStorage.SetConsumptionWindow(ctx, consumptionWindow.Minus(impact))
 
-	// XXX(yumin): the ratio is zero when the window is zero, because the consumption window
-	// as the sum of past donation impacts, shall be large than zero as the @p impact is nonzero.
-	// consumptionRatio = (this consumption * penalty score) / (total consumption in 7 days window)
-	consumptionRatio := sdk.ZeroDec()
-	if !consumptionWindow.ToDec().IsZero() {
-		consumptionRatio = impact.ToDec().Quo(consumptionWindow.ToDec())
+	// events blended under the reputation policy are paid by their weight in the
+	// reputation window, others by their impact in the consumption window,
+	// whatever the current policy is.
+	weight, window := impact, consumptionWindow
+	if event.Weight != nil {
+		weight, window = *event.Weight, reputationWindow
 	}
 	rewardPool, err := pm.am.GetPool(ctx, linotypes.InflationConsumptionPool)
 	if err != nil {
 		return err
 	}
-	// reward = (consumption reward pool) * (consumptionRatio)
-	reward := linotypes.DecToCoin(rewardPool.ToDec().Mul(consumptionRatio))
-	return pm.am.MoveFromPool(ctx,
-		linotypes.InflationConsumptionPool, linotypes.NewAccOrAddrFromAcc(author), reward)
+	reward := contentBonus(weight, window, rewardPool)
+	if post.IsCensored {
+		return pm.am.MoveBetweenPools(ctx,
+			linotypes.InflationConsumptionPool, linotypes.InflationTreasuryPool, reward)
+	}
+	pm.recordContentBonus(ctx, post, reward)
+	for _, share := range types.SplitAmount(event.PostAuthor, event.Beneficiaries, reward.Amount) {
+		err := pm.am.MoveFromPool(ctx, linotypes.InflationConsumptionPool,
+			linotypes.NewAccOrAddrFromAcc(share.Account), linotypes.NewCoin(share.Amount))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateBeneficiaries - beneficiaries are valid and exist.
+func (pm PostManager) validateBeneficiaries(ctx sdk.Context, author linotypes.AccountKey, beneficiaries []types.Beneficiary) sdk.Error {
+	if err := types.ValidateBeneficiaries(author, beneficiaries); err != nil {
+		return err
+	}
+	for _, b := range beneficiaries {
+		if !pm.am.DoesAccountExist(ctx, b.Account) {
+			return types.ErrAccountNotFound(b.Account)
+		}
+	}
+	return nil
+}
+
+// getPostParam - post param, params stored before content bonus policy was
+// introduced fall back to consumption policy.
+func (pm PostManager) getPostParam(ctx sdk.Context) *param.PostParam {
+	postParam, err := pm.ph.GetPostParam(ctx)
+	if err != nil || !postParam.IsValid() {
+		return &param.PostParam{ContentBonusPolicy: param.ConsumptionContentBonus}
+	}
+	return postParam
+}
+
+// contentBonusWeight - weight of the reward event and the window it is divided by under policy.
+// consumption: impact of the donation in the consumption window.
+// reputation: weight blended as in reputationWeight in the reputation window.
+func (pm PostManager) contentBonusWeight(ctx sdk.Context, policy param.ContentBonusPolicy,
+	postParam *param.PostParam, event types.RewardEvent) (linotypes.MiniDollar, linotypes.MiniDollar, sdk.Error) {
+	if policy != param.ReputationContentBonus {
+		return event.Evaluate, pm.postStorage.GetConsumptionWindow(ctx), nil
+	}
+	window := pm.postStorage.GetReputationWindow(ctx)
+	if event.Weight != nil {
+		return *event.Weight, window, nil
+	}
+	weight, err := pm.reputationWeight(ctx, postParam, event.Consumer, event.Evaluate)
+	return weight, window, err
+}
+
+// reputationWeight - (1 - w) * impact + w * min(reputation of donor, impact),
+// w is ReputationWeight. The reputation is capped by the impact, so that
+// dust donations of a reputable donor do not carry its whole reputation.
+func (pm PostManager) reputationWeight(ctx sdk.Context, postParam *param.PostParam,
+	donor linotypes.AccountKey, impact linotypes.MiniDollar) (linotypes.MiniDollar, sdk.Error) {
+	w := sdk.ZeroDec()
+	if !postParam.ReputationWeight.IsNil() {
+		w = postParam.ReputationWeight
+	}
+	reputation, err := pm.rep.GetReputation(ctx, donor)
+	if err != nil {
+		return linotypes.NewMiniDollar(0), err
+	}
+	if reputation.GT(impact.Int) {
+		reputation = impact
+	}
+	weight := impact.ToDec().Mul(sdk.OneDec().Sub(w)).Add(reputation.ToDec().Mul(w))
+	return linotypes.NewMiniDollarFromInt(weight.TruncateInt()), nil
+}
+
+// contentBonus - reward = (consumption reward pool) * (weight / window),
+// at most the whole pool.
+// XXX(yumin): the ratio is zero when the window is zero, because the window
+// as the sum of past donation weights, shall be large than zero as the weight is nonzero.
+func contentBonus(weight, window linotypes.MiniDollar, rewardPool linotypes.Coin) linotypes.Coin {
+	ratio := sdk.ZeroDec()
+	if !window.ToDec().IsZero() {
+		ratio = weight.ToDec().Quo(window.ToDec())
+	}
+	if ratio.GT(sdk.OneDec()) {
+		ratio = sdk.OneDec()
+	}
+	return linotypes.DecToCoin(rewardPool.ToDec().Mul(ratio))
+}
+
+// GetContentBonusDryRun - content bonus the author of reward event would receive
+// if it were executed now, under each policy. State is not changed.
+// The event is taken as a new donation, whose weight is added to the window
+// as it would be when registered, unless it carries its blended weight.
+func (pm PostManager) GetContentBonusDryRun(ctx sdk.Context, event types.RewardEvent) (*model.ContentBonusDryRun, sdk.Error) {
+	permlink := linotypes.GetPermlink(event.PostAuthor, event.PostID)
+	if !pm.DoesPostExist(ctx, permlink) {
+		return nil, types.ErrPostNotFound(permlink)
+	}
+	rewardPool, err := pm.am.GetPool(ctx, linotypes.InflationConsumptionPool)
+	if err != nil {
+		return nil, err
+	}
+	postParam := pm.getPostParam(ctx)
+	rst := &model.ContentBonusDryRun{
+		Policy:            postParam.ContentBonusPolicy,
+		ConsumptionWindow: pm.postStorage.GetConsumptionWindow(ctx),
+		ReputationWindow:  pm.postStorage.GetReputationWindow(ctx),
+		RewardPool:        rewardPool,
+	}
+	for _, policy := range []param.ContentBonusPolicy{
+		param.ConsumptionContentBonus, param.ReputationContentBonus} {
+		bonus := model.ContentBonus{
+			Policy: policy,
+			Weight: linotypes.NewMiniDollar(0),
+			Bonus:  linotypes.NewCoinFromInt64(0),
+		}
+		if !event.Evaluate.IsZero() {
+			var window linotypes.MiniDollar
+			bonus.Weight, window, err = pm.contentBonusWeight(ctx, policy, postParam, event)
+			if err != nil {
+				return nil, err
+			}
+			if event.Weight == nil {
+				window = window.Plus(bonus.Weight)
+			}
+			bonus.Bonus = contentBonus(bonus.Weight, window, rewardPool)
+		}
+		rst.Bonuses = append(rst.Bonuses, bonus)
+	}
+	return rst, nil
+}
+
+// newRevision - revision of the current title and content of the post.
+func (pm PostManager) newRevision(ctx sdk.Context, post *model.Post) *model.PostRevision {
+	rev := &model.PostRevision{
+		Revision:    post.Revision,
+		ContentHash: types.ContentHash(post.Title, post.Content),
+		CreatedAt:   post.UpdatedAt,
+		Donations:   linotypes.NewMiniDollar(0),
+		ContentRef:  post.ContentRef,
+	}
+	if pm.getPostParam(ctx).KeepRevisionContent {
+		rev.Title = post.Title
+		rev.Content = post.Content
+	}
+	return rev
+}
+
+// getOrInitRevision - return the latest revision of the post, posts created
+// before history was recorded have their latest revision recorded here.
+func (pm PostManager) getOrInitRevision(ctx sdk.Context, post *model.Post) *model.PostRevision {
+	permlink := linotypes.GetPermlink(post.Author, post.PostID)
+	rev, err := pm.postStorage.GetRevision(ctx, permlink, post.Revision)
+	if err == nil {
+		return rev
+	}
+	rev = pm.newRevision(ctx, post)
+	pm.postStorage.SetRevision(ctx, permlink, rev)
+	return rev
+}
+
+// GetHistory - return all recorded revisions of the post, including deleted ones.
+func (pm PostManager) GetHistory(ctx sdk.Context, permlink linotypes.Permlink) (*model.PostHistory, sdk.Error) {
+	post, err := pm.postStorage.GetPost(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	rst := &model.PostHistory{
+		Permlink:  permlink,
+		Revision:  post.Revision,
+		IsDeleted: post.IsDeleted,
+		Revisions: make([]model.PostRevision, 0),
+	}
+	for i := int64(0); i <= post.Revision; i++ {
+		rev, err := pm.postStorage.GetRevision(ctx, permlink, i)
+		if err != nil {
+			// revisions before history was recorded.
+			continue
+		}
+		rst.Revisions = append(rst.Revisions, *rev)
+	}
+	return rst, nil
+}
+
+// GetContentRef - content reference of the post, for clients to fetch the content
+// and verify it against the hash. Deleted posts and posts with inline content have none.
+func (pm PostManager) GetContentRef(ctx sdk.Context, permlink linotypes.Permlink) (*types.ContentRef, sdk.Error) {
+	post, err := pm.GetPost(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	if post.ContentRef == nil {
+		return nil, types.ErrContentRefNotFound(permlink)
+	}
+	return post.ContentRef, nil
+}
+
+// GetReplies - return at most limit replies of the post, starting from the start-th one.
+// Deleted replies are returned as they are, with title and content cleared.
+func (pm PostManager) GetReplies(ctx sdk.Context, permlink linotypes.Permlink, start, limit int64) (*model.Replies, sdk.Error) {
+	post, err := pm.postStorage.GetPost(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	if start < 0 || limit <= 0 {
+		return nil, linotypes.ErrInvalidQueryPath()
+	}
+	if limit > maxRepliesPerPage {
+		limit = maxRepliesPerPage
+	}
+	rst := &model.Replies{
+		Parent:  permlink,
+		Total:   post.ReplyCount,
+		Start:   start,
+		Replies: make([]model.Post, 0),
+	}
+	for i := start; i < post.ReplyCount && i < start+limit; i++ {
+		child, err := pm.postStorage.GetReply(ctx, permlink, i)
+		if err != nil {
+			return nil, err
+		}
+		reply, err := pm.postStorage.GetPost(ctx, child)
+		if err != nil {
+			return nil, err
+		}
+		rst.Replies = append(rst.Replies, *reply)
+	}
+	return rst, nil
 }
 
 func (pm PostManager) GetComsumptionWindow(ctx sdk.Context) linotypes.MiniDollar {
@@ -386,16 +845,130 @@ func (pm PostManager) ExportToFile(ctx sdk.Context, cdc *codec.Codec, filepath s
 
 	// export posts
 	posts := make([]model.PostIR, 0)
+	replies := make([]model.RepliesIR, 0)
+	histories := make([]model.PostHistoryIR, 0)
+	recentDonations := make([]model.RecentDonationsIR, 0)
+	addRecentDonations := func(permlink linotypes.Permlink) {
+		donations := pm.postStorage.GetRecentDonations(ctx, permlink)
+		nextSeq := pm.postStorage.GetRecentDonationNextSeq(ctx, permlink)
+		if nextSeq == 0 {
+			return
+		}
+		irs := make([]model.DonationIR, 0)
+		for i := len(donations) - 1; i >= 0; i-- {
+			irs = append(irs, model.DonationIR(donations[i]))
+		}
+		recentDonations = append(recentDonations, model.RecentDonationsIR{
+			Permlink:  permlink,
+			Donations: irs,
+			NextSeq:   nextSeq,
+		})
+	}
 	postSubStore := storeList[string(model.PostSubStore)]
 	postSubStore.Iterate(func(key []byte, val interface{}) bool {
 		post := val.(*model.Post)
 		posts = append(posts, model.PostIR(*post))
+		if post.ReplyCount > 0 {
+			permlink := linotypes.GetPermlink(post.Author, post.PostID)
+			children := make([]linotypes.Permlink, 0)
+			for i := int64(0); i < post.ReplyCount; i++ {
+				child, err := pm.postStorage.GetReply(ctx, permlink, i)
+				if err != nil {
+					panic(err)
+				}
+				children = append(children, child)
+			}
+			replies = append(replies, model.RepliesIR{
+				Parent:  permlink,
+				Replies: children,
+			})
+		}
+		revisions := make([]model.PostRevisionIR, 0)
+		permlink := linotypes.GetPermlink(post.Author, post.PostID)
+		for i := int64(0); i <= post.Revision; i++ {
+			rev, err := pm.postStorage.GetRevision(ctx, permlink, i)
+			if err != nil {
+				continue
+			}
+			revisions = append(revisions, model.PostRevisionIR(*rev))
+		}
+		if len(revisions) > 0 {
+			histories = append(histories, model.PostHistoryIR{
+				Permlink:  permlink,
+				Revisions: revisions,
+			})
+		}
+		addRecentDonations(permlink)
 		return false
 	})
 	state.Posts = posts
+	state.Replies = replies
+	state.Histories = histories
+
+	// censorships
+	censorships := make([]model.CensorshipIR, 0)
+	for _, c := range pm.GetCensorships(ctx) {
+		votes := make([]model.CensorshipVoteIR, 0)
+		for _, v := range pm.postStorage.GetCensorshipVotes(ctx, c.ID) {
+			votes = append(votes, model.CensorshipVoteIR(v))
+		}
+		censorships = append(censorships, model.CensorshipIR{
+			ID:        c.ID,
+			Permlink:  c.Permlink,
+			Flagger:   c.Flagger,
+			Deposit:   c.Deposit,
+			Reason:    c.Reason,
+			CreatedAt: c.CreatedAt,
+			DecideAt:  c.DecideAt,
+			Status:    c.Status,
+			Approve:   c.Approve,
+			Reject:    c.Reject,
+			Votes:     votes,
+		})
+	}
+	state.Censorships = censorships
+	state.CensorshipNextID = pm.postStorage.GetCensorshipNextID(ctx)
+
+	// donation stats
+	postStats := make([]model.PostStatsIR, 0)
+	storeList[string(model.PostStatsSubStore)].Iterate(func(key []byte, val interface{}) bool {
+		permlink := linotypes.Permlink(key)
+		postStats = append(postStats, model.PostStatsIR{
+			Permlink: permlink,
+			Stats:    model.DonationStatsIR(*val.(*model.DonationStats)),
+			Donors:   pm.postStorage.GetPostDonors(ctx, permlink),
+		})
+		return false
+	})
+	state.PostStats = postStats
+	authorStats := make([]model.AuthorStatsIR, 0)
+	storeList[string(model.AuthorStatsSubStore)].Iterate(func(key []byte, val interface{}) bool {
+		author := linotypes.AccountKey(key)
+		authorStats = append(authorStats, model.AuthorStatsIR{
+			Author: author,
+			Stats:  model.DonationStatsIR(*val.(*model.DonationStats)),
+			Donors: pm.postStorage.GetAuthorDonors(ctx, author),
+		})
+		// channels with donations always have author stats.
+		addRecentDonations(types.GetChannelPermlink(author))
+		return false
+	})
+	state.AuthorStats = authorStats
+	state.RecentDonations = recentDonations
+
+	// escrowed donations
+	escrows := make([]model.EscrowedDonationIR, 0)
+	storeList[string(model.EscrowSubStore)].Iterate(func(key []byte, val interface{}) bool {
+		escrows = append(escrows, model.EscrowedDonationIR(*val.(*model.EscrowedDonation)))
+		return false
+	})
+	state.Escrows = escrows
+	state.EscrowNextID = pm.postStorage.GetEscrowNextID(ctx)
 
 	// consumption window
 	state.ConsumptionWindow = pm.postStorage.GetConsumptionWindow(ctx)
+	reputationWindow := pm.postStorage.GetReputationWindow(ctx)
+	state.ReputationWindow = &reputationWindow
 
 	return utils.Save(filepath, cdc, state)
 }
@@ -408,23 +981,141 @@ func (pm PostManager) ImportFromFile(ctx sdk.Context, cdc *codec.Codec, filepath
 	}
 	table := rst.(*model.PostTablesIR)
 
-	if table.Version != importVersion {
+	switch table.Version {
+	case postOnlyVersion:
+		table = convertPostTablesV2(table)
+	case importVersion:
+	default:
 		return fmt.Errorf("unsupported import version: %d", table.Version)
 	}
 
+	posts := make([]model.Post, 0, len(table.Posts))
 	for _, v := range table.Posts {
-		pm.postStorage.SetPost(ctx, &model.Post{
+		post := model.Post{
 			PostID:    v.PostID,
 			Title:     v.Title,
 			Content:   v.Content,
 			Author:    v.Author,
-			CreatedBy: v.Author,
+			CreatedBy: v.CreatedBy,
 			CreatedAt: v.CreatedAt,
 			UpdatedAt: v.UpdatedAt,
 			IsDeleted: v.IsDeleted,
+
+			ParentPermlink: v.ParentPermlink,
+			ReplyCount:     v.ReplyCount,
+			Revision:       v.Revision,
+			IsCensored:     v.IsCensored,
+			CensorshipID:   v.CensorshipID,
+			Beneficiaries:  v.Beneficiaries,
+			ContentRef:     v.ContentRef,
+			Tags:           v.Tags,
+		}
+		pm.postStorage.SetPost(ctx, &post)
+		posts = append(posts, post)
+	}
+	pm.rebuildIndexes(ctx, posts)
+
+	for _, v := range table.Histories {
+		for _, rev := range v.Revisions {
+			rev := model.PostRevision(rev)
+			pm.postStorage.SetRevision(ctx, v.Permlink, &rev)
+		}
+	}
+
+	for _, v := range table.RecentDonations {
+		for _, d := range v.Donations {
+			d := model.Donation(d)
+			pm.postStorage.SetRecentDonation(ctx, v.Permlink, &d)
+		}
+		if v.NextSeq != 0 {
+			pm.postStorage.SetRecentDonationNextSeq(ctx, v.Permlink, v.NextSeq)
+		}
+	}
+
+	for _, v := range table.Replies {
+		for i, child := range v.Replies {
+			pm.postStorage.SetReply(ctx, v.Parent, int64(i), child)
+		}
+	}
+
+	for _, v := range table.PostStats {
+		stats := model.DonationStats(v.Stats)
+		pm.postStorage.SetPostStats(ctx, v.Permlink, &stats)
+		for _, donor := range v.Donors {
+			pm.postStorage.SetPostDonor(ctx, v.Permlink, donor)
+		}
+	}
+	for _, v := range table.AuthorStats {
+		stats := model.DonationStats(v.Stats)
+		pm.postStorage.SetAuthorStats(ctx, v.Author, &stats)
+		for _, donor := range v.Donors {
+			pm.postStorage.SetAuthorDonor(ctx, v.Author, donor)
+		}
+	}
+
+	for _, c := range table.Censorships {
+		pm.postStorage.SetCensorship(ctx, &model.Censorship{
+			ID:        c.ID,
+			Permlink:  c.Permlink,
+			Flagger:   c.Flagger,
+			Deposit:   c.Deposit,
+			Reason:    c.Reason,
+			CreatedAt: c.CreatedAt,
+			DecideAt:  c.DecideAt,
+			Status:    c.Status,
+			Approve:   c.Approve,
+			Reject:    c.Reject,
 		})
+		for _, v := range c.Votes {
+			pm.postStorage.SetCensorshipVote(ctx, c.ID, &model.CensorshipVote{
+				Voter:   v.Voter,
+				Approve: v.Approve,
+			})
+		}
+	}
+	if table.CensorshipNextID != 0 {
+		pm.postStorage.SetCensorshipNextID(ctx, table.CensorshipNextID)
+	}
+
+	for _, v := range table.Escrows {
+		escrow := model.EscrowedDonation(v)
+		pm.postStorage.SetEscrowedDonation(ctx, &escrow)
+	}
+	if table.EscrowNextID != 0 {
+		pm.postStorage.SetEscrowNextID(ctx, table.EscrowNextID)
 	}
 
 	pm.postStorage.SetConsumptionWindow(ctx, table.ConsumptionWindow)
+	if table.ReputationWindow != nil {
+		pm.postStorage.SetReputationWindow(ctx, *table.ReputationWindow)
+	}
 	return nil
 }
+
+// convertPostTablesV2 - posts of v2 are top level posts without history,
+// beneficiaries, content refs or tags. Replies, revisions, censorships, stats,
+// recent donations and escrows start empty, indexes are rebuilt from posts on import.
+func convertPostTablesV2(v2 *model.PostTablesIR) *model.PostTablesIR {
+	posts := make([]model.PostIR, 0, len(v2.Posts))
+	for _, v := range v2.Posts {
+		createdBy := v.CreatedBy
+		if createdBy == "" {
+			createdBy = v.Author
+		}
+		posts = append(posts, model.PostIR{
+			PostID:    v.PostID,
+			Title:     v.Title,
+			Content:   v.Content,
+			Author:    v.Author,
+			CreatedBy: createdBy,
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
+			IsDeleted: v.IsDeleted,
+		})
+	}
+	return &model.PostTablesIR{
+		Version:           importVersion,
+		Posts:             posts,
+		ConsumptionWindow: v2.ConsumptionWindow,
+	}
+}
@@ -12,9 +12,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/lino-network/lino/param"
+	parammock "github.com/lino-network/lino/param/mocks"
 	"github.com/lino-network/lino/testsuites"
 	"github.com/lino-network/lino/testutils"
 	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/utils"
 	acc "github.com/lino-network/lino/x/account/mocks"
 	dev "github.com/lino-network/lino/x/developer/mocks"
 	global "github.com/lino-network/lino/x/global/mocks"
@@ -34,6 +37,7 @@ type PostManagerTestSuite struct {
 	testsuites.GoldenTestSuite
 	pm PostManager
 	// deps
+	ph     *parammock.ParamKeeper
 	am     *acc.AccountKeeper
 	dev    *dev.DeveloperKeeper
 	global *global.GlobalKeeper
@@ -67,13 +71,26 @@ func TestPostManagerTestSuite(t *testing.T) {
 
 func (suite *PostManagerTestSuite) SetupTest() {
 	suite.SetupCtx(0, time.Unix(0, 0), storeKey)
+	suite.ph = &parammock.ParamKeeper{}
 	suite.am = &acc.AccountKeeper{}
 	suite.dev = &dev.DeveloperKeeper{}
 	suite.global = &global.GlobalKeeper{}
 	suite.price = &price.PriceKeeper{}
 	suite.rep = &rep.ReputationKeeper{}
 	suite.vote = &vote.VoteKeeper{}
-	suite.pm = NewPostManager(storeKey, suite.am, suite.global, suite.dev, suite.rep, suite.price, suite.vote)
+	suite.pm = NewPostManager(storeKey, suite.ph, suite.am, suite.global, suite.dev, suite.rep, suite.price, suite.vote)
+
+	suite.ph.On("GetPostParam", mock.Anything).Return(&param.PostParam{
+		ContentBonusPolicy:  param.ConsumptionContentBonus,
+		ReputationWeight:    linotypes.NewDecFromRat(50, 100),
+		RecentDonationsSize: 10,
+	}, nil).Maybe()
+	suite.ph.On("GetProposalParam", mock.Anything).Return(&param.ProposalParam{
+		ContentCensorshipDecideSec:  100,
+		ContentCensorshipMinDeposit: linotypes.NewCoinFromInt64(100),
+		ContentCensorshipPassRatio:  linotypes.NewDecFromRat(50, 100),
+		ContentCensorshipPassVotes:  linotypes.NewCoinFromInt64(1000),
+	}, nil).Maybe()
 
 	// background
 	suite.user1 = linotypes.AccountKey("user1")
@@ -246,7 +263,7 @@ func (suite *PostManagerTestSuite) TestCreatePost() {
 			CreatedBy: tc.createdby,
 		}
 		err := suite.pm.CreatePost(
-			suite.Ctx, msg.Author, msg.PostID, msg.CreatedBy, msg.Content, msg.Title)
+			suite.Ctx, msg.Author, msg.PostID, msg.CreatedBy, msg.Content, msg.Title, msg.ParentPermlink, nil, nil, nil)
 		suite.Equal(tc.expectResult, err, "%s", tc.testName)
 		if tc.expectResult == nil {
 			post, err := suite.pm.postStorage.GetPost(
@@ -277,7 +294,7 @@ func (suite *PostManagerTestSuite) TestUpdatePost() {
 	user2 := suite.user2
 	app1 := suite.app1
 	postID := "post1"
-	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title")
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 	baseTime := suite.Ctx.BlockHeader().Time.Unix()
 
@@ -324,7 +341,7 @@ func (suite *PostManagerTestSuite) TestUpdatePost() {
 
 	for _, tc := range testCases {
 		suite.NextBlock(time.Unix(tc.updateTime, 0))
-		err := suite.pm.UpdatePost(suite.Ctx, tc.author, tc.postID, tc.title, tc.content)
+		err := suite.pm.UpdatePost(suite.Ctx, tc.author, tc.postID, tc.title, tc.content, nil, nil)
 		suite.Equal(tc.expectErr, err)
 		if tc.expectErr == nil {
 			post, err := suite.pm.postStorage.GetPost(
@@ -338,6 +355,7 @@ func (suite *PostManagerTestSuite) TestUpdatePost() {
 				CreatedBy: app1,
 				CreatedAt: baseTime,
 				UpdatedAt: tc.updateTime,
+				Revision:  1,
 			}, post, "%s", tc.testName)
 		}
 	}
@@ -347,7 +365,7 @@ func (suite *PostManagerTestSuite) TestDeletePost() {
 	user1 := suite.user1
 	app1 := suite.app1
 	postID := "post1"
-	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title")
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 
 	testCases := []struct {
@@ -386,7 +404,7 @@ func (suite *PostManagerTestSuite) TestDeletePost() {
 	}
 
 	// after deleting post, cannot create post with same permlink.
-	err = suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title")
+	err = suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Equal(types.ErrPostAlreadyExist(linotypes.GetPermlink(user1, postID)), err)
 
 	// after deleting post, cannot create post with same permlink.
@@ -398,7 +416,7 @@ func (suite *PostManagerTestSuite) TestGetPost() {
 	user1 := suite.user1
 	app1 := suite.app1
 	postID := "post1"
-	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title")
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 	post, err := suite.pm.GetPost(suite.Ctx, linotypes.GetPermlink(user1, postID))
 	suite.Require().Nil(err)
@@ -421,12 +439,341 @@ func (suite *PostManagerTestSuite) TestGetPost() {
 	suite.Require().NotNil(err)
 }
 
+func (suite *PostManagerTestSuite) TestCreateReply() {
+	user1 := suite.user1
+	user2 := suite.user2
+	app1 := suite.app1
+	root := linotypes.GetPermlink(user1, "root")
+	err := suite.pm.CreatePost(suite.Ctx, user1, "root", app1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	err = suite.pm.CreatePost(suite.Ctx, user1, "deleted", app1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	err = suite.pm.DeletePost(suite.Ctx, linotypes.GetPermlink(user1, "deleted"))
+	suite.Require().Nil(err)
+
+	testCases := []struct {
+		testName   string
+		author     linotypes.AccountKey
+		postID     string
+		parent     linotypes.Permlink
+		expectErr  sdk.Error
+		replyCount int64
+	}{
+		{
+			testName:  "parent not exists",
+			author:    user2,
+			postID:    "reply0",
+			parent:    linotypes.GetPermlink(user1, "notexist"),
+			expectErr: types.ErrPostNotFound(linotypes.GetPermlink(user1, "notexist")),
+		},
+		{
+			testName:  "parent deleted",
+			author:    user2,
+			postID:    "reply0",
+			parent:    linotypes.GetPermlink(user1, "deleted"),
+			expectErr: types.ErrPostDeleted(linotypes.GetPermlink(user1, "deleted")),
+		},
+		{
+			testName:   "reply successfully",
+			author:     user2,
+			postID:     "reply1",
+			parent:     root,
+			replyCount: 1,
+		},
+		{
+			testName:   "reply to self",
+			author:     user1,
+			postID:     "reply2",
+			parent:     root,
+			replyCount: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := suite.pm.CreatePost(suite.Ctx, tc.author, tc.postID, tc.author, "content", "title", tc.parent, nil, nil, nil)
+		suite.Equal(tc.expectErr, err, "%s", tc.testName)
+		if tc.expectErr != nil {
+			suite.False(suite.pm.postStorage.HasPost(
+				suite.Ctx, linotypes.GetPermlink(tc.author, tc.postID)), "%s", tc.testName)
+			continue
+		}
+		permlink := linotypes.GetPermlink(tc.author, tc.postID)
+		reply, err := suite.pm.GetPost(suite.Ctx, permlink)
+		suite.Require().Nil(err)
+		suite.Equal(tc.parent, reply.ParentPermlink, "%s", tc.testName)
+		parent, err := suite.pm.GetPost(suite.Ctx, tc.parent)
+		suite.Require().Nil(err)
+		suite.Equal(tc.replyCount, parent.ReplyCount, "%s", tc.testName)
+		child, err := suite.pm.postStorage.GetReply(suite.Ctx, tc.parent, tc.replyCount-1)
+		suite.Require().Nil(err)
+		suite.Equal(permlink, child, "%s", tc.testName)
+	}
+}
+
+func (suite *PostManagerTestSuite) TestGetReplies() {
+	user1 := suite.user1
+	user2 := suite.user2
+	root := linotypes.GetPermlink(user1, "root")
+	err := suite.pm.CreatePost(suite.Ctx, user1, "root", user1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	for _, postID := range []string{"reply1", "reply2", "reply3"} {
+		err = suite.pm.CreatePost(suite.Ctx, user2, postID, user2, "content", "title", root, nil, nil, nil)
+		suite.Require().Nil(err)
+	}
+	reply2 := linotypes.GetPermlink(user2, "reply2")
+	err = suite.pm.CreatePost(suite.Ctx, user1, "nested", user1, "content", "title", reply2, nil, nil, nil)
+	suite.Require().Nil(err)
+
+	// deleting a reply keeps its position and its own replies.
+	err = suite.pm.DeletePost(suite.Ctx, reply2)
+	suite.Require().Nil(err)
+	err = suite.pm.CreatePost(suite.Ctx, user1, "nested2", user1, "content", "title", reply2, nil, nil, nil)
+	suite.Equal(types.ErrPostDeleted(reply2), err)
+
+	post := func(author linotypes.AccountKey, postID string, parent linotypes.Permlink, replyCount int64, deleted bool) model.Post {
+		rst := model.Post{
+			PostID:    postID,
+			Title:     "title",
+			Content:   "content",
+			Author:    author,
+			CreatedBy: author,
+			CreatedAt: suite.Ctx.BlockHeader().Time.Unix(),
+			UpdatedAt: suite.Ctx.BlockHeader().Time.Unix(),
+
+			ParentPermlink: parent,
+			ReplyCount:     replyCount,
+		}
+		if deleted {
+			rst.Title = ""
+			rst.Content = ""
+			rst.IsDeleted = true
+		}
+		return rst
+	}
+
+	testCases := []struct {
+		testName  string
+		permlink  linotypes.Permlink
+		start     int64
+		limit     int64
+		expected  *model.Replies
+		expectErr sdk.Error
+	}{
+		{
+			testName:  "post not exists",
+			permlink:  linotypes.GetPermlink(user1, "notexist"),
+			start:     0,
+			limit:     10,
+			expectErr: types.ErrPostNotFound(linotypes.GetPermlink(user1, "notexist")),
+		},
+		{
+			testName:  "invalid limit",
+			permlink:  root,
+			start:     0,
+			limit:     0,
+			expectErr: linotypes.ErrInvalidQueryPath(),
+		},
+		{
+			testName:  "invalid start",
+			permlink:  root,
+			start:     -1,
+			limit:     10,
+			expectErr: linotypes.ErrInvalidQueryPath(),
+		},
+		{
+			testName: "first page",
+			permlink: root,
+			start:    0,
+			limit:    2,
+			expected: &model.Replies{
+				Parent: root,
+				Total:  3,
+				Start:  0,
+				Replies: []model.Post{
+					post(user2, "reply1", root, 0, false),
+					post(user2, "reply2", root, 1, true),
+				},
+			},
+		},
+		{
+			testName: "last page",
+			permlink: root,
+			start:    2,
+			limit:    2,
+			expected: &model.Replies{
+				Parent: root,
+				Total:  3,
+				Start:  2,
+				Replies: []model.Post{
+					post(user2, "reply3", root, 0, false),
+				},
+			},
+		},
+		{
+			testName: "out of range",
+			permlink: root,
+			start:    3,
+			limit:    2,
+			expected: &model.Replies{
+				Parent:  root,
+				Total:   3,
+				Start:   3,
+				Replies: []model.Post{},
+			},
+		},
+		{
+			testName: "replies of deleted post",
+			permlink: reply2,
+			start:    0,
+			limit:    10,
+			expected: &model.Replies{
+				Parent: reply2,
+				Total:  1,
+				Start:  0,
+				Replies: []model.Post{
+					post(user1, "nested", reply2, 0, false),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		replies, err := suite.pm.GetReplies(suite.Ctx, tc.permlink, tc.start, tc.limit)
+		suite.Equal(tc.expectErr, err, "%s", tc.testName)
+		suite.Equal(tc.expected, replies, "%s", tc.testName)
+	}
+}
+
+func (suite *PostManagerTestSuite) TestPostHistory() {
+	user1 := suite.user1
+	user2 := suite.user2
+	postID := "post1"
+	permlink := linotypes.GetPermlink(user1, postID)
+	suite.ph = &parammock.ParamKeeper{}
+	suite.pm.ph = suite.ph
+	suite.ph.On("GetPostParam", mock.Anything).Return(&param.PostParam{
+		ContentBonusPolicy:  param.ConsumptionContentBonus,
+		ReputationWeight:    linotypes.NewDecFromRat(50, 100),
+		KeepRevisionContent: true,
+	}, nil)
+	suite.rep.On("DonateAt", mock.Anything, user2, permlink, mock.Anything).Return(
+		linotypes.NewMiniDollar(33), nil)
+	suite.vote.On("RecordFriction", mock.Anything, mock.Anything).Return(nil)
+	suite.global.On("RegisterEventAtTime", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := suite.pm.GetHistory(suite.Ctx, permlink)
+	suite.Equal(types.ErrPostNotFound(permlink), err)
+
+	baseTime := suite.Ctx.BlockHeader().Time.Unix()
+	err = suite.pm.CreatePost(suite.Ctx, user1, postID, user1, "content", "title", "", nil, nil, nil)
+	suite.Require().Nil(err)
+	_, err = suite.pm.afterDonation(suite.Ctx, user1, postID, user2,
+		linotypes.NewMiniDollar(1000), linotypes.NewCoinFromInt64(0), linotypes.NewMiniDollar(1000),
+		linotypes.NewCoinFromInt64(1), "", 0)
+	suite.Require().Nil(err)
+
+	suite.NextBlock(time.Unix(baseTime+10, 0))
+	err = suite.pm.UpdatePost(suite.Ctx, user1, postID, "title2", "content2", nil, nil)
+	suite.Require().Nil(err)
+	for i := 0; i < 2; i++ {
+		_, err = suite.pm.afterDonation(suite.Ctx, user1, postID, user2,
+			linotypes.NewMiniDollar(500), linotypes.NewCoinFromInt64(0), linotypes.NewMiniDollar(500),
+			linotypes.NewCoinFromInt64(1), "", 0)
+		suite.Require().Nil(err)
+	}
+
+	expected := &model.PostHistory{
+		Permlink: permlink,
+		Revision: 1,
+		Revisions: []model.PostRevision{
+			{
+				Revision:     0,
+				ContentHash:  types.ContentHash("title", "content"),
+				Title:        "title",
+				Content:      "content",
+				CreatedAt:    baseTime,
+				Donations:    linotypes.NewMiniDollar(1000),
+				NumDonations: 1,
+			},
+			{
+				Revision:     1,
+				ContentHash:  types.ContentHash("title2", "content2"),
+				Title:        "title2",
+				Content:      "content2",
+				CreatedAt:    baseTime + 10,
+				Donations:    linotypes.NewMiniDollar(1000),
+				NumDonations: 2,
+			},
+		},
+	}
+	history, err := suite.pm.GetHistory(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(expected, history)
+
+	// deleting keeps content hashes only.
+	err = suite.pm.DeletePost(suite.Ctx, permlink)
+	suite.Require().Nil(err)
+	expected.IsDeleted = true
+	for i := range expected.Revisions {
+		expected.Revisions[i].Title = ""
+		expected.Revisions[i].Content = ""
+	}
+	history, err = suite.pm.GetHistory(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(expected, history)
+}
+
+func (suite *PostManagerTestSuite) TestPostHistoryBeforeRevisions() {
+	user1 := suite.user1
+	postID := "post1"
+	permlink := linotypes.GetPermlink(user1, postID)
+	// post created before history was recorded.
+	suite.pm.postStorage.SetPost(suite.Ctx, &model.Post{
+		PostID:    postID,
+		Title:     "title",
+		Content:   "content",
+		Author:    user1,
+		CreatedBy: user1,
+		CreatedAt: 1,
+		UpdatedAt: 2,
+	})
+	history, err := suite.pm.GetHistory(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(&model.PostHistory{
+		Permlink:  permlink,
+		Revisions: []model.PostRevision{},
+	}, history)
+
+	err = suite.pm.UpdatePost(suite.Ctx, user1, postID, "title2", "content2", nil, nil)
+	suite.Require().Nil(err)
+	history, err = suite.pm.GetHistory(suite.Ctx, permlink)
+	suite.Nil(err)
+	suite.Equal(&model.PostHistory{
+		Permlink: permlink,
+		Revision: 1,
+		Revisions: []model.PostRevision{
+			{
+				Revision:    0,
+				ContentHash: types.ContentHash("title", "content"),
+				CreatedAt:   2,
+				Donations:   linotypes.NewMiniDollar(0),
+			},
+			{
+				Revision:    1,
+				ContentHash: types.ContentHash("title2", "content2"),
+				CreatedAt:   suite.Ctx.BlockHeader().Time.Unix(),
+				Donations:   linotypes.NewMiniDollar(0),
+			},
+		},
+	}, history)
+}
+
 func (suite *PostManagerTestSuite) TestLinoDonateInvalid() {
 	user2 := suite.user2
 	user1 := suite.user1
 	app1 := suite.app1
 	postID := "post1"
-	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title")
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 
 	testCases := []struct {
@@ -513,7 +860,7 @@ func (suite *PostManagerTestSuite) TestLinoDonateInvalid() {
 	}
 
 	for _, tc := range testCases {
-		err := suite.pm.LinoDonate(suite.Ctx, tc.from, tc.amount, tc.author, tc.postID, tc.app)
+		err := suite.pm.LinoDonate(suite.Ctx, tc.from, tc.amount, tc.author, tc.postID, tc.app, "")
 		suite.Require().Equal(tc.expectErr, err, "%s", tc.testName)
 	}
 }
@@ -529,7 +876,7 @@ func (suite *PostManagerTestSuite) TestLinoDonateOK() {
 	dollar := linotypes.NewMiniDollar(1000)
 	dp := linotypes.NewMiniDollar(33)
 	suite.price.On("CoinToMiniDollar", mock.Anything, amount).Return(dollar, nil)
-	err := suite.pm.CreatePost(suite.Ctx, author, postID, app, "content", "title")
+	err := suite.pm.CreatePost(suite.Ctx, author, postID, app, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 
 	suite.rep.On("DonateAt",
@@ -552,8 +899,21 @@ func (suite *PostManagerTestSuite) TestLinoDonateOK() {
 	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
 		linotypes.NewAccOrAddrFromAcc(from), tax).Return(nil).Once()
 	suite.vote.On("RecordFriction", mock.Anything, tax).Return(nil).Once()
-	err = suite.pm.LinoDonate(suite.Ctx, from, amount, author, postID, app)
+	err = suite.pm.LinoDonate(suite.Ctx, from, amount, author, postID, app, "thanks for the post")
+	suite.Nil(err)
+	donations, err := suite.pm.GetRecentDonations(suite.Ctx, linotypes.GetPermlink(author, postID))
 	suite.Nil(err)
+	suite.Equal([]model.Donation{
+		{
+			Seq:       0,
+			Donor:     from,
+			Amount:    amount.Amount,
+			Currency:  types.CurrencyLino,
+			App:       app,
+			Memo:      "thanks for the post",
+			CreatedAt: suite.Ctx.BlockHeader().Time.Unix(),
+		},
+	}, donations)
 	suite.price.AssertExpectations(suite.T())
 	suite.rep.AssertExpectations(suite.T())
 	suite.global.AssertExpectations(suite.T())
@@ -570,7 +930,7 @@ func (suite *PostManagerTestSuite) TestIDADonateInvalid() {
 	// app2 := suite.app2
 	app3 := suite.app3
 	postID := "post1"
-	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title")
+	err := suite.pm.CreatePost(suite.Ctx, user1, postID, app1, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 	suite.dev.On("BurnIDA", mock.Anything, app1, mock.Anything, mock.Anything).Return(linotypes.NewCoinFromInt64(0), nil)
 
@@ -697,7 +1057,7 @@ func (suite *PostManagerTestSuite) TestIDADonateInvalid() {
 	}
 
 	for _, tc := range testCases {
-		err := suite.pm.IDADonate(suite.Ctx, tc.from, tc.n, tc.author, tc.postID, tc.app, tc.signer)
+		err := suite.pm.IDADonate(suite.Ctx, tc.from, tc.n, tc.author, tc.postID, tc.app, tc.signer, "")
 		suite.Equal(tc.expectErr, err, "%s", tc.testName)
 		if err != nil {
 			continue
@@ -718,7 +1078,7 @@ func (suite *PostManagerTestSuite) TestIDADonateOK() {
 	taxcoins := linotypes.NewCoinFromInt64(78)
 	income := dollar.Minus(tax)
 	dp := linotypes.NewMiniDollar(33)
-	err = suite.pm.CreatePost(suite.Ctx, author, postID, app, "content", "title")
+	err = suite.pm.CreatePost(suite.Ctx, author, postID, app, "content", "title", "", nil, nil, nil)
 	suite.Require().Nil(err)
 
 	suite.dev.On("BurnIDA", mock.Anything, app, from, tax).Return(taxcoins, nil)
@@ -740,8 +1100,21 @@ func (suite *PostManagerTestSuite) TestIDADonateOK() {
 	suite.am.On("MoveToPool", mock.Anything, linotypes.VoteFrictionPool,
 		linotypes.NewAccOrAddrFromAcc(from), taxcoins).Return(nil).Once()
 	suite.vote.On("RecordFriction", mock.Anything, taxcoins).Return(nil).Once()
-	err = suite.pm.IDADonate(suite.Ctx, from, miniIDA, author, postID, app, suite.app1affiliated)
+	err = suite.pm.IDADonate(suite.Ctx, from, miniIDA, author, postID, app, suite.app1affiliated, "tip")
+	suite.Nil(err)
+	donations, err := suite.pm.GetRecentDonations(suite.Ctx, linotypes.GetPermlink(author, postID))
 	suite.Nil(err)
+	suite.Equal([]model.Donation{
+		{
+			Seq:       0,
+			Donor:     from,
+			Amount:    miniIDA,
+			Currency:  types.CurrencyIDA,
+			App:       app,
+			Memo:      "tip",
+			CreatedAt: suite.Ctx.BlockHeader().Time.Unix(),
+		},
+	}, donations)
 	suite.price.AssertExpectations(suite.T())
 	suite.rep.AssertExpectations(suite.T())
 	suite.global.AssertExpectations(suite.T())
@@ -751,6 +1124,54 @@ func (suite *PostManagerTestSuite) TestIDADonateOK() {
 func (suite *PostManagerTestSuite) TestImportExport() {
 	cdc := codec.New()
 	suite.LoadState(true)
+	err := suite.pm.CreatePost(suite.Ctx, suite.user2, "reply", suite.user2, "content", "title",
+		linotypes.GetPermlink(suite.user1, "postID"), nil, nil, nil)
+	suite.Require().Nil(err)
+	err = suite.pm.UpdatePost(suite.Ctx, suite.user2, "reply", "title2", "content2",
+		[]types.Beneficiary{{Account: suite.app2, Weight: 1000}}, nil)
+	suite.Require().Nil(err)
+	err = suite.pm.CreatePost(suite.Ctx, suite.user2, "ref", suite.app1, "", "title", "", nil,
+		&types.ContentRef{Hash: types.ContentHash("", "content"), URI: "ipfs://content", Size: 7, MimeType: "text/plain"},
+		[]string{"music"})
+	suite.Require().Nil(err)
+	suite.vote.On("DoesVoterExist", mock.Anything, suite.user2).Return(true)
+	suite.am.On("MoveToPool", mock.Anything, linotypes.PostCensorshipDepositPool,
+		linotypes.NewAccOrAddrFromAcc(suite.user2), linotypes.NewCoinFromInt64(100)).Return(nil)
+	suite.global.On("RegisterEventAtTime", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	id, err := suite.pm.FlagPost(suite.Ctx, suite.user2, linotypes.GetPermlink(suite.user1, "postID"),
+		linotypes.NewCoinFromInt64(100), "spam")
+	suite.Require().Nil(err)
+	err = suite.pm.VoteCensorship(suite.Ctx, suite.user2, id, true)
+	suite.Require().Nil(err)
+	post, err := suite.pm.postStorage.GetPost(suite.Ctx, linotypes.GetPermlink(suite.user1, "postID"))
+	suite.Require().Nil(err)
+	suite.pm.recordDonation(suite.Ctx, post, suite.user2,
+		linotypes.NewCoinFromInt64(100), linotypes.NewMiniDollar(0), linotypes.NewMiniDollar(33))
+	suite.pm.recordContentBonus(suite.Ctx, post, linotypes.NewCoinFromInt64(10))
+	for _, memo := range []string{"first", "second"} {
+		suite.pm.recordRecentDonation(suite.Ctx, linotypes.GetPermlink(suite.user1, "postID"),
+			suite.user2, sdk.NewInt(100), types.CurrencyLino, suite.app1, memo, 0)
+	}
+	channel, err := suite.pm.getDonationTarget(suite.Ctx, suite.app2, types.ChannelPostID)
+	suite.Require().Nil(err)
+	suite.pm.recordDonation(suite.Ctx, channel, suite.user2,
+		linotypes.NewCoinFromInt64(100), linotypes.NewMiniDollar(0), linotypes.NewMiniDollar(33))
+	suite.pm.recordRecentDonation(suite.Ctx, types.GetChannelPermlink(suite.app2),
+		suite.user2, sdk.NewInt(100), types.CurrencyLino, suite.app1, "channel", 1)
+	suite.pm.postStorage.SetEscrowedDonation(suite.Ctx, &model.EscrowedDonation{
+		ID:        1,
+		From:      suite.user2,
+		Author:    suite.app2,
+		PostID:    types.ChannelPostID,
+		App:       suite.app1,
+		Amount:    linotypes.NewCoinFromInt64(100),
+		Shares:    []types.Share{{Account: suite.app2, Amount: sdk.NewInt(90)}},
+		Dollar:    linotypes.NewMiniDollar(100),
+		Impact:    linotypes.NewMiniDollar(33),
+		CreatedAt: 1,
+		ReleaseAt: 2,
+	})
+	suite.pm.postStorage.SetEscrowNextID(suite.Ctx, 2)
 
 	dir, err2 := ioutil.TempDir("", "test")
 	suite.Require().Nil(err2)
@@ -768,3 +1189,68 @@ func (suite *PostManagerTestSuite) TestImportExport() {
 	suite.Golden()
 	// suite.AssertStateUnchanged(false)
 }
+
+func (suite *PostManagerTestSuite) TestImportV2() {
+	cdc := codec.New()
+	dir, err2 := ioutil.TempDir("", "test")
+	suite.Require().Nil(err2)
+	defer os.RemoveAll(dir) // clean up
+
+	tmpfn := filepath.Join(dir, "tmpfile")
+	err2 = utils.Save(tmpfn, cdc, &model.PostTablesIR{
+		Version: 2,
+		Posts: []model.PostIR{
+			{
+				PostID:    "p2",
+				Title:     "title2",
+				Content:   "content2",
+				Author:    suite.user1,
+				CreatedBy: suite.app1,
+				CreatedAt: 20,
+				UpdatedAt: 20,
+			},
+			{
+				PostID:    "p1",
+				Title:     "title1",
+				Content:   "content1",
+				Author:    suite.user1,
+				CreatedBy: suite.user1,
+				CreatedAt: 10,
+				UpdatedAt: 15,
+			},
+		},
+		ConsumptionWindow: linotypes.NewMiniDollar(123),
+	})
+	suite.Require().Nil(err2)
+
+	suite.SetupTest()
+	err2 = suite.pm.ImportFromFile(suite.Ctx, cdc, tmpfn)
+	suite.Require().Nil(err2)
+
+	post, err := suite.pm.GetPost(suite.Ctx, linotypes.GetPermlink(suite.user1, "p2"))
+	suite.Require().Nil(err)
+	suite.Equal(suite.app1, post.CreatedBy)
+	suite.Equal(int64(0), post.Revision)
+	suite.Equal(linotypes.NewMiniDollar(123), suite.pm.GetComsumptionWindow(suite.Ctx))
+	// indexes are rebuilt in creation order.
+	lst, err := suite.pm.GetPostsByAuthor(suite.Ctx, suite.user1, 0, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(lst.Posts, 2)
+	suite.Equal("p1", lst.Posts[0].PostID)
+	suite.Equal("p2", lst.Posts[1].PostID)
+	lst, err = suite.pm.GetPostsByApp(suite.Ctx, suite.app1, 0, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(lst.Posts, 1)
+	suite.Equal("p2", lst.Posts[0].PostID)
+	// everything else starts empty.
+	suite.Equal(int64(1), suite.pm.postStorage.GetCensorshipNextID(suite.Ctx))
+	suite.Equal(int64(1), suite.pm.postStorage.GetEscrowNextID(suite.Ctx))
+	history, err := suite.pm.GetHistory(suite.Ctx, linotypes.GetPermlink(suite.user1, "p1"))
+	suite.Require().Nil(err)
+	suite.Empty(history.Revisions)
+
+	// versions between v2 and the current one were never released.
+	err2 = utils.Save(tmpfn, cdc, &model.PostTablesIR{Version: 5})
+	suite.Require().Nil(err2)
+	suite.NotNil(suite.pm.ImportFromFile(suite.Ctx, cdc, tmpfn))
+}
@@ -0,0 +1,119 @@
+package manager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	"github.com/lino-network/lino/x/post/types"
+)
+
+// recordDonation - add donation to stats of the post and its author.
+func (pm PostManager) recordDonation(ctx sdk.Context, post *model.Post, from linotypes.AccountKey, lino linotypes.Coin, ida, impact linotypes.MiniDollar) {
+	permlink := linotypes.GetPermlink(post.Author, post.PostID)
+	add := func(stats *model.DonationStats, newDonor bool) {
+		stats.LinoDonations = stats.LinoDonations.Plus(lino)
+		stats.IDADonations = stats.IDADonations.Plus(ida)
+		stats.NumDonations++
+		if newDonor {
+			stats.NumDonors++
+		}
+		stats.Impact = stats.Impact.Plus(impact)
+	}
+
+	postStats := pm.postStorage.GetPostStats(ctx, permlink)
+	newDonor := !pm.postStorage.HasPostDonor(ctx, permlink, from)
+	add(postStats, newDonor)
+	pm.postStorage.SetPostStats(ctx, permlink, postStats)
+	if newDonor {
+		pm.postStorage.SetPostDonor(ctx, permlink, from)
+	}
+
+	authorStats := pm.postStorage.GetAuthorStats(ctx, post.Author)
+	newDonor = !pm.postStorage.HasAuthorDonor(ctx, post.Author, from)
+	add(authorStats, newDonor)
+	pm.postStorage.SetAuthorStats(ctx, post.Author, authorStats)
+	if newDonor {
+		pm.postStorage.SetAuthorDonor(ctx, post.Author, from)
+	}
+}
+
+// revertDonation - subtract a refunded donation from stats of the post and its author,
+// donors are kept.
+func (pm PostManager) revertDonation(ctx sdk.Context, post *model.Post, lino linotypes.Coin, impact linotypes.MiniDollar) {
+	permlink := linotypes.GetPermlink(post.Author, post.PostID)
+	sub := func(stats *model.DonationStats) {
+		stats.LinoDonations = stats.LinoDonations.Minus(lino)
+		stats.NumDonations--
+		stats.Impact = stats.Impact.Minus(impact)
+	}
+
+	postStats := pm.postStorage.GetPostStats(ctx, permlink)
+	sub(postStats)
+	pm.postStorage.SetPostStats(ctx, permlink, postStats)
+
+	authorStats := pm.postStorage.GetAuthorStats(ctx, post.Author)
+	sub(authorStats)
+	pm.postStorage.SetAuthorStats(ctx, post.Author, authorStats)
+}
+
+// recordContentBonus - add content bonus to stats of the post and its author.
+func (pm PostManager) recordContentBonus(ctx sdk.Context, post *model.Post, bonus linotypes.Coin) {
+	permlink := linotypes.GetPermlink(post.Author, post.PostID)
+	postStats := pm.postStorage.GetPostStats(ctx, permlink)
+	postStats.ContentBonus = postStats.ContentBonus.Plus(bonus)
+	pm.postStorage.SetPostStats(ctx, permlink, postStats)
+
+	authorStats := pm.postStorage.GetAuthorStats(ctx, post.Author)
+	authorStats.ContentBonus = authorStats.ContentBonus.Plus(bonus)
+	pm.postStorage.SetAuthorStats(ctx, post.Author, authorStats)
+}
+
+// recordRecentDonation - add donation to recent donations of the post, the
+// number of donations kept is RecentDonationsSize of post param.
+func (pm PostManager) recordRecentDonation(ctx sdk.Context, permlink linotypes.Permlink, from linotypes.AccountKey, amount sdk.Int, currency types.Currency, app linotypes.AccountKey, memo string, escrowID int64) {
+	pm.postStorage.AddRecentDonation(ctx, permlink, &model.Donation{
+		Donor:     from,
+		Amount:    amount,
+		Currency:  currency,
+		App:       app,
+		Memo:      memo,
+		CreatedAt: ctx.BlockHeader().Time.Unix(),
+		EscrowID:  escrowID,
+	}, pm.getPostParam(ctx).RecentDonationsSize)
+}
+
+// removeRecentDonation - remove the escrowed donation from recent donations
+// of the post, if it is still kept.
+func (pm PostManager) removeRecentDonation(ctx sdk.Context, permlink linotypes.Permlink, escrowID int64) {
+	for _, d := range pm.postStorage.GetRecentDonations(ctx, permlink) {
+		if d.EscrowID == escrowID {
+			pm.postStorage.DeleteRecentDonation(ctx, permlink, d.Seq)
+			return
+		}
+	}
+}
+
+// GetPostStats - donation stats of the post or channel, stats of deleted posts are kept.
+func (pm PostManager) GetPostStats(ctx sdk.Context, permlink linotypes.Permlink) (*model.DonationStats, sdk.Error) {
+	if !pm.postStorage.HasPost(ctx, permlink) && !pm.isChannel(ctx, permlink) {
+		return nil, types.ErrPostNotFound(permlink)
+	}
+	return pm.postStorage.GetPostStats(ctx, permlink), nil
+}
+
+// GetAuthorStats - donation stats of all posts of the author.
+func (pm PostManager) GetAuthorStats(ctx sdk.Context, author linotypes.AccountKey) (*model.DonationStats, sdk.Error) {
+	if !pm.am.DoesAccountExist(ctx, author) {
+		return nil, types.ErrAccountNotFound(author)
+	}
+	return pm.postStorage.GetAuthorStats(ctx, author), nil
+}
+
+// GetRecentDonations - recent donations of the post or channel, newest first.
+func (pm PostManager) GetRecentDonations(ctx sdk.Context, permlink linotypes.Permlink) ([]model.Donation, sdk.Error) {
+	if !pm.postStorage.HasPost(ctx, permlink) && !pm.isChannel(ctx, permlink) {
+		return nil, types.ErrPostNotFound(permlink)
+	}
+	return pm.postStorage.GetRecentDonations(ctx, permlink), nil
+}
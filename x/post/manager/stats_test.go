@@ -0,0 +1,106 @@
+package manager
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	types "github.com/lino-network/lino/x/post/types"
+)
+
+func (suite *PostManagerTestSuite) TestDonationStats() {
+	user1 := suite.user1
+	user2 := suite.user2
+	app1 := suite.app1
+	post1 := linotypes.GetPermlink(user1, "post1")
+	post2 := linotypes.GetPermlink(user1, "post2")
+	suite.rep.On("DonateAt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		linotypes.NewMiniDollar(10), nil)
+	suite.vote.On("RecordFriction", mock.Anything, mock.Anything).Return(nil)
+	suite.global.On("RegisterEventAtTime", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := suite.pm.GetPostStats(suite.Ctx, post1)
+	suite.Equal(types.ErrPostNotFound(post1), err)
+	_, err = suite.pm.GetAuthorStats(suite.Ctx, suite.unreg1)
+	suite.Equal(types.ErrAccountNotFound(suite.unreg1), err)
+	stats, err := suite.pm.GetAuthorStats(suite.Ctx, user1)
+	suite.Nil(err)
+	suite.Equal(model.NewDonationStats(), stats)
+
+	for _, postID := range []string{"post1", "post2"} {
+		err = suite.pm.CreatePost(suite.Ctx, user1, postID, user1, "content", "title", "", nil, nil, nil)
+		suite.Require().Nil(err)
+	}
+	stats, err = suite.pm.GetPostStats(suite.Ctx, post1)
+	suite.Nil(err)
+	suite.Equal(model.NewDonationStats(), stats)
+
+	// user2 donates twice to post1 in LINO and IDA, app1 donates to post2.
+	_, err = suite.pm.afterDonation(suite.Ctx, user1, "post1", user2, linotypes.NewMiniDollar(1000),
+		linotypes.NewCoinFromInt64(100), linotypes.NewMiniDollar(0), linotypes.NewCoinFromInt64(1), app1, 0)
+	suite.Require().Nil(err)
+	_, err = suite.pm.afterDonation(suite.Ctx, user1, "post1", user2, linotypes.NewMiniDollar(500),
+		linotypes.NewCoinFromInt64(0), linotypes.NewMiniDollar(500), linotypes.NewCoinFromInt64(1), app1, 0)
+	suite.Require().Nil(err)
+	_, err = suite.pm.afterDonation(suite.Ctx, user1, "post2", app1, linotypes.NewMiniDollar(300),
+		linotypes.NewCoinFromInt64(0), linotypes.NewMiniDollar(300), linotypes.NewCoinFromInt64(1), app1, 0)
+	suite.Require().Nil(err)
+	_, err = suite.pm.afterDonation(suite.Ctx, user1, "post2", user2, linotypes.NewMiniDollar(300),
+		linotypes.NewCoinFromInt64(30), linotypes.NewMiniDollar(0), linotypes.NewCoinFromInt64(1), app1, 0)
+	suite.Require().Nil(err)
+
+	// content bonus of post1.
+	suite.pm.postStorage.SetConsumptionWindow(suite.Ctx, linotypes.NewMiniDollar(100))
+	suite.am.On("GetPool", mock.Anything, linotypes.InflationConsumptionPool).Return(
+		linotypes.NewCoinFromInt64(1000), nil)
+	suite.am.On("MoveFromPool", mock.Anything, linotypes.InflationConsumptionPool,
+		linotypes.NewAccOrAddrFromAcc(user1), linotypes.NewCoinFromInt64(100)).Return(nil).Once()
+	suite.dev.On("ReportConsumption", mock.Anything, app1, mock.Anything).Return(nil).Maybe()
+	err = suite.pm.ExecRewardEvent(suite.Ctx, types.RewardEvent{
+		PostAuthor: user1,
+		PostID:     "post1",
+		Consumer:   user2,
+		Evaluate:   linotypes.NewMiniDollar(10),
+		FromApp:    app1,
+	})
+	suite.Require().Nil(err)
+
+	stats, err = suite.pm.GetPostStats(suite.Ctx, post1)
+	suite.Nil(err)
+	suite.Equal(&model.DonationStats{
+		LinoDonations: linotypes.NewCoinFromInt64(100),
+		IDADonations:  linotypes.NewMiniDollar(500),
+		NumDonations:  2,
+		NumDonors:     1,
+		Impact:        linotypes.NewMiniDollar(20),
+		ContentBonus:  linotypes.NewCoinFromInt64(100),
+	}, stats)
+	stats, err = suite.pm.GetPostStats(suite.Ctx, post2)
+	suite.Nil(err)
+	suite.Equal(&model.DonationStats{
+		LinoDonations: linotypes.NewCoinFromInt64(30),
+		IDADonations:  linotypes.NewMiniDollar(300),
+		NumDonations:  2,
+		NumDonors:     2,
+		Impact:        linotypes.NewMiniDollar(20),
+		ContentBonus:  linotypes.NewCoinFromInt64(0),
+	}, stats)
+	stats, err = suite.pm.GetAuthorStats(suite.Ctx, user1)
+	suite.Nil(err)
+	suite.Equal(&model.DonationStats{
+		LinoDonations: linotypes.NewCoinFromInt64(130),
+		IDADonations:  linotypes.NewMiniDollar(800),
+		NumDonations:  4,
+		NumDonors:     2,
+		Impact:        linotypes.NewMiniDollar(40),
+		ContentBonus:  linotypes.NewCoinFromInt64(100),
+	}, stats)
+	suite.Equal([]linotypes.AccountKey{app1, user2}, suite.pm.postStorage.GetAuthorDonors(suite.Ctx, user1))
+
+	// stats of deleted posts are kept.
+	err = suite.pm.DeletePost(suite.Ctx, post2)
+	suite.Require().Nil(err)
+	stats, err = suite.pm.GetPostStats(suite.Ctx, post2)
+	suite.Nil(err)
+	suite.Equal(int64(2), stats.NumDonors)
+}
@@ -20,13 +20,45 @@ type PostKeeper struct {
 	mock.Mock
 }
 
-// CreatePost provides a mock function with given fields: ctx, author, postID, createdBy, content, title
-func (_m *PostKeeper) CreatePost(ctx types.Context, author linotypes.AccountKey, postID string, createdBy linotypes.AccountKey, content string, title string) types.Error {
-	ret := _m.Called(ctx, author, postID, createdBy, content, title)
+// BatchIDADonate provides a mock function with given fields: ctx, from, entries, app, signer, memo
+func (_m *PostKeeper) BatchIDADonate(ctx types.Context, from linotypes.AccountKey, entries []posttypes.DonateEntry, app linotypes.AccountKey, signer linotypes.AccountKey, memo string) types.Error {
+	ret := _m.Called(ctx, from, entries, app, signer, memo)
 
 	var r0 types.Error
-	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, string, linotypes.AccountKey, string, string) types.Error); ok {
-		r0 = rf(ctx, author, postID, createdBy, content, title)
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, []posttypes.DonateEntry, linotypes.AccountKey, linotypes.AccountKey, string) types.Error); ok {
+		r0 = rf(ctx, from, entries, app, signer, memo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
+// BatchLinoDonate provides a mock function with given fields: ctx, from, entries, app, memo
+func (_m *PostKeeper) BatchLinoDonate(ctx types.Context, from linotypes.AccountKey, entries []posttypes.DonateEntry, app linotypes.AccountKey, memo string) types.Error {
+	ret := _m.Called(ctx, from, entries, app, memo)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, []posttypes.DonateEntry, linotypes.AccountKey, string) types.Error); ok {
+		r0 = rf(ctx, from, entries, app, memo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
+// CreatePost provides a mock function with given fields: ctx, author, postID, createdBy, content, title, parent, beneficiaries, ref, tags
+func (_m *PostKeeper) CreatePost(ctx types.Context, author linotypes.AccountKey, postID string, createdBy linotypes.AccountKey, content string, title string, parent linotypes.Permlink, beneficiaries []posttypes.Beneficiary, ref *posttypes.ContentRef, tags []string) types.Error {
+	ret := _m.Called(ctx, author, postID, createdBy, content, title, parent, beneficiaries, ref, tags)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, string, linotypes.AccountKey, string, string, linotypes.Permlink, []posttypes.Beneficiary, *posttypes.ContentRef, []string) types.Error); ok {
+		r0 = rf(ctx, author, postID, createdBy, content, title, parent, beneficiaries, ref, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(types.Error)
@@ -66,6 +98,61 @@ func (_m *PostKeeper) DoesPostExist(ctx types.Context, permlink linotypes.Permli
 	return r0
 }
 
+// DonateToAccount provides a mock function with given fields: ctx, from, amount, author, app, memo
+func (_m *PostKeeper) DonateToAccount(ctx types.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, app linotypes.AccountKey, memo string) types.Error {
+	ret := _m.Called(ctx, from, amount, author, app, memo)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.Coin, linotypes.AccountKey, linotypes.AccountKey, string) types.Error); ok {
+		r0 = rf(ctx, from, amount, author, app, memo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
+// EscrowLinoDonate provides a mock function with given fields: ctx, from, amount, author, postID, app, memo
+func (_m *PostKeeper) EscrowLinoDonate(ctx types.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey, memo string) (int64, types.Error) {
+	ret := _m.Called(ctx, from, amount, author, postID, app, memo)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.Coin, linotypes.AccountKey, string, linotypes.AccountKey, string) int64); ok {
+		r0 = rf(ctx, from, amount, author, postID, app, memo)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey, linotypes.Coin, linotypes.AccountKey, string, linotypes.AccountKey, string) types.Error); ok {
+		r1 = rf(ctx, from, amount, author, postID, app, memo)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecDecideCensorshipEvent provides a mock function with given fields: ctx, event
+func (_m *PostKeeper) ExecDecideCensorshipEvent(ctx types.Context, event posttypes.DecideCensorshipEvent) types.Error {
+	ret := _m.Called(ctx, event)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, posttypes.DecideCensorshipEvent) types.Error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
 // ExecRewardEvent provides a mock function with given fields: ctx, reward
 func (_m *PostKeeper) ExecRewardEvent(ctx types.Context, reward posttypes.RewardEvent) types.Error {
 	ret := _m.Called(ctx, reward)
@@ -96,6 +183,120 @@ func (_m *PostKeeper) ExportToFile(ctx types.Context, cdc *amino.Codec, filepath
 	return r0
 }
 
+// FlagPost provides a mock function with given fields: ctx, flagger, permlink, deposit, reason
+func (_m *PostKeeper) FlagPost(ctx types.Context, flagger linotypes.AccountKey, permlink linotypes.Permlink, deposit linotypes.Coin, reason string) (int64, types.Error) {
+	ret := _m.Called(ctx, flagger, permlink, deposit, reason)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.Permlink, linotypes.Coin, string) int64); ok {
+		r0 = rf(ctx, flagger, permlink, deposit, reason)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey, linotypes.Permlink, linotypes.Coin, string) types.Error); ok {
+		r1 = rf(ctx, flagger, permlink, deposit, reason)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAuthorStats provides a mock function with given fields: ctx, author
+func (_m *PostKeeper) GetAuthorStats(ctx types.Context, author linotypes.AccountKey) (*model.DonationStats, types.Error) {
+	ret := _m.Called(ctx, author)
+
+	var r0 *model.DonationStats
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) *model.DonationStats); ok {
+		r0 = rf(ctx, author)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DonationStats)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey) types.Error); ok {
+		r1 = rf(ctx, author)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCensorship provides a mock function with given fields: ctx, id
+func (_m *PostKeeper) GetCensorship(ctx types.Context, id int64) (*model.Censorship, types.Error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Censorship
+	if rf, ok := ret.Get(0).(func(types.Context, int64) *model.Censorship); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Censorship)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, int64) types.Error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCensorshipVotes provides a mock function with given fields: ctx, id
+func (_m *PostKeeper) GetCensorshipVotes(ctx types.Context, id int64) ([]model.CensorshipVote, types.Error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []model.CensorshipVote
+	if rf, ok := ret.Get(0).(func(types.Context, int64) []model.CensorshipVote); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CensorshipVote)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, int64) types.Error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCensorships provides a mock function with given fields: ctx
+func (_m *PostKeeper) GetCensorships(ctx types.Context) []model.Censorship {
+	ret := _m.Called(ctx)
+
+	var r0 []model.Censorship
+	if rf, ok := ret.Get(0).(func(types.Context) []model.Censorship); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Censorship)
+		}
+	}
+
+	return r0
+}
+
 // GetComsumptionWindow provides a mock function with given fields: ctx
 func (_m *PostKeeper) GetComsumptionWindow(ctx types.Context) linotypes.MiniDollar {
 	ret := _m.Called(ctx)
@@ -110,6 +311,106 @@ func (_m *PostKeeper) GetComsumptionWindow(ctx types.Context) linotypes.MiniDoll
 	return r0
 }
 
+// GetContentBonusDryRun provides a mock function with given fields: ctx, event
+func (_m *PostKeeper) GetContentBonusDryRun(ctx types.Context, event posttypes.RewardEvent) (*model.ContentBonusDryRun, types.Error) {
+	ret := _m.Called(ctx, event)
+
+	var r0 *model.ContentBonusDryRun
+	if rf, ok := ret.Get(0).(func(types.Context, posttypes.RewardEvent) *model.ContentBonusDryRun); ok {
+		r0 = rf(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ContentBonusDryRun)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, posttypes.RewardEvent) types.Error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetContentRef provides a mock function with given fields: ctx, permlink
+func (_m *PostKeeper) GetContentRef(ctx types.Context, permlink linotypes.Permlink) (*posttypes.ContentRef, types.Error) {
+	ret := _m.Called(ctx, permlink)
+
+	var r0 *posttypes.ContentRef
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.Permlink) *posttypes.ContentRef); ok {
+		r0 = rf(ctx, permlink)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*posttypes.ContentRef)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.Permlink) types.Error); ok {
+		r1 = rf(ctx, permlink)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetEscrowedDonation provides a mock function with given fields: ctx, id
+func (_m *PostKeeper) GetEscrowedDonation(ctx types.Context, id int64) (*model.EscrowedDonation, types.Error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.EscrowedDonation
+	if rf, ok := ret.Get(0).(func(types.Context, int64) *model.EscrowedDonation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EscrowedDonation)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, int64) types.Error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetHistory provides a mock function with given fields: ctx, permlink
+func (_m *PostKeeper) GetHistory(ctx types.Context, permlink linotypes.Permlink) (*model.PostHistory, types.Error) {
+	ret := _m.Called(ctx, permlink)
+
+	var r0 *model.PostHistory
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.Permlink) *model.PostHistory); ok {
+		r0 = rf(ctx, permlink)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostHistory)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.Permlink) types.Error); ok {
+		r1 = rf(ctx, permlink)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetPost provides a mock function with given fields: ctx, permlink
 func (_m *PostKeeper) GetPost(ctx types.Context, permlink linotypes.Permlink) (model.Post, types.Error) {
 	ret := _m.Called(ctx, permlink)
@@ -133,13 +434,163 @@ func (_m *PostKeeper) GetPost(ctx types.Context, permlink linotypes.Permlink) (m
 	return r0, r1
 }
 
-// IDADonate provides a mock function with given fields: ctx, from, n, author, postID, app, signer
-func (_m *PostKeeper) IDADonate(ctx types.Context, from linotypes.AccountKey, n types.Int, author linotypes.AccountKey, postID string, app linotypes.AccountKey, signer linotypes.AccountKey) types.Error {
-	ret := _m.Called(ctx, from, n, author, postID, app, signer)
+// GetPostStats provides a mock function with given fields: ctx, permlink
+func (_m *PostKeeper) GetPostStats(ctx types.Context, permlink linotypes.Permlink) (*model.DonationStats, types.Error) {
+	ret := _m.Called(ctx, permlink)
+
+	var r0 *model.DonationStats
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.Permlink) *model.DonationStats); ok {
+		r0 = rf(ctx, permlink)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DonationStats)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.Permlink) types.Error); ok {
+		r1 = rf(ctx, permlink)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPostsByApp provides a mock function with given fields: ctx, app, start, limit
+func (_m *PostKeeper) GetPostsByApp(ctx types.Context, app linotypes.AccountKey, start int64, limit int64) (*model.PostList, types.Error) {
+	ret := _m.Called(ctx, app, start, limit)
+
+	var r0 *model.PostList
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, int64, int64) *model.PostList); ok {
+		r0 = rf(ctx, app, start, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostList)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey, int64, int64) types.Error); ok {
+		r1 = rf(ctx, app, start, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPostsByAuthor provides a mock function with given fields: ctx, author, start, limit
+func (_m *PostKeeper) GetPostsByAuthor(ctx types.Context, author linotypes.AccountKey, start int64, limit int64) (*model.PostList, types.Error) {
+	ret := _m.Called(ctx, author, start, limit)
+
+	var r0 *model.PostList
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, int64, int64) *model.PostList); ok {
+		r0 = rf(ctx, author, start, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostList)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey, int64, int64) types.Error); ok {
+		r1 = rf(ctx, author, start, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPostsByTag provides a mock function with given fields: ctx, tag, start, limit
+func (_m *PostKeeper) GetPostsByTag(ctx types.Context, tag string, start int64, limit int64) (*model.PostList, types.Error) {
+	ret := _m.Called(ctx, tag, start, limit)
+
+	var r0 *model.PostList
+	if rf, ok := ret.Get(0).(func(types.Context, string, int64, int64) *model.PostList); ok {
+		r0 = rf(ctx, tag, start, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostList)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, string, int64, int64) types.Error); ok {
+		r1 = rf(ctx, tag, start, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetRecentDonations provides a mock function with given fields: ctx, permlink
+func (_m *PostKeeper) GetRecentDonations(ctx types.Context, permlink linotypes.Permlink) ([]model.Donation, types.Error) {
+	ret := _m.Called(ctx, permlink)
+
+	var r0 []model.Donation
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.Permlink) []model.Donation); ok {
+		r0 = rf(ctx, permlink)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Donation)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.Permlink) types.Error); ok {
+		r1 = rf(ctx, permlink)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetReplies provides a mock function with given fields: ctx, permlink, start, limit
+func (_m *PostKeeper) GetReplies(ctx types.Context, permlink linotypes.Permlink, start int64, limit int64) (*model.Replies, types.Error) {
+	ret := _m.Called(ctx, permlink, start, limit)
+
+	var r0 *model.Replies
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.Permlink, int64, int64) *model.Replies); ok {
+		r0 = rf(ctx, permlink, start, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Replies)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.Permlink, int64, int64) types.Error); ok {
+		r1 = rf(ctx, permlink, start, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// IDADonate provides a mock function with given fields: ctx, from, n, author, postID, app, signer, memo
+func (_m *PostKeeper) IDADonate(ctx types.Context, from linotypes.AccountKey, n linotypes.MiniIDA, author linotypes.AccountKey, postID string, app linotypes.AccountKey, signer linotypes.AccountKey, memo string) types.Error {
+	ret := _m.Called(ctx, from, n, author, postID, app, signer, memo)
 
 	var r0 types.Error
-	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, types.Int, linotypes.AccountKey, string, linotypes.AccountKey, linotypes.AccountKey) types.Error); ok {
-		r0 = rf(ctx, from, n, author, postID, app, signer)
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.MiniIDA, linotypes.AccountKey, string, linotypes.AccountKey, linotypes.AccountKey, string) types.Error); ok {
+		r0 = rf(ctx, from, n, author, postID, app, signer, memo)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(types.Error)
@@ -163,13 +614,45 @@ func (_m *PostKeeper) ImportFromFile(ctx types.Context, cdc *amino.Codec, filepa
 	return r0
 }
 
-// LinoDonate provides a mock function with given fields: ctx, from, amount, author, postID, app
-func (_m *PostKeeper) LinoDonate(ctx types.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey) types.Error {
-	ret := _m.Called(ctx, from, amount, author, postID, app)
+// LinoDonate provides a mock function with given fields: ctx, from, amount, author, postID, app, memo
+func (_m *PostKeeper) LinoDonate(ctx types.Context, from linotypes.AccountKey, amount linotypes.Coin, author linotypes.AccountKey, postID string, app linotypes.AccountKey, memo string) types.Error {
+	ret := _m.Called(ctx, from, amount, author, postID, app, memo)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.Coin, linotypes.AccountKey, string, linotypes.AccountKey, string) types.Error); ok {
+		r0 = rf(ctx, from, amount, author, postID, app, memo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
+// RefundDonation provides a mock function with given fields: ctx, app, signer, id
+func (_m *PostKeeper) RefundDonation(ctx types.Context, app linotypes.AccountKey, signer linotypes.AccountKey, id int64) types.Error {
+	ret := _m.Called(ctx, app, signer, id)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.AccountKey, int64) types.Error); ok {
+		r0 = rf(ctx, app, signer, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
+// UpdatePost provides a mock function with given fields: ctx, author, postID, title, content, beneficiaries, ref
+func (_m *PostKeeper) UpdatePost(ctx types.Context, author linotypes.AccountKey, postID string, title string, content string, beneficiaries []posttypes.Beneficiary, ref *posttypes.ContentRef) types.Error {
+	ret := _m.Called(ctx, author, postID, title, content, beneficiaries, ref)
 
 	var r0 types.Error
-	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.Coin, linotypes.AccountKey, string, linotypes.AccountKey) types.Error); ok {
-		r0 = rf(ctx, from, amount, author, postID, app)
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, string, string, string, []posttypes.Beneficiary, *posttypes.ContentRef) types.Error); ok {
+		r0 = rf(ctx, author, postID, title, content, beneficiaries, ref)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(types.Error)
@@ -179,13 +662,13 @@ func (_m *PostKeeper) LinoDonate(ctx types.Context, from linotypes.AccountKey, a
 	return r0
 }
 
-// UpdatePost provides a mock function with given fields: ctx, author, postID, title, content
-func (_m *PostKeeper) UpdatePost(ctx types.Context, author linotypes.AccountKey, postID string, title string, content string) types.Error {
-	ret := _m.Called(ctx, author, postID, title, content)
+// VoteCensorship provides a mock function with given fields: ctx, voter, id, approve
+func (_m *PostKeeper) VoteCensorship(ctx types.Context, voter linotypes.AccountKey, id int64, approve bool) types.Error {
+	ret := _m.Called(ctx, voter, id, approve)
 
 	var r0 types.Error
-	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, string, string, string) types.Error); ok {
-		r0 = rf(ctx, author, postID, title, content)
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, int64, bool) types.Error); ok {
+		r0 = rf(ctx, voter, id, approve)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(types.Error)
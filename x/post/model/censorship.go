@@ -0,0 +1,28 @@
+package model
+
+import (
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/types"
+)
+
+// Censorship - a flag on a post with a deposit, decided by stake-weighted
+// votes of voters.
+type Censorship struct {
+	ID        int64                  `json:"id"`
+	Permlink  linotypes.Permlink     `json:"permlink"`
+	Flagger   linotypes.AccountKey   `json:"flagger"`
+	Deposit   linotypes.Coin         `json:"deposit"`
+	Reason    string                 `json:"reason"`
+	CreatedAt int64                  `json:"created_at"`
+	DecideAt  int64                  `json:"decide_at"`
+	Status    types.CensorshipStatus `json:"status"`
+	// lino stake of approve and reject voters when decided.
+	Approve linotypes.Coin `json:"approve"`
+	Reject  linotypes.Coin `json:"reject"`
+}
+
+// CensorshipVote - vote of a voter on a censorship.
+type CensorshipVote struct {
+	Voter   linotypes.AccountKey `json:"voter"`
+	Approve bool                 `json:"approve"`
+}
@@ -10,6 +10,18 @@ import (
 func NewPostDumper(store PostStorage) *testutils.Dumper {
 	dumper := testutils.NewDumper(store.key, store.cdc)
 	dumper.RegisterType(&Post{}, "lino/post", PostSubStore)
-	dumper.RegisterType(&types.MiniDollar{}, "lino/minidollar", ConsumptionWindowSubStore)
+	dumper.RegisterType(&types.MiniDollar{}, "lino/minidollar", ConsumptionWindowSubStore, ReputationWindowSubStore)
+	dumper.RegisterRawString(ReplySubStore)
+	dumper.RegisterType(&PostRevision{}, "lino/postrevision", RevisionSubStore)
+	dumper.RegisterType(&Censorship{}, "lino/censorship", CensorshipSubStore)
+	dumper.RegisterType(&CensorshipVote{}, "lino/censorshipvote", CensorshipVoteSubStore)
+	dumper.RegisterRawString(CensorshipNextIDSubStore)
+	dumper.RegisterType(&DonationStats{}, "lino/donationstats", PostStatsSubStore, AuthorStatsSubStore)
+	dumper.RegisterRawString(PostDonorSubStore, AuthorDonorSubStore)
+	dumper.RegisterType(&Donation{}, "lino/donation", RecentDonationSubStore)
+	dumper.RegisterRawString(RecentDonationSeqSubStore)
+	dumper.RegisterType(&EscrowedDonation{}, "lino/escroweddonation", EscrowSubStore)
+	dumper.RegisterRawString(EscrowNextIDSubStore)
+	dumper.RegisterRawString(PostByAuthorSubStore, PostByAppSubStore, PostByTagSubStore, PostIndexSizeSubStore)
 	return dumper
 }
@@ -0,0 +1,29 @@
+package model
+
+import (
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/types"
+)
+
+// EscrowedDonation - a LINO donation whose shares are held in the escrow pool
+// until its reward event is executed at ReleaseAt. Before that, the app of
+// the donation can refund it. Amount is the donated amount with friction,
+// Dollar is Amount in MiniDollar and Revision is the revision donated to.
+type EscrowedDonation struct {
+	ID        int64                `json:"id"`
+	From      linotypes.AccountKey `json:"from"`
+	Author    linotypes.AccountKey `json:"author"`
+	PostID    string               `json:"post_id"`
+	Revision  int64                `json:"revision"`
+	App       linotypes.AccountKey `json:"app"`
+	Amount    linotypes.Coin       `json:"amount"`
+	Shares    []types.Share        `json:"shares"`
+	Dollar    linotypes.MiniDollar `json:"dollar"`
+	Impact    linotypes.MiniDollar `json:"impact"`
+	CreatedAt int64                `json:"created_at"`
+	ReleaseAt int64                `json:"release_at"`
+	// Weight is the content bonus weight in the reward event, nil if not blended.
+	Weight *linotypes.MiniDollar `json:"weight,omitempty"`
+	// Beneficiaries in the reward event.
+	Beneficiaries []types.Beneficiary `json:"beneficiaries,omitempty"`
+}
@@ -1,7 +1,10 @@
 package model
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/lino-network/lino/types"
+	posttypes "github.com/lino-network/lino/x/post/types"
 )
 
 // PostIR - is the IR of Post.
@@ -14,6 +17,90 @@ type PostIR struct {
 	CreatedAt int64            `json:"created_at"`
 	UpdatedAt int64            `json:"updated_at"`
 	IsDeleted bool             `json:"is_deleted"`
+	// ParentPermlink is empty for top level posts.
+	ParentPermlink types.Permlink `json:"parent_permlink,omitempty"`
+	ReplyCount     int64          `json:"reply_count,omitempty"`
+	// Revision is increased by one on each update, starts from 0.
+	Revision int64 `json:"revision,omitempty"`
+	// IsCensored is set when a censorship on the post passes, it is
+	// independent of IsDeleted. CensorshipID is the latest censorship.
+	IsCensored   bool  `json:"is_censored,omitempty"`
+	CensorshipID int64 `json:"censorship_id,omitempty"`
+	// Beneficiaries share donations and content bonus of the post by weight,
+	// the author receives the rest.
+	Beneficiaries []posttypes.Beneficiary `json:"beneficiaries,omitempty"`
+	// ContentRef is set if the content is stored off chain, Content is empty then.
+	ContentRef *posttypes.ContentRef `json:"content_ref,omitempty"`
+	Tags       []string              `json:"tags,omitempty"`
+}
+
+// PostRevisionIR - is the IR of PostRevision.
+type PostRevisionIR struct {
+	Revision     int64            `json:"revision"`
+	ContentHash  string           `json:"content_hash"`
+	Title        string           `json:"title,omitempty"`
+	Content      string           `json:"content,omitempty"`
+	CreatedAt    int64            `json:"created_at"`
+	Donations    types.MiniDollar `json:"donations"`
+	NumDonations int64            `json:"num_donations"`
+	// ContentRef of the revision, only its hash is kept when the post is deleted.
+	ContentRef *posttypes.ContentRef `json:"content_ref,omitempty"`
+}
+
+// PostHistoryIR - revisions of a post.
+type PostHistoryIR struct {
+	Permlink  types.Permlink   `json:"permlink"`
+	Revisions []PostRevisionIR `json:"revisions"`
+}
+
+// RepliesIR - replies of a post, in creation order.
+type RepliesIR struct {
+	Parent  types.Permlink   `json:"parent"`
+	Replies []types.Permlink `json:"replies"`
+}
+
+// DonationStatsIR - donation aggregates.
+type DonationStatsIR struct {
+	LinoDonations types.Coin       `json:"lino_donations"`
+	IDADonations  types.MiniDollar `json:"ida_donations"`
+	NumDonations  int64            `json:"num_donations"`
+	NumDonors     int64            `json:"num_donors"`
+	Impact        types.MiniDollar `json:"impact"`
+	ContentBonus  types.Coin       `json:"content_bonus"`
+}
+
+// PostStatsIR - donation stats of a post with its donors, pk: permlink
+type PostStatsIR struct {
+	Permlink types.Permlink     `json:"permlink"`
+	Stats    DonationStatsIR    `json:"stats"`
+	Donors   []types.AccountKey `json:"donors"`
+}
+
+// AuthorStatsIR - donation stats of an author with its donors, pk: author
+type AuthorStatsIR struct {
+	Author types.AccountKey   `json:"author"`
+	Stats  DonationStatsIR    `json:"stats"`
+	Donors []types.AccountKey `json:"donors"`
+}
+
+// DonationIR - is the IR of Donation.
+type DonationIR struct {
+	Seq       int64              `json:"seq"`
+	Donor     types.AccountKey   `json:"donor"`
+	Amount    sdk.Int            `json:"amount"`
+	Currency  posttypes.Currency `json:"currency"`
+	App       types.AccountKey   `json:"app"`
+	Memo      string             `json:"memo"`
+	CreatedAt int64              `json:"created_at"`
+	EscrowID  int64              `json:"escrow_id,omitempty"`
+}
+
+// RecentDonationsIR - recent donations of a post, oldest first, pk: permlink
+type RecentDonationsIR struct {
+	Permlink  types.Permlink `json:"permlink"`
+	Donations []DonationIR   `json:"donations"`
+	// NextSeq is absent in states exported before it was stored.
+	NextSeq int64 `json:"next_seq,omitempty"`
 }
 
 // PostTablesIR - is the Post State.
@@ -21,4 +108,57 @@ type PostTablesIR struct {
 	Version           int              `json:"version"`
 	Posts             []PostIR         `json:"posts"`
 	ConsumptionWindow types.MiniDollar `json:"consumption_window"`
+	Replies           []RepliesIR      `json:"replies"`
+	Histories         []PostHistoryIR  `json:"histories"`
+	Censorships       []CensorshipIR   `json:"censorships"`
+	CensorshipNextID  int64            `json:"censorship_next_id"`
+	PostStats         []PostStatsIR    `json:"post_stats"`
+	AuthorStats       []AuthorStatsIR  `json:"author_stats"`
+	// RecentDonations is absent in states exported before recent donations.
+	RecentDonations []RecentDonationsIR `json:"recent_donations,omitempty"`
+	// Escrows is absent in states exported before escrowed donations.
+	Escrows      []EscrowedDonationIR `json:"escrows,omitempty"`
+	EscrowNextID int64                `json:"escrow_next_id,omitempty"`
+	// ReputationWindow is absent in states exported before the reputation window.
+	ReputationWindow *types.MiniDollar `json:"reputation_window,omitempty"`
+}
+
+// CensorshipIR - censorship with its votes, pk: id
+type CensorshipIR struct {
+	ID        int64                      `json:"id"`
+	Permlink  types.Permlink             `json:"permlink"`
+	Flagger   types.AccountKey           `json:"flagger"`
+	Deposit   types.Coin                 `json:"deposit"`
+	Reason    string                     `json:"reason"`
+	CreatedAt int64                      `json:"created_at"`
+	DecideAt  int64                      `json:"decide_at"`
+	Status    posttypes.CensorshipStatus `json:"status"`
+	Approve   types.Coin                 `json:"approve"`
+	Reject    types.Coin                 `json:"reject"`
+	Votes     []CensorshipVoteIR         `json:"votes"`
+}
+
+// CensorshipVoteIR - vote on censorship.
+type CensorshipVoteIR struct {
+	Voter   types.AccountKey `json:"voter"`
+	Approve bool             `json:"approve"`
+}
+
+// EscrowedDonationIR - is the IR of EscrowedDonation, pk: id
+type EscrowedDonationIR struct {
+	ID        int64             `json:"id"`
+	From      types.AccountKey  `json:"from"`
+	Author    types.AccountKey  `json:"author"`
+	PostID    string            `json:"post_id"`
+	Revision  int64             `json:"revision"`
+	App       types.AccountKey  `json:"app"`
+	Amount    types.Coin        `json:"amount"`
+	Shares    []posttypes.Share `json:"shares"`
+	Dollar    types.MiniDollar  `json:"dollar"`
+	Impact    types.MiniDollar  `json:"impact"`
+	CreatedAt int64             `json:"created_at"`
+	ReleaseAt int64             `json:"release_at"`
+	Weight    *types.MiniDollar `json:"weight,omitempty"`
+	// Beneficiaries is absent in escrows exported before it was recorded.
+	Beneficiaries []posttypes.Beneficiary `json:"beneficiaries,omitempty"`
 }
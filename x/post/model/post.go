@@ -1,7 +1,11 @@
 package model
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	posttypes "github.com/lino-network/lino/x/post/types"
 )
 
 // Post - post is created by the CreatedBy.
@@ -14,4 +18,113 @@ type Post struct {
 	CreatedAt int64            `json:"created_at"`
 	UpdatedAt int64            `json:"updated_at"`
 	IsDeleted bool             `json:"is_deleted"`
+	// ParentPermlink is empty for top level posts.
+	ParentPermlink types.Permlink `json:"parent_permlink,omitempty"`
+	ReplyCount     int64          `json:"reply_count,omitempty"`
+	// Revision is increased by one on each update, starts from 0.
+	Revision int64 `json:"revision,omitempty"`
+	// IsCensored is set when a censorship on the post passes, it is
+	// independent of IsDeleted. CensorshipID is the latest censorship.
+	IsCensored   bool  `json:"is_censored,omitempty"`
+	CensorshipID int64 `json:"censorship_id,omitempty"`
+	// Beneficiaries share donations and content bonus of the post by weight,
+	// the author receives the rest.
+	Beneficiaries []posttypes.Beneficiary `json:"beneficiaries,omitempty"`
+	// ContentRef is set if the content is stored off chain, Content is empty then.
+	ContentRef *posttypes.ContentRef `json:"content_ref,omitempty"`
+	Tags       []string              `json:"tags,omitempty"`
+}
+
+// PostRevision - a revision of a post.
+// Title and content are kept only if KeepRevisionContent is set when the
+// revision is created, and are cleared when the post is deleted.
+// Donations are the donations received while the revision was the latest one.
+type PostRevision struct {
+	Revision     int64            `json:"revision"`
+	ContentHash  string           `json:"content_hash"`
+	Title        string           `json:"title,omitempty"`
+	Content      string           `json:"content,omitempty"`
+	CreatedAt    int64            `json:"created_at"`
+	Donations    types.MiniDollar `json:"donations"`
+	NumDonations int64            `json:"num_donations"`
+	// ContentRef of the revision, only its hash is kept when the post is deleted.
+	ContentRef *posttypes.ContentRef `json:"content_ref,omitempty"`
+}
+
+// PostHistory - all revisions of a post, in revision order.
+type PostHistory struct {
+	Permlink  types.Permlink `json:"permlink"`
+	Revision  int64          `json:"revision"`
+	IsDeleted bool           `json:"is_deleted"`
+	Revisions []PostRevision `json:"revisions"`
+}
+
+// Replies - a page of replies of a post, in creation order.
+// Deleted replies are kept in place so that the thread keeps its shape.
+type Replies struct {
+	Parent  types.Permlink `json:"parent"`
+	Total   int64          `json:"total"`
+	Start   int64          `json:"start"`
+	Replies []Post         `json:"replies"`
+}
+
+// PostList - a page of posts of an author, an app or a tag, in creation order.
+// Deleted posts are kept in place, with title and content cleared.
+type PostList struct {
+	Total int64  `json:"total"`
+	Start int64  `json:"start"`
+	Posts []Post `json:"posts"`
+}
+
+// DonationStats - donation aggregates of a post, or of all posts of an author.
+// Donations are counted before friction, content bonus is the total
+// received by the author and beneficiaries.
+type DonationStats struct {
+	LinoDonations types.Coin       `json:"lino_donations"`
+	IDADonations  types.MiniDollar `json:"ida_donations"`
+	NumDonations  int64            `json:"num_donations"`
+	NumDonors     int64            `json:"num_donors"`
+	Impact        types.MiniDollar `json:"impact"`
+	ContentBonus  types.Coin       `json:"content_bonus"`
+}
+
+// NewDonationStats - stats without any donation.
+func NewDonationStats() *DonationStats {
+	return &DonationStats{
+		LinoDonations: types.NewCoinFromInt64(0),
+		IDADonations:  types.NewMiniDollar(0),
+		Impact:        types.NewMiniDollar(0),
+		ContentBonus:  types.NewCoinFromInt64(0),
+	}
+}
+
+// Donation - a donation kept in recent donations of a post.
+// Amount is in the smallest unit of the currency, Coin for LINO and MiniIDA
+// for IDA, friction included. Seq increases by one on each donation to the post.
+type Donation struct {
+	Seq       int64              `json:"seq"`
+	Donor     types.AccountKey   `json:"donor"`
+	Amount    sdk.Int            `json:"amount"`
+	Currency  posttypes.Currency `json:"currency"`
+	App       types.AccountKey   `json:"app"`
+	Memo      string             `json:"memo"`
+	CreatedAt int64              `json:"created_at"`
+	EscrowID  int64              `json:"escrow_id,omitempty"`
+}
+
+// ContentBonus - content bonus of a reward event under a policy.
+type ContentBonus struct {
+	Policy param.ContentBonusPolicy `json:"policy"`
+	Weight types.MiniDollar         `json:"weight"`
+	Bonus  types.Coin               `json:"bonus"`
+}
+
+// ContentBonusDryRun - content bonus the author would receive if the reward
+// event were executed now, under each policy.
+type ContentBonusDryRun struct {
+	Policy            param.ContentBonusPolicy `json:"policy"`
+	ConsumptionWindow types.MiniDollar         `json:"consumption_window"`
+	ReputationWindow  types.MiniDollar         `json:"reputation_window"`
+	RewardPool        types.Coin               `json:"reward_pool"`
+	Bonuses           []ContentBonus           `json:"bonuses"`
 }
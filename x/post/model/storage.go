@@ -1,6 +1,9 @@
 package model
 
 import (
+	"encoding/binary"
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,6 +15,24 @@ import (
 var (
 	PostSubStore              = []byte{0x00} // SubStore for all post info
 	ConsumptionWindowSubStore = []byte{0x01} // SubStore for consumption window.
+	ReplySubStore             = []byte{0x02} // SubStore for replies of posts.
+	RevisionSubStore          = []byte{0x03} // SubStore for revisions of posts.
+	CensorshipSubStore        = []byte{0x04} // SubStore for censorships.
+	CensorshipVoteSubStore    = []byte{0x05} // SubStore for votes on censorships.
+	CensorshipNextIDSubStore  = []byte{0x06} // SubStore for next censorship id.
+	PostStatsSubStore         = []byte{0x07} // SubStore for donation stats of posts.
+	AuthorStatsSubStore       = []byte{0x08} // SubStore for donation stats of authors.
+	PostDonorSubStore         = []byte{0x09} // SubStore for donors of posts.
+	AuthorDonorSubStore       = []byte{0x0a} // SubStore for donors of authors.
+	RecentDonationSubStore    = []byte{0x0b} // SubStore for recent donations of posts.
+	EscrowSubStore            = []byte{0x0c} // SubStore for escrowed donations.
+	EscrowNextIDSubStore      = []byte{0x0d} // SubStore for next escrowed donation id.
+	PostByAuthorSubStore      = []byte{0x0e} // SubStore for posts by author.
+	PostByAppSubStore         = []byte{0x0f} // SubStore for posts by creating app.
+	PostByTagSubStore         = []byte{0x10} // SubStore for posts by tag.
+	PostIndexSizeSubStore     = []byte{0x11} // SubStore for number of posts in indexes.
+	ReputationWindowSubStore  = []byte{0x12} // SubStore for reputation window.
+	RecentDonationSeqSubStore = []byte{0x13} // SubStore for next seq of recent donations of posts.
 )
 
 func GetAuthorPrefix(author linotypes.AccountKey) []byte {
@@ -28,6 +49,125 @@ func GetConsumptionWindowKey() []byte {
 	return ConsumptionWindowSubStore
 }
 
+// GetReputationWindowKey - "reputation window substore"
+func GetReputationWindowKey() []byte {
+	return ReputationWindowSubStore
+}
+
+// GetRepliesPrefix - "reply substore" + "len(parent)" + "parent"
+func GetRepliesPrefix(parent linotypes.Permlink) []byte {
+	return getPermlinkPrefix(ReplySubStore, parent)
+}
+
+// GetReplyKey - "reply substore" + "len(parent)" + "parent" + "index"
+func GetReplyKey(parent linotypes.Permlink, index int64) []byte {
+	return append(GetRepliesPrefix(parent), int64Bytes(index)...)
+}
+
+// GetRevisionsPrefix - "revision substore" + "len(permlink)" + "permlink"
+func GetRevisionsPrefix(permlink linotypes.Permlink) []byte {
+	return getPermlinkPrefix(RevisionSubStore, permlink)
+}
+
+// GetRevisionKey - "revision substore" + "len(permlink)" + "permlink" + "revision"
+func GetRevisionKey(permlink linotypes.Permlink, revision int64) []byte {
+	return append(GetRevisionsPrefix(permlink), int64Bytes(revision)...)
+}
+
+// GetCensorshipKey - "censorship substore" + big endian id
+func GetCensorshipKey(id int64) []byte {
+	return append(CensorshipSubStore, int64Bytes(id)...)
+}
+
+// GetCensorshipVotePrefix - "censorship vote substore" + big endian id
+func GetCensorshipVotePrefix(id int64) []byte {
+	return append(CensorshipVoteSubStore, int64Bytes(id)...)
+}
+
+// GetCensorshipVoteKey - "censorship vote substore" + big endian id + "voter"
+func GetCensorshipVoteKey(id int64, voter linotypes.AccountKey) []byte {
+	return append(GetCensorshipVotePrefix(id), voter...)
+}
+
+// GetEscrowKey - "escrow substore" + big endian id
+func GetEscrowKey(id int64) []byte {
+	return append(EscrowSubStore, int64Bytes(id)...)
+}
+
+// GetPostStatsKey - "post stats substore" + "permlink"
+func GetPostStatsKey(permlink linotypes.Permlink) []byte {
+	return append(PostStatsSubStore, permlink...)
+}
+
+// GetAuthorStatsKey - "author stats substore" + "author"
+func GetAuthorStatsKey(author linotypes.AccountKey) []byte {
+	return append(AuthorStatsSubStore, author...)
+}
+
+// GetPostDonorsPrefix - "post donor substore" + "len(permlink)" + "permlink"
+func GetPostDonorsPrefix(permlink linotypes.Permlink) []byte {
+	return getPermlinkPrefix(PostDonorSubStore, permlink)
+}
+
+// GetPostDonorKey - "post donor substore" + "len(permlink)" + "permlink" + "donor"
+func GetPostDonorKey(permlink linotypes.Permlink, donor linotypes.AccountKey) []byte {
+	return append(GetPostDonorsPrefix(permlink), donor...)
+}
+
+// GetAuthorDonorsPrefix - "author donor substore" + "len(author)" + "author"
+func GetAuthorDonorsPrefix(author linotypes.AccountKey) []byte {
+	return getPermlinkPrefix(AuthorDonorSubStore, linotypes.Permlink(author))
+}
+
+// GetAuthorDonorKey - "author donor substore" + "len(author)" + "author" + "donor"
+func GetAuthorDonorKey(author, donor linotypes.AccountKey) []byte {
+	return append(GetAuthorDonorsPrefix(author), donor...)
+}
+
+// GetRecentDonationsPrefix - "recent donation substore" + "len(permlink)" + "permlink"
+func GetRecentDonationsPrefix(permlink linotypes.Permlink) []byte {
+	return getPermlinkPrefix(RecentDonationSubStore, permlink)
+}
+
+// GetRecentDonationKey - "recent donation substore" + "len(permlink)" + "permlink" + "seq"
+func GetRecentDonationKey(permlink linotypes.Permlink, seq int64) []byte {
+	return append(GetRecentDonationsPrefix(permlink), int64Bytes(seq)...)
+}
+
+// GetRecentDonationSeqKey - "recent donation seq substore" + "permlink"
+func GetRecentDonationSeqKey(permlink linotypes.Permlink) []byte {
+	return append(RecentDonationSeqSubStore, permlink...)
+}
+
+// GetPostIndexPrefix - "index substore" + "len(key)" + "key"
+func GetPostIndexPrefix(index []byte, key string) []byte {
+	return getPermlinkPrefix(index, linotypes.Permlink(key))
+}
+
+// GetPostIndexKey - "index substore" + "len(key)" + "key" + "seq"
+func GetPostIndexKey(index []byte, key string, seq int64) []byte {
+	return append(GetPostIndexPrefix(index, key), int64Bytes(seq)...)
+}
+
+// GetPostIndexSizeKey - "index size substore" + "index substore" + "len(key)" + "key"
+func GetPostIndexSizeKey(index []byte, key string) []byte {
+	return append(PostIndexSizeSubStore, GetPostIndexPrefix(index, key)...)
+}
+
+// getPermlinkPrefix - "substore" + "len(permlink)" + "permlink"
+// permlink is length-prefixed so that keys of "a#1" do not share a prefix with "a#10".
+func getPermlinkPrefix(substore []byte, permlink linotypes.Permlink) []byte {
+	lenBytes := make([]byte, 2)
+	binary.BigEndian.PutUint16(lenBytes, uint16(len(permlink)))
+	return append(append(substore, lenBytes...), permlink...)
+}
+
+func int64Bytes(v int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(v))
+	return bz
+}
+
 // PostStorage - post storage
 type PostStorage struct {
 	key sdk.StoreKey
@@ -79,6 +219,146 @@ func (ps PostStorage) SetPost(ctx sdk.Context, postInfo *Post) {
 // 	store.Delete(GetPostInfoKey(permlink))
 // }
 
+// GetReply - get the index-th reply of parent, index starts from 0.
+func (ps PostStorage) GetReply(ctx sdk.Context, parent linotypes.Permlink, index int64) (linotypes.Permlink, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(GetReplyKey(parent, index))
+	if bz == nil {
+		return "", types.ErrReplyNotFound(parent, index)
+	}
+	return linotypes.Permlink(bz), nil
+}
+
+// SetReply - set the index-th reply of parent.
+func (ps PostStorage) SetReply(ctx sdk.Context, parent linotypes.Permlink, index int64, child linotypes.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Set(GetReplyKey(parent, index), []byte(child))
+}
+
+// GetRevision - get a revision of the post.
+func (ps PostStorage) GetRevision(ctx sdk.Context, permlink linotypes.Permlink, revision int64) (*PostRevision, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(GetRevisionKey(permlink, revision))
+	if bz == nil {
+		return nil, types.ErrRevisionNotFound(permlink, revision)
+	}
+	rev := new(PostRevision)
+	ps.cdc.MustUnmarshalBinaryLengthPrefixed(bz, rev)
+	return rev, nil
+}
+
+// SetRevision - set a revision of the post.
+func (ps PostStorage) SetRevision(ctx sdk.Context, permlink linotypes.Permlink, rev *PostRevision) {
+	store := ctx.KVStore(ps.key)
+	bz := ps.cdc.MustMarshalBinaryLengthPrefixed(*rev)
+	store.Set(GetRevisionKey(permlink, rev.Revision), bz)
+}
+
+// GetCensorship - get censorship by id.
+func (ps PostStorage) GetCensorship(ctx sdk.Context, id int64) (*Censorship, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(GetCensorshipKey(id))
+	if bz == nil {
+		return nil, types.ErrCensorshipNotFound(id)
+	}
+	censorship := new(Censorship)
+	ps.cdc.MustUnmarshalBinaryLengthPrefixed(bz, censorship)
+	return censorship, nil
+}
+
+// SetCensorship - set censorship.
+func (ps PostStorage) SetCensorship(ctx sdk.Context, censorship *Censorship) {
+	store := ctx.KVStore(ps.key)
+	bz := ps.cdc.MustMarshalBinaryLengthPrefixed(*censorship)
+	store.Set(GetCensorshipKey(censorship.ID), bz)
+}
+
+// SetCensorshipVote - set vote of voter on censorship.
+func (ps PostStorage) SetCensorshipVote(ctx sdk.Context, id int64, vote *CensorshipVote) {
+	store := ctx.KVStore(ps.key)
+	bz := ps.cdc.MustMarshalBinaryLengthPrefixed(*vote)
+	store.Set(GetCensorshipVoteKey(id, vote.Voter), bz)
+}
+
+// GetCensorshipVotes - all votes on censorship, sorted by voter.
+func (ps PostStorage) GetCensorshipVotes(ctx sdk.Context, id int64) []CensorshipVote {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(store, GetCensorshipVotePrefix(id))
+	defer iter.Close()
+	rst := make([]CensorshipVote, 0)
+	for ; iter.Valid(); iter.Next() {
+		vote := CensorshipVote{}
+		ps.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &vote)
+		rst = append(rst, vote)
+	}
+	return rst
+}
+
+// GetCensorshipNextID - id of next censorship, starts from 1.
+func (ps PostStorage) GetCensorshipNextID(ctx sdk.Context) int64 {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(CensorshipNextIDSubStore)
+	if bz == nil {
+		return 1
+	}
+	id, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetCensorshipNextID - set id of next censorship.
+func (ps PostStorage) SetCensorshipNextID(ctx sdk.Context, id int64) {
+	store := ctx.KVStore(ps.key)
+	store.Set(CensorshipNextIDSubStore, []byte(strconv.FormatInt(id, 10)))
+}
+
+// GetEscrowedDonation - get escrowed donation by id.
+func (ps PostStorage) GetEscrowedDonation(ctx sdk.Context, id int64) (*EscrowedDonation, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(GetEscrowKey(id))
+	if bz == nil {
+		return nil, types.ErrEscrowNotFound(id)
+	}
+	escrow := new(EscrowedDonation)
+	ps.cdc.MustUnmarshalBinaryLengthPrefixed(bz, escrow)
+	return escrow, nil
+}
+
+// SetEscrowedDonation - set escrowed donation.
+func (ps PostStorage) SetEscrowedDonation(ctx sdk.Context, escrow *EscrowedDonation) {
+	store := ctx.KVStore(ps.key)
+	bz := ps.cdc.MustMarshalBinaryLengthPrefixed(*escrow)
+	store.Set(GetEscrowKey(escrow.ID), bz)
+}
+
+// DeleteEscrowedDonation - delete escrowed donation.
+func (ps PostStorage) DeleteEscrowedDonation(ctx sdk.Context, id int64) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetEscrowKey(id))
+}
+
+// GetEscrowNextID - id of next escrowed donation, starts from 1.
+func (ps PostStorage) GetEscrowNextID(ctx sdk.Context) int64 {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(EscrowNextIDSubStore)
+	if bz == nil {
+		return 1
+	}
+	id, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetEscrowNextID - set id of next escrowed donation.
+func (ps PostStorage) SetEscrowNextID(ctx sdk.Context, id int64) {
+	store := ctx.KVStore(ps.key)
+	store.Set(EscrowNextIDSubStore, []byte(strconv.FormatInt(id, 10)))
+}
+
 func (ps PostStorage) GetConsumptionWindow(ctx sdk.Context) linotypes.MiniDollar {
 	store := ctx.KVStore(ps.key)
 	bz := store.Get(GetConsumptionWindowKey())
@@ -96,6 +376,225 @@ func (ps PostStorage) SetConsumptionWindow(ctx sdk.Context, consumption linotype
 	store.Set(GetConsumptionWindowKey(), bz)
 }
 
+// GetReputationWindow - sum of content bonus weights of pending reward events
+// blended under the reputation policy.
+func (ps PostStorage) GetReputationWindow(ctx sdk.Context) linotypes.MiniDollar {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(GetReputationWindowKey())
+	if bz == nil {
+		return linotypes.NewMiniDollar(0)
+	}
+	window := linotypes.NewMiniDollar(0)
+	ps.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &window)
+	return window
+}
+
+// SetReputationWindow - set reputation window.
+func (ps PostStorage) SetReputationWindow(ctx sdk.Context, window linotypes.MiniDollar) {
+	store := ctx.KVStore(ps.key)
+	bz := ps.cdc.MustMarshalBinaryLengthPrefixed(window)
+	store.Set(GetReputationWindowKey(), bz)
+}
+
+// GetPostStats - donation stats of the post, empty stats if there is no donation.
+func (ps PostStorage) GetPostStats(ctx sdk.Context, permlink linotypes.Permlink) *DonationStats {
+	return ps.getStats(ctx, GetPostStatsKey(permlink))
+}
+
+// SetPostStats - set donation stats of the post.
+func (ps PostStorage) SetPostStats(ctx sdk.Context, permlink linotypes.Permlink, stats *DonationStats) {
+	ps.setStats(ctx, GetPostStatsKey(permlink), stats)
+}
+
+// GetAuthorStats - donation stats of the author, empty stats if there is no donation.
+func (ps PostStorage) GetAuthorStats(ctx sdk.Context, author linotypes.AccountKey) *DonationStats {
+	return ps.getStats(ctx, GetAuthorStatsKey(author))
+}
+
+// SetAuthorStats - set donation stats of the author.
+func (ps PostStorage) SetAuthorStats(ctx sdk.Context, author linotypes.AccountKey, stats *DonationStats) {
+	ps.setStats(ctx, GetAuthorStatsKey(author), stats)
+}
+
+// HasPostDonor - returns true if donor has donated to the post.
+func (ps PostStorage) HasPostDonor(ctx sdk.Context, permlink linotypes.Permlink, donor linotypes.AccountKey) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(GetPostDonorKey(permlink, donor))
+}
+
+// SetPostDonor - record donor of the post.
+func (ps PostStorage) SetPostDonor(ctx sdk.Context, permlink linotypes.Permlink, donor linotypes.AccountKey) {
+	store := ctx.KVStore(ps.key)
+	store.Set(GetPostDonorKey(permlink, donor), []byte(donor))
+}
+
+// GetPostDonors - all donors of the post, sorted.
+func (ps PostStorage) GetPostDonors(ctx sdk.Context, permlink linotypes.Permlink) []linotypes.AccountKey {
+	return ps.getDonors(ctx, GetPostDonorsPrefix(permlink))
+}
+
+// HasAuthorDonor - returns true if donor has donated to any post of the author.
+func (ps PostStorage) HasAuthorDonor(ctx sdk.Context, author, donor linotypes.AccountKey) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(GetAuthorDonorKey(author, donor))
+}
+
+// SetAuthorDonor - record donor of the author.
+func (ps PostStorage) SetAuthorDonor(ctx sdk.Context, author, donor linotypes.AccountKey) {
+	store := ctx.KVStore(ps.key)
+	store.Set(GetAuthorDonorKey(author, donor), []byte(donor))
+}
+
+// GetAuthorDonors - all donors of the author, sorted.
+func (ps PostStorage) GetAuthorDonors(ctx sdk.Context, author linotypes.AccountKey) []linotypes.AccountKey {
+	return ps.getDonors(ctx, GetAuthorDonorsPrefix(author))
+}
+
+// SetRecentDonation - set a recent donation of the post at its seq.
+func (ps PostStorage) SetRecentDonation(ctx sdk.Context, permlink linotypes.Permlink, donation *Donation) {
+	store := ctx.KVStore(ps.key)
+	bz := ps.cdc.MustMarshalBinaryLengthPrefixed(*donation)
+	store.Set(GetRecentDonationKey(permlink, donation.Seq), bz)
+}
+
+// AddRecentDonation - append donation to recent donations of the post with the
+// next seq, and prune donations that are not among the latest size ones.
+// More than one donation is pruned only if size has been decreased.
+func (ps PostStorage) AddRecentDonation(ctx sdk.Context, permlink linotypes.Permlink, donation *Donation, size int64) {
+	store := ctx.KVStore(ps.key)
+	prefix := GetRecentDonationsPrefix(permlink)
+	donation.Seq = ps.GetRecentDonationNextSeq(ctx, permlink)
+	ps.SetRecentDonationNextSeq(ctx, permlink, donation.Seq+1)
+	if size > 0 {
+		ps.SetRecentDonation(ctx, permlink, donation)
+	}
+
+	stale := make([][]byte, 0)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	for ; iter.Valid(); iter.Next() {
+		d := Donation{}
+		ps.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &d)
+		if d.Seq > donation.Seq-size {
+			break
+		}
+		stale = append(stale, iter.Key())
+	}
+	iter.Close()
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+// DeleteRecentDonation - delete the recent donation of the post at seq, seq is not reused.
+func (ps PostStorage) DeleteRecentDonation(ctx sdk.Context, permlink linotypes.Permlink, seq int64) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetRecentDonationKey(permlink, seq))
+}
+
+// GetRecentDonationNextSeq - seq of the next donation to the post, which does not
+// depend on the donations kept. Posts without a stored seq continue from
+// their last kept donation.
+func (ps PostStorage) GetRecentDonationNextSeq(ctx sdk.Context, permlink linotypes.Permlink) int64 {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(GetRecentDonationSeqKey(permlink))
+	if bz == nil {
+		last := sdk.KVStoreReversePrefixIterator(store, GetRecentDonationsPrefix(permlink))
+		defer last.Close()
+		if !last.Valid() {
+			return 0
+		}
+		prev := Donation{}
+		ps.cdc.MustUnmarshalBinaryLengthPrefixed(last.Value(), &prev)
+		return prev.Seq + 1
+	}
+	seq, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return seq
+}
+
+// SetRecentDonationNextSeq - set seq of the next donation to the post.
+func (ps PostStorage) SetRecentDonationNextSeq(ctx sdk.Context, permlink linotypes.Permlink, seq int64) {
+	store := ctx.KVStore(ps.key)
+	store.Set(GetRecentDonationSeqKey(permlink), []byte(strconv.FormatInt(seq, 10)))
+}
+
+// GetRecentDonations - recent donations of the post, newest first.
+func (ps PostStorage) GetRecentDonations(ctx sdk.Context, permlink linotypes.Permlink) []Donation {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStoreReversePrefixIterator(store, GetRecentDonationsPrefix(permlink))
+	defer iter.Close()
+	rst := make([]Donation, 0)
+	for ; iter.Valid(); iter.Next() {
+		d := Donation{}
+		ps.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &d)
+		rst = append(rst, d)
+	}
+	return rst
+}
+
+// AddPostToIndex - append permlink to posts of key in the index, which is one of
+// PostByAuthorSubStore, PostByAppSubStore and PostByTagSubStore.
+func (ps PostStorage) AddPostToIndex(ctx sdk.Context, index []byte, key string, permlink linotypes.Permlink) {
+	store := ctx.KVStore(ps.key)
+	size := ps.GetPostIndexSize(ctx, index, key)
+	store.Set(GetPostIndexKey(index, key, size), []byte(permlink))
+	store.Set(GetPostIndexSizeKey(index, key), []byte(strconv.FormatInt(size+1, 10)))
+}
+
+// GetPostIndexSize - number of posts of key in the index.
+func (ps PostStorage) GetPostIndexSize(ctx sdk.Context, index []byte, key string) int64 {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(GetPostIndexSizeKey(index, key))
+	if bz == nil {
+		return 0
+	}
+	size, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return size
+}
+
+// GetPostFromIndex - get the seq-th post of key in the index, seq starts from 0.
+func (ps PostStorage) GetPostFromIndex(ctx sdk.Context, index []byte, key string, seq int64) (linotypes.Permlink, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(GetPostIndexKey(index, key, seq))
+	if bz == nil {
+		return "", types.ErrPostIndexNotFound(key, seq)
+	}
+	return linotypes.Permlink(bz), nil
+}
+
+func (ps PostStorage) getStats(ctx sdk.Context, key []byte) *DonationStats {
+	store := ctx.KVStore(ps.key)
+	bz := store.Get(key)
+	if bz == nil {
+		return NewDonationStats()
+	}
+	stats := new(DonationStats)
+	ps.cdc.MustUnmarshalBinaryLengthPrefixed(bz, stats)
+	return stats
+}
+
+func (ps PostStorage) setStats(ctx sdk.Context, key []byte, stats *DonationStats) {
+	store := ctx.KVStore(ps.key)
+	bz := ps.cdc.MustMarshalBinaryLengthPrefixed(*stats)
+	store.Set(key, bz)
+}
+
+func (ps PostStorage) getDonors(ctx sdk.Context, prefix []byte) []linotypes.AccountKey {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	rst := make([]linotypes.AccountKey, 0)
+	for ; iter.Valid(); iter.Next() {
+		rst = append(rst, linotypes.AccountKey(iter.Value()))
+	}
+	return rst
+}
+
 func (ps PostStorage) PartialStoreMap(ctx sdk.Context) utils.StoreMap {
 	store := ctx.KVStore(ps.key)
 	stores := []utils.SubStore{
@@ -105,6 +604,36 @@ func (ps PostStorage) PartialStoreMap(ctx sdk.Context) utils.StoreMap {
 			ValCreator: func() interface{} { return new(Post) },
 			Decoder:    ps.cdc.MustUnmarshalBinaryLengthPrefixed,
 		},
+		{
+			Store:      store,
+			Prefix:     RevisionSubStore,
+			ValCreator: func() interface{} { return new(PostRevision) },
+			Decoder:    ps.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     CensorshipSubStore,
+			ValCreator: func() interface{} { return new(Censorship) },
+			Decoder:    ps.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     PostStatsSubStore,
+			ValCreator: func() interface{} { return new(DonationStats) },
+			Decoder:    ps.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     AuthorStatsSubStore,
+			ValCreator: func() interface{} { return new(DonationStats) },
+			Decoder:    ps.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     EscrowSubStore,
+			ValCreator: func() interface{} { return new(EscrowedDonation) },
+			Decoder:    ps.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
 	}
 	return utils.NewStoreMap(stores)
 }
@@ -11,6 +11,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/types"
 )
 
 type postStoreTestSuite struct {
@@ -88,3 +89,242 @@ func (suite *postStoreTestSuite) TestConsumptionWIndowGetSet() {
 	suite.ps.SetConsumptionWindow(suite.ctx, linotypes.NewMiniDollar(2000))
 	suite.Equal(linotypes.NewMiniDollar(2000), suite.ps.GetConsumptionWindow(suite.ctx))
 }
+
+func (suite *postStoreTestSuite) TestReputationWindowGetSet() {
+	suite.Equal(linotypes.NewMiniDollar(0), suite.ps.GetReputationWindow(suite.ctx))
+	suite.ps.SetReputationWindow(suite.ctx, linotypes.NewMiniDollar(1000))
+	suite.Equal(linotypes.NewMiniDollar(1000), suite.ps.GetReputationWindow(suite.ctx))
+	suite.Equal(linotypes.NewMiniDollar(0), suite.ps.GetConsumptionWindow(suite.ctx))
+}
+
+func (suite *postStoreTestSuite) TestReplyGetSet() {
+	parent1 := linotypes.GetPermlink("author", "1")
+	parent2 := linotypes.GetPermlink("author", "10")
+	child1 := linotypes.GetPermlink("replier", "1")
+	child2 := linotypes.GetPermlink("replier", "2")
+
+	_, err := suite.ps.GetReply(suite.ctx, parent1, 0)
+	suite.NotNil(err)
+
+	suite.ps.SetReply(suite.ctx, parent1, 0, child1)
+	suite.ps.SetReply(suite.ctx, parent2, 0, child2)
+	rst, err := suite.ps.GetReply(suite.ctx, parent1, 0)
+	suite.Nil(err)
+	suite.Equal(child1, rst)
+	rst, err = suite.ps.GetReply(suite.ctx, parent2, 0)
+	suite.Nil(err)
+	suite.Equal(child2, rst)
+	_, err = suite.ps.GetReply(suite.ctx, parent1, 1)
+	suite.Equal(types.ErrReplyNotFound(parent1, 1), err)
+}
+
+func (suite *postStoreTestSuite) TestRevisionGetSet() {
+	permlink := linotypes.GetPermlink("author", "1")
+	rev0 := &PostRevision{
+		Revision:    0,
+		ContentHash: types.ContentHash("title", "content"),
+		Title:       "title",
+		Content:     "content",
+		CreatedAt:   1,
+		Donations:   linotypes.NewMiniDollar(100),
+	}
+	rev1 := &PostRevision{
+		Revision:     1,
+		ContentHash:  types.ContentHash("title2", "content2"),
+		CreatedAt:    2,
+		Donations:    linotypes.NewMiniDollar(0),
+		NumDonations: 0,
+	}
+
+	_, err := suite.ps.GetRevision(suite.ctx, permlink, 0)
+	suite.Equal(types.ErrRevisionNotFound(permlink, 0), err)
+
+	suite.ps.SetRevision(suite.ctx, permlink, rev0)
+	suite.ps.SetRevision(suite.ctx, permlink, rev1)
+	rst, err := suite.ps.GetRevision(suite.ctx, permlink, 0)
+	suite.Nil(err)
+	suite.Equal(rev0, rst)
+	rst, err = suite.ps.GetRevision(suite.ctx, permlink, 1)
+	suite.Nil(err)
+	suite.Equal(rev1, rst)
+	_, err = suite.ps.GetRevision(suite.ctx, linotypes.GetPermlink("author", "10"), 0)
+	suite.NotNil(err)
+}
+
+func (suite *postStoreTestSuite) TestCensorshipGetSet() {
+	censorship := &Censorship{
+		ID:        1,
+		Permlink:  linotypes.GetPermlink("author", "1"),
+		Flagger:   "flagger",
+		Deposit:   linotypes.NewCoinFromInt64(100),
+		Reason:    "spam",
+		CreatedAt: 1,
+		DecideAt:  101,
+		Status:    types.CensorshipPending,
+		Approve:   linotypes.NewCoinFromInt64(0),
+		Reject:    linotypes.NewCoinFromInt64(0),
+	}
+	_, err := suite.ps.GetCensorship(suite.ctx, 1)
+	suite.Equal(types.ErrCensorshipNotFound(1), err)
+	suite.Equal(int64(1), suite.ps.GetCensorshipNextID(suite.ctx))
+
+	suite.ps.SetCensorship(suite.ctx, censorship)
+	suite.ps.SetCensorshipNextID(suite.ctx, 2)
+	rst, err := suite.ps.GetCensorship(suite.ctx, 1)
+	suite.Nil(err)
+	suite.Equal(censorship, rst)
+	suite.Equal(int64(2), suite.ps.GetCensorshipNextID(suite.ctx))
+
+	suite.Equal([]CensorshipVote{}, suite.ps.GetCensorshipVotes(suite.ctx, 1))
+	suite.ps.SetCensorshipVote(suite.ctx, 1, &CensorshipVote{Voter: "voter2", Approve: true})
+	suite.ps.SetCensorshipVote(suite.ctx, 1, &CensorshipVote{Voter: "voter1", Approve: false})
+	suite.ps.SetCensorshipVote(suite.ctx, 10, &CensorshipVote{Voter: "voter3", Approve: true})
+	suite.Equal([]CensorshipVote{
+		{Voter: "voter1", Approve: false},
+		{Voter: "voter2", Approve: true},
+	}, suite.ps.GetCensorshipVotes(suite.ctx, 1))
+}
+
+func (suite *postStoreTestSuite) TestDonationStatsGetSet() {
+	permlink := linotypes.GetPermlink("author", "1")
+	suite.Equal(NewDonationStats(), suite.ps.GetPostStats(suite.ctx, permlink))
+	suite.Equal(NewDonationStats(), suite.ps.GetAuthorStats(suite.ctx, "author"))
+
+	stats := &DonationStats{
+		LinoDonations: linotypes.NewCoinFromInt64(1),
+		IDADonations:  linotypes.NewMiniDollar(2),
+		NumDonations:  3,
+		NumDonors:     4,
+		Impact:        linotypes.NewMiniDollar(5),
+		ContentBonus:  linotypes.NewCoinFromInt64(6),
+	}
+	suite.ps.SetPostStats(suite.ctx, permlink, stats)
+	suite.Equal(stats, suite.ps.GetPostStats(suite.ctx, permlink))
+	suite.Equal(NewDonationStats(), suite.ps.GetPostStats(suite.ctx, linotypes.GetPermlink("author", "10")))
+	suite.ps.SetAuthorStats(suite.ctx, "author", stats)
+	suite.Equal(stats, suite.ps.GetAuthorStats(suite.ctx, "author"))
+
+	suite.False(suite.ps.HasPostDonor(suite.ctx, permlink, "donor"))
+	suite.ps.SetPostDonor(suite.ctx, permlink, "donor")
+	suite.ps.SetPostDonor(suite.ctx, linotypes.GetPermlink("author", "10"), "donor2")
+	suite.True(suite.ps.HasPostDonor(suite.ctx, permlink, "donor"))
+	suite.Equal([]linotypes.AccountKey{"donor"}, suite.ps.GetPostDonors(suite.ctx, permlink))
+
+	suite.False(suite.ps.HasAuthorDonor(suite.ctx, "author", "donor"))
+	suite.ps.SetAuthorDonor(suite.ctx, "author", "donor")
+	suite.ps.SetAuthorDonor(suite.ctx, "author2", "donor2")
+	suite.True(suite.ps.HasAuthorDonor(suite.ctx, "author", "donor"))
+	suite.Equal([]linotypes.AccountKey{"donor"}, suite.ps.GetAuthorDonors(suite.ctx, "author"))
+}
+
+func (suite *postStoreTestSuite) TestRecentDonations() {
+	permlink := linotypes.GetPermlink("author", "1")
+	other := linotypes.GetPermlink("author", "10")
+	suite.Equal([]Donation{}, suite.ps.GetRecentDonations(suite.ctx, permlink))
+
+	seqs := func(permlink linotypes.Permlink) []int64 {
+		rst := make([]int64, 0)
+		for _, d := range suite.ps.GetRecentDonations(suite.ctx, permlink) {
+			rst = append(rst, d.Seq)
+		}
+		return rst
+	}
+	for i := 0; i < 5; i++ {
+		suite.ps.AddRecentDonation(suite.ctx, permlink, &Donation{
+			Donor:    "donor",
+			Amount:   sdk.NewInt(int64(i)),
+			Currency: types.CurrencyLino,
+			Memo:     "memo",
+		}, 3)
+	}
+	suite.ps.AddRecentDonation(suite.ctx, other, &Donation{
+		Donor:    "donor",
+		Amount:   sdk.NewInt(1),
+		Currency: types.CurrencyIDA,
+	}, 3)
+	suite.Equal([]int64{4, 3, 2}, seqs(permlink))
+	suite.Equal([]int64{0}, seqs(other))
+	suite.Equal(Donation{
+		Seq:      4,
+		Donor:    "donor",
+		Amount:   sdk.NewInt(4),
+		Currency: types.CurrencyLino,
+		Memo:     "memo",
+	}, suite.ps.GetRecentDonations(suite.ctx, permlink)[0])
+
+	// size decreased.
+	suite.ps.AddRecentDonation(suite.ctx, permlink, &Donation{Amount: sdk.NewInt(5)}, 1)
+	suite.Equal([]int64{5}, seqs(permlink))
+	// size increased.
+	suite.ps.AddRecentDonation(suite.ctx, permlink, &Donation{Amount: sdk.NewInt(6)}, 3)
+	suite.Equal([]int64{6, 5}, seqs(permlink))
+	// disabled.
+	suite.ps.AddRecentDonation(suite.ctx, permlink, &Donation{Amount: sdk.NewInt(7)}, 0)
+	suite.Equal([]int64{}, seqs(permlink))
+	suite.Equal([]int64{0}, seqs(other))
+	// seq does not restart when enabled again.
+	suite.ps.AddRecentDonation(suite.ctx, permlink, &Donation{Amount: sdk.NewInt(8)}, 3)
+	suite.Equal([]int64{8}, seqs(permlink))
+	suite.Equal(int64(9), suite.ps.GetRecentDonationNextSeq(suite.ctx, permlink))
+	// deleted seq is not reused.
+	suite.ps.DeleteRecentDonation(suite.ctx, permlink, 8)
+	suite.Equal([]int64{}, seqs(permlink))
+	suite.Equal(int64(9), suite.ps.GetRecentDonationNextSeq(suite.ctx, permlink))
+}
+
+func (suite *postStoreTestSuite) TestEscrowedDonationGetSet() {
+	escrow := &EscrowedDonation{
+		ID:        1,
+		From:      "donor",
+		Author:    "author",
+		PostID:    "1",
+		App:       "app",
+		Amount:    linotypes.NewCoinFromInt64(100),
+		Shares:    []types.Share{{Account: "author", Amount: sdk.NewInt(90)}},
+		Dollar:    linotypes.NewMiniDollar(100),
+		Impact:    linotypes.NewMiniDollar(10),
+		CreatedAt: 1,
+		ReleaseAt: 101,
+	}
+	_, err := suite.ps.GetEscrowedDonation(suite.ctx, 1)
+	suite.Equal(types.ErrEscrowNotFound(1), err)
+	suite.Equal(int64(1), suite.ps.GetEscrowNextID(suite.ctx))
+
+	suite.ps.SetEscrowedDonation(suite.ctx, escrow)
+	suite.ps.SetEscrowNextID(suite.ctx, 2)
+	rst, err := suite.ps.GetEscrowedDonation(suite.ctx, 1)
+	suite.Nil(err)
+	suite.Equal(escrow, rst)
+	suite.Equal(int64(2), suite.ps.GetEscrowNextID(suite.ctx))
+
+	suite.ps.DeleteEscrowedDonation(suite.ctx, 1)
+	_, err = suite.ps.GetEscrowedDonation(suite.ctx, 1)
+	suite.Equal(types.ErrEscrowNotFound(1), err)
+}
+
+func (suite *postStoreTestSuite) TestPostIndex() {
+	post1 := linotypes.GetPermlink("author", "1")
+	post2 := linotypes.GetPermlink("author", "2")
+	post3 := linotypes.GetPermlink("authorx", "1")
+
+	suite.Equal(int64(0), suite.ps.GetPostIndexSize(suite.ctx, PostByAuthorSubStore, "author"))
+	_, err := suite.ps.GetPostFromIndex(suite.ctx, PostByAuthorSubStore, "author", 0)
+	suite.Equal(types.ErrPostIndexNotFound("author", 0), err)
+
+	suite.ps.AddPostToIndex(suite.ctx, PostByAuthorSubStore, "author", post1)
+	suite.ps.AddPostToIndex(suite.ctx, PostByAuthorSubStore, "author", post2)
+	suite.ps.AddPostToIndex(suite.ctx, PostByAuthorSubStore, "authorx", post3)
+	suite.ps.AddPostToIndex(suite.ctx, PostByTagSubStore, "author", post3)
+	suite.Equal(int64(2), suite.ps.GetPostIndexSize(suite.ctx, PostByAuthorSubStore, "author"))
+	suite.Equal(int64(1), suite.ps.GetPostIndexSize(suite.ctx, PostByAuthorSubStore, "authorx"))
+	suite.Equal(int64(1), suite.ps.GetPostIndexSize(suite.ctx, PostByTagSubStore, "author"))
+	suite.Equal(int64(0), suite.ps.GetPostIndexSize(suite.ctx, PostByAppSubStore, "author"))
+	for i, expected := range []linotypes.Permlink{post1, post2} {
+		rst, err := suite.ps.GetPostFromIndex(suite.ctx, PostByAuthorSubStore, "author", int64(i))
+		suite.Nil(err)
+		suite.Equal(expected, rst)
+	}
+	rst, err := suite.ps.GetPostFromIndex(suite.ctx, PostByTagSubStore, "author", 0)
+	suite.Nil(err)
+	suite.Equal(post3, rst)
+}
@@ -1,6 +1,8 @@
 package post
 
 import (
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -24,8 +26,114 @@ func NewQuerier(pm PostKeeper) sdk.Querier {
 			return utils.NewQueryResolver(0, func(args ...string) (interface{}, sdk.Error) {
 				return pm.GetComsumptionWindow(ctx), nil
 			})(ctx, cdc, path)
+		case types.QueryContentBonus:
+			return utils.NewQueryResolver(4, func(args ...string) (interface{}, sdk.Error) {
+				impact, ok := sdk.NewIntFromString(args[3])
+				if !ok {
+					return nil, linotypes.ErrInvalidQueryPath()
+				}
+				return pm.GetContentBonusDryRun(ctx, types.RewardEvent{
+					PostAuthor: linotypes.AccountKey(args[0]),
+					PostID:     args[1],
+					Consumer:   linotypes.AccountKey(args[2]),
+					Evaluate:   linotypes.NewMiniDollarFromInt(impact),
+				})
+			})(ctx, cdc, path)
+		case types.QueryHistory:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				return pm.GetHistory(ctx, linotypes.Permlink(args[0]))
+			})(ctx, cdc, path)
+		case types.QueryPostStats:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				return pm.GetPostStats(ctx, linotypes.Permlink(args[0]))
+			})(ctx, cdc, path)
+		case types.QueryAuthorStats:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				return pm.GetAuthorStats(ctx, linotypes.AccountKey(args[0]))
+			})(ctx, cdc, path)
+		case types.QueryRecentDonations:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				return pm.GetRecentDonations(ctx, linotypes.Permlink(args[0]))
+			})(ctx, cdc, path)
+		case types.QueryEscrow:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				id, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return nil, linotypes.ErrInvalidQueryPath()
+				}
+				return pm.GetEscrowedDonation(ctx, id)
+			})(ctx, cdc, path)
+		case types.QueryContentRef:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				return pm.GetContentRef(ctx, linotypes.Permlink(args[0]))
+			})(ctx, cdc, path)
+		case types.QueryCensorship:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				id, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return nil, linotypes.ErrInvalidQueryPath()
+				}
+				return pm.GetCensorship(ctx, id)
+			})(ctx, cdc, path)
+		case types.QueryCensorships:
+			return utils.NewQueryResolver(0, func(args ...string) (interface{}, sdk.Error) {
+				return pm.GetCensorships(ctx), nil
+			})(ctx, cdc, path)
+		case types.QueryCensorshipVotes:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				id, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return nil, linotypes.ErrInvalidQueryPath()
+				}
+				return pm.GetCensorshipVotes(ctx, id)
+			})(ctx, cdc, path)
+		case types.QueryReplies:
+			return utils.NewQueryResolver(3, func(args ...string) (interface{}, sdk.Error) {
+				start, limit, err := parsePage(args[1], args[2])
+				if err != nil {
+					return nil, err
+				}
+				return pm.GetReplies(ctx, linotypes.Permlink(args[0]), start, limit)
+			})(ctx, cdc, path)
+		case types.QueryPostsByAuthor:
+			return utils.NewQueryResolver(3, func(args ...string) (interface{}, sdk.Error) {
+				start, limit, err := parsePage(args[1], args[2])
+				if err != nil {
+					return nil, err
+				}
+				return pm.GetPostsByAuthor(ctx, linotypes.AccountKey(args[0]), start, limit)
+			})(ctx, cdc, path)
+		case types.QueryPostsByApp:
+			return utils.NewQueryResolver(3, func(args ...string) (interface{}, sdk.Error) {
+				start, limit, err := parsePage(args[1], args[2])
+				if err != nil {
+					return nil, err
+				}
+				return pm.GetPostsByApp(ctx, linotypes.AccountKey(args[0]), start, limit)
+			})(ctx, cdc, path)
+		case types.QueryPostsByTag:
+			return utils.NewQueryResolver(3, func(args ...string) (interface{}, sdk.Error) {
+				start, limit, err := parsePage(args[1], args[2])
+				if err != nil {
+					return nil, err
+				}
+				return pm.GetPostsByTag(ctx, args[0], start, limit)
+			})(ctx, cdc, path)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown post query endpoint")
 		}
 	}
 }
+
+// parsePage - parse start and limit of a paginated query.
+func parsePage(startStr, limitStr string) (start, limit int64, err sdk.Error) {
+	start, e := strconv.ParseInt(startStr, 10, 64)
+	if e != nil {
+		return 0, 0, linotypes.ErrInvalidQueryPath()
+	}
+	limit, e = strconv.ParseInt(limitStr, 10, 64)
+	if e != nil {
+		return 0, 0, linotypes.ErrInvalidQueryPath()
+	}
+	return start, limit, nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lino-network/lino/types"
+)
+
+const (
+	// MaxBeneficiaries - max number of beneficiaries of a post.
+	MaxBeneficiaries = 10
+	// TotalBasisPoints - weights of beneficiaries are in basis points.
+	TotalBasisPoints = 10000
+)
+
+// Beneficiary - account that receives Weight basis points of donations and
+// content bonus of a post.
+type Beneficiary struct {
+	Account types.AccountKey `json:"account"`
+	Weight  int64            `json:"weight"`
+}
+
+// Share - amount that goes to an account.
+type Share struct {
+	Account types.AccountKey `json:"account"`
+	Amount  sdk.Int          `json:"amount"`
+}
+
+// ValidateBeneficiaries - stateless check of beneficiaries of the author's post.
+// weights are positive and sum up to no more than TotalBasisPoints,
+// the rest goes to the author, so author can not be a beneficiary.
+func ValidateBeneficiaries(author types.AccountKey, beneficiaries []Beneficiary) sdk.Error {
+	if len(beneficiaries) > MaxBeneficiaries {
+		return ErrInvalidBeneficiaries(fmt.Sprintf("more than %d beneficiaries", MaxBeneficiaries))
+	}
+	total := int64(0)
+	seen := make(map[types.AccountKey]bool)
+	for _, b := range beneficiaries {
+		if !b.Account.IsValid() {
+			return ErrInvalidBeneficiaries(fmt.Sprintf("invalid account: %s", b.Account))
+		}
+		if b.Account == author {
+			return ErrInvalidBeneficiaries("author can not be a beneficiary")
+		}
+		if seen[b.Account] {
+			return ErrInvalidBeneficiaries(fmt.Sprintf("duplicated account: %s", b.Account))
+		}
+		seen[b.Account] = true
+		if b.Weight <= 0 || b.Weight > TotalBasisPoints {
+			return ErrInvalidBeneficiaries(fmt.Sprintf("invalid weight of %s: %d", b.Account, b.Weight))
+		}
+		total += b.Weight
+	}
+	if total > TotalBasisPoints {
+		return ErrInvalidBeneficiaries(fmt.Sprintf("total weight %d exceeds %d", total, TotalBasisPoints))
+	}
+	return nil
+}
+
+// SplitAmount - split amount among beneficiaries by weight, rounded down, and the
+// rest goes to the author. Shares are in beneficiaries order followed by the author,
+// shares that are not positive are omitted.
+func SplitAmount(author types.AccountKey, beneficiaries []Beneficiary, amount sdk.Int) []Share {
+	rst := make([]Share, 0, len(beneficiaries)+1)
+	rest := amount
+	for _, b := range beneficiaries {
+		share := amount.MulRaw(b.Weight).QuoRaw(TotalBasisPoints)
+		if !share.IsPositive() {
+			continue
+		}
+		rest = rest.Sub(share)
+		rst = append(rst, Share{Account: b.Account, Amount: share})
+	}
+	if rest.IsPositive() {
+		rst = append(rst, Share{Account: author, Amount: rest})
+	}
+	return rst
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lino-network/lino/types"
+)
+
+func TestValidateBeneficiaries(t *testing.T) {
+	tooMany := make([]Beneficiary, 0)
+	for i := 0; i <= MaxBeneficiaries; i++ {
+		tooMany = append(tooMany, Beneficiary{Account: types.AccountKey("user" + string(rune('a'+i))), Weight: 1})
+	}
+	testCases := []struct {
+		testName      string
+		beneficiaries []Beneficiary
+		expected      sdk.Error
+	}{
+		{
+			testName: "empty",
+			expected: nil,
+		},
+		{
+			testName: "normal",
+			beneficiaries: []Beneficiary{
+				{Account: "editor", Weight: 2500},
+				{Account: "cohost", Weight: 7500},
+			},
+			expected: nil,
+		},
+		{
+			testName:      "too many",
+			beneficiaries: tooMany,
+			expected:      ErrInvalidBeneficiaries("more than 10 beneficiaries"),
+		},
+		{
+			testName:      "invalid account",
+			beneficiaries: []Beneficiary{{Account: "", Weight: 1}},
+			expected:      ErrInvalidBeneficiaries("invalid account: "),
+		},
+		{
+			testName:      "author",
+			beneficiaries: []Beneficiary{{Account: "author", Weight: 1}},
+			expected:      ErrInvalidBeneficiaries("author can not be a beneficiary"),
+		},
+		{
+			testName: "duplicated",
+			beneficiaries: []Beneficiary{
+				{Account: "editor", Weight: 1},
+				{Account: "editor", Weight: 1},
+			},
+			expected: ErrInvalidBeneficiaries("duplicated account: editor"),
+		},
+		{
+			testName:      "zero weight",
+			beneficiaries: []Beneficiary{{Account: "editor", Weight: 0}},
+			expected:      ErrInvalidBeneficiaries("invalid weight of editor: 0"),
+		},
+		{
+			testName: "total exceeds",
+			beneficiaries: []Beneficiary{
+				{Account: "editor", Weight: 5000},
+				{Account: "cohost", Weight: 5001},
+			},
+			expected: ErrInvalidBeneficiaries("total weight 10001 exceeds 10000"),
+		},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, ValidateBeneficiaries("author", tc.beneficiaries), tc.testName)
+	}
+}
+
+func TestSplitAmount(t *testing.T) {
+	beneficiaries := []Beneficiary{
+		{Account: "editor", Weight: 2500},
+		{Account: "cohost", Weight: 3333},
+	}
+	testCases := []struct {
+		testName      string
+		beneficiaries []Beneficiary
+		amount        int64
+		expected      []Share
+	}{
+		{
+			testName: "no beneficiaries",
+			amount:   100,
+			expected: []Share{{Account: "author", Amount: sdk.NewInt(100)}},
+		},
+		{
+			testName:      "rounded down, rest to author",
+			beneficiaries: beneficiaries,
+			amount:        1001,
+			expected: []Share{
+				{Account: "editor", Amount: sdk.NewInt(250)},
+				{Account: "cohost", Amount: sdk.NewInt(333)},
+				{Account: "author", Amount: sdk.NewInt(418)},
+			},
+		},
+		{
+			testName:      "zero shares omitted",
+			beneficiaries: beneficiaries,
+			amount:        3,
+			expected:      []Share{{Account: "author", Amount: sdk.NewInt(3)}},
+		},
+		{
+			testName:      "all to beneficiaries",
+			beneficiaries: []Beneficiary{{Account: "editor", Weight: 10000}},
+			amount:        7,
+			expected:      []Share{{Account: "editor", Amount: sdk.NewInt(7)}},
+		},
+		{
+			testName:      "zero amount",
+			beneficiaries: beneficiaries,
+			amount:        0,
+			expected:      []Share{},
+		},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, SplitAmount("author", tc.beneficiaries, sdk.NewInt(tc.amount)), tc.testName)
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+// CensorshipStatus - status of a censorship.
+type CensorshipStatus int
+
+const (
+	CensorshipPending  CensorshipStatus = 1
+	CensorshipPassed   CensorshipStatus = 2
+	CensorshipRejected CensorshipStatus = 3
+)
@@ -11,6 +11,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(DeletePostMsg{}, "lino/deletePost", nil)
 	cdc.RegisterConcrete(DonateMsg{}, "lino/donate", nil)
 	cdc.RegisterConcrete(IDADonateMsg{}, "lino/idaDonate", nil)
+	cdc.RegisterConcrete(DonateToAccountMsg{}, "lino/donateToAccount", nil)
+	cdc.RegisterConcrete(BatchDonateMsg{}, "lino/batchDonate", nil)
+	cdc.RegisterConcrete(BatchIDADonateMsg{}, "lino/batchIdaDonate", nil)
+	cdc.RegisterConcrete(FlagPostMsg{}, "lino/flagPost", nil)
+	cdc.RegisterConcrete(VoteCensorshipMsg{}, "lino/voteCensorship", nil)
+	cdc.RegisterConcrete(RefundDonationMsg{}, "lino/refundDonation", nil)
 }
 
 // ModuleCdc is the module codec
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/hex"
+	"mime"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lino-network/lino/types"
+)
+
+// ContentRef - reference to content stored off chain. Hash is the hex encoded
+// sha256 of the content, so that clients can fetch the content from URI, or a
+// local store keyed by Hash, and verify it.
+type ContentRef struct {
+	Hash     string `json:"hash"`
+	URI      string `json:"uri"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mime_type"`
+}
+
+// ValidateBasic - stateless check of the content reference.
+func (ref ContentRef) ValidateBasic() sdk.Error {
+	if !isSha256Hex(ref.Hash) {
+		return ErrInvalidContentRef("hash must be hex encoded sha256")
+	}
+	if len(ref.URI) == 0 || len(ref.URI) > types.MaxContentRefURILength {
+		return ErrInvalidContentRef("invalid uri length")
+	}
+	if u, err := url.Parse(ref.URI); err != nil || u.Scheme == "" {
+		return ErrInvalidContentRef("uri must be absolute")
+	}
+	if ref.Size <= 0 {
+		return ErrInvalidContentRef("size must be positive")
+	}
+	if len(ref.MimeType) > types.MaxContentRefMimeTypeLength {
+		return ErrInvalidContentRef("mime type too long")
+	}
+	if _, _, err := mime.ParseMediaType(ref.MimeType); err != nil {
+		return ErrInvalidContentRef("invalid mime type")
+	}
+	return nil
+}
+
+// isSha256Hex - s is 64 lowercase hex digits, the encoding of ContentHash.
+func isSha256Hex(s string) bool {
+	if len(s) != hex.EncodedLen(32) {
+		return false
+	}
+	for _, c := range s {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// inlineContentBatchSize - msgs carrying inline content are charged bandwidth
+// as one msg plus one per InlineContentBytesPerMsg bytes of content.
+func inlineContentBatchSize(content string) int64 {
+	return 1 + int64(len(content))/types.InlineContentBytesPerMsg
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lino-network/lino/types"
+)
+
+func TestContentRefValidateBasic(t *testing.T) {
+	valid := ContentRef{
+		Hash:     ContentHash("", "content"),
+		URI:      "ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		Size:     7,
+		MimeType: "text/plain; charset=utf-8",
+	}
+	assert.Nil(t, valid.ValidateBasic())
+
+	testCases := []struct {
+		testName string
+		update   func(ref *ContentRef)
+		reason   string
+	}{
+		{"short hash", func(ref *ContentRef) { ref.Hash = ref.Hash[1:] }, "hash must be hex encoded sha256"},
+		{"upper case hash", func(ref *ContentRef) { ref.Hash = strings.ToUpper(ref.Hash) }, "hash must be hex encoded sha256"},
+		{"empty uri", func(ref *ContentRef) { ref.URI = "" }, "invalid uri length"},
+		{"uri too long", func(ref *ContentRef) {
+			ref.URI = "https://" + strings.Repeat("a", types.MaxContentRefURILength)
+		}, "invalid uri length"},
+		{"relative uri", func(ref *ContentRef) { ref.URI = "content.txt" }, "uri must be absolute"},
+		{"zero size", func(ref *ContentRef) { ref.Size = 0 }, "size must be positive"},
+		{"empty mime type", func(ref *ContentRef) { ref.MimeType = "" }, "invalid mime type"},
+		{"mime type too long", func(ref *ContentRef) {
+			ref.MimeType = "text/" + strings.Repeat("a", types.MaxContentRefMimeTypeLength)
+		}, "mime type too long"},
+	}
+	for _, tc := range testCases {
+		ref := valid
+		tc.update(&ref)
+		assert.Equal(t, ErrInvalidContentRef(tc.reason), ref.ValidateBasic(), tc.testName)
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/lino-network/lino/types"
+)
+
+// Currency - currency of a donation.
+type Currency string
+
+const (
+	CurrencyLino Currency = "lino"
+	CurrencyIDA  Currency = "ida"
+)
+
+// ChannelPostID - post id of the channel of an author. Donations to an author
+// rather than to a post are accounted against the channel permlink "author#",
+// which never collides with a post because post ids cannot be empty.
+const ChannelPostID = ""
+
+// GetChannelPermlink - permlink of the channel of the author.
+func GetChannelPermlink(author types.AccountKey) types.Permlink {
+	return types.GetPermlink(author, ChannelPostID)
+}
+
+// ParseChannelPermlink - author of the channel, false if permlink is not a channel.
+func ParseChannelPermlink(permlink types.Permlink) (types.AccountKey, bool) {
+	if !strings.HasSuffix(string(permlink), types.PermlinkSeparator) {
+		return "", false
+	}
+	author := types.AccountKey(strings.TrimSuffix(string(permlink), types.PermlinkSeparator))
+	return author, author.IsValid()
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lino-network/lino/types"
+)
+
+func TestChannelPermlink(t *testing.T) {
+	permlink := GetChannelPermlink("author")
+	assert.Equal(t, types.Permlink("author#"), permlink)
+	author, ok := ParseChannelPermlink(permlink)
+	assert.True(t, ok)
+	assert.Equal(t, types.AccountKey("author"), author)
+
+	_, ok = ParseChannelPermlink(types.GetPermlink("author", "post1"))
+	assert.False(t, ok)
+	_, ok = ParseChannelPermlink("#")
+	assert.False(t, ok)
+}
@@ -118,3 +118,84 @@ func ErrInvalidSigner() sdk.Error {
 	return linotypes.NewError(
 		linotypes.CodeInvalidSigner, fmt.Sprintf("signer does not match app, post"))
 }
+
+// ErrInvalidParentPermlink - error when parent permlink of a reply is malformed.
+func ErrInvalidParentPermlink(parent linotypes.Permlink) sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidParentPermlink, fmt.Sprintf("invalid parent permlink: %s", parent))
+}
+
+// ErrReplyNotFound - error when the index-th reply of a post is not found.
+func ErrReplyNotFound(parent linotypes.Permlink, index int64) sdk.Error {
+	return linotypes.NewError(linotypes.CodeReplyNotFound, fmt.Sprintf("reply %d of %s is not found", index, parent))
+}
+
+// ErrRevisionNotFound - error when a revision of a post is not found.
+func ErrRevisionNotFound(permlink linotypes.Permlink, revision int64) sdk.Error {
+	return linotypes.NewError(linotypes.CodeRevisionNotFound, fmt.Sprintf("revision %d of %s is not found", revision, permlink))
+}
+
+// ErrPostCensored - error when post has been censored.
+func ErrPostCensored(permlink linotypes.Permlink) sdk.Error {
+	return linotypes.NewError(linotypes.CodePostCensored, fmt.Sprintf("permlink %v was censored", permlink))
+}
+
+// ErrInvalidPermlink - error when permlink is malformed.
+func ErrInvalidPermlink(permlink linotypes.Permlink) sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidPermlink, fmt.Sprintf("invalid permlink: %s", permlink))
+}
+
+// ErrInvalidCensorship - error when censorship is invalid.
+func ErrInvalidCensorship(reason string) sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidCensorship, fmt.Sprintf("invalid censorship: %s", reason))
+}
+
+// ErrCensorshipNotFound - error when censorship is not found.
+func ErrCensorshipNotFound(id int64) sdk.Error {
+	return linotypes.NewError(linotypes.CodeProposalNotFound, fmt.Sprintf("censorship %d is not found", id))
+}
+
+// ErrCensorshipClosed - error when censorship is no longer open for votes.
+func ErrCensorshipClosed(id int64) sdk.Error {
+	return linotypes.NewError(linotypes.CodeNotOngoingProposal, fmt.Sprintf("censorship %d is closed", id))
+}
+
+// ErrInvalidBeneficiaries - error when beneficiaries of a post are invalid.
+func ErrInvalidBeneficiaries(reason string) sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidBeneficiaries, fmt.Sprintf("invalid beneficiaries: %s", reason))
+}
+
+// ErrInvalidBatchDonation - error when batch donation has invalid number of entries.
+func ErrInvalidBatchDonation(n int) sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidBatchDonation,
+		fmt.Sprintf("batch donation must have 1 to %d entries, got %d", linotypes.MaximumBatchDonateEntries, n))
+}
+
+// ErrEscrowNotFound - error when escrowed donation is not found.
+func ErrEscrowNotFound(id int64) sdk.Error {
+	return linotypes.NewError(linotypes.CodeEscrowNotFound, fmt.Sprintf("escrowed donation %d is not found", id))
+}
+
+// ErrRefundWindowClosed - error when refunding an escrowed donation after its release time.
+func ErrRefundWindowClosed(id int64) sdk.Error {
+	return linotypes.NewError(linotypes.CodeRefundWindowClosed, fmt.Sprintf("refund window of escrowed donation %d is closed", id))
+}
+
+// ErrInvalidContentRef - error when content reference of a post is invalid.
+func ErrInvalidContentRef(reason string) sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidContentRef, fmt.Sprintf("invalid content reference: %s", reason))
+}
+
+// ErrContentRefNotFound - error when post has inline content rather than a content reference.
+func ErrContentRefNotFound(permlink linotypes.Permlink) sdk.Error {
+	return linotypes.NewError(linotypes.CodeContentRefNotFound, fmt.Sprintf("post %s has no content reference", permlink))
+}
+
+// ErrInvalidTags - error when tags of a post are invalid.
+func ErrInvalidTags(reason string) sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidTags, fmt.Sprintf("invalid tags: %s", reason))
+}
+
+// ErrPostIndexNotFound - error when the seq-th post of key is not found in a post index.
+func ErrPostIndexNotFound(key string, seq int64) sdk.Error {
+	return linotypes.NewError(linotypes.CodePostIndexNotFound, fmt.Sprintf("post %d of %s is not found in index", seq, key))
+}
@@ -13,4 +13,17 @@ type RewardEvent struct {
 	Consumer   linotypes.AccountKey `json:"consumer"`
 	Evaluate   linotypes.MiniDollar `json:"evaluate"`
 	FromApp    linotypes.AccountKey `json:"from_app"`
+	// EscrowID is the escrowed donation released on execution, 0 if not escrowed.
+	EscrowID int64 `json:"escrow_id,omitempty"`
+	// Weight is the content bonus weight of the donation blended at donation time,
+	// nil if the donation was not made under the reputation policy.
+	Weight *linotypes.MiniDollar `json:"weight,omitempty"`
+	// Beneficiaries of the post when the donation was made, the content bonus
+	// is split among them, not among the beneficiaries at execution.
+	Beneficiaries []Beneficiary `json:"beneficiaries,omitempty"`
+}
+
+// DecideCensorshipEvent - tally votes of a censorship at the end of its decide window.
+type DecideCensorshipEvent struct {
+	ID int64 `json:"id"`
 }
@@ -13,4 +13,18 @@ const (
 	// query stores
 	QueryPostInfo          = "info"
 	QueryConsumptionWindow = "consumption-window"
+	QueryContentBonus      = "content-bonus"
+	QueryReplies           = "replies"
+	QueryHistory           = "history"
+	QueryCensorship        = "censorship"
+	QueryCensorships       = "censorships"
+	QueryCensorshipVotes   = "censorship-votes"
+	QueryPostStats         = "post-stats"
+	QueryAuthorStats       = "author-stats"
+	QueryRecentDonations   = "donations"
+	QueryEscrow            = "escrow"
+	QueryContentRef        = "content-ref"
+	QueryPostsByAuthor     = "posts-by-author"
+	QueryPostsByApp        = "posts-by-app"
+	QueryPostsByTag        = "posts-by-tag"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,19 +10,28 @@ import (
 	"github.com/lino-network/lino/types"
 )
 
-// CreatePostMsg contains information to create a post
+// CreatePostMsg contains information to create a post, content is either
+// inline in Content, or stored off chain and referenced by ContentRef.
 // required stateful validation:
 // createdBy is a developer, if not author.
+// parentPermlink, if not empty, exists and is not deleted.
+// beneficiaries exist.
+// Tags can not be changed once the post is created.
 type CreatePostMsg struct {
-	Author    types.AccountKey `json:"author"`
-	PostID    string           `json:"post_id"`
-	Title     string           `json:"title"`
-	Content   string           `json:"content"`
-	CreatedBy types.AccountKey `json:"created_by"`
-	Preauth   bool             `json:"preauth"`
+	Author         types.AccountKey `json:"author"`
+	PostID         string           `json:"post_id"`
+	Title          string           `json:"title"`
+	Content        string           `json:"content"`
+	CreatedBy      types.AccountKey `json:"created_by"`
+	Preauth        bool             `json:"preauth"`
+	ParentPermlink types.Permlink   `json:"parent_permlink,omitempty"`
+	Beneficiaries  []Beneficiary    `json:"beneficiaries,omitempty"`
+	ContentRef     *ContentRef      `json:"content_ref,omitempty"`
+	Tags           []string         `json:"tags,omitempty"`
 }
 
 var _ types.Msg = CreatePostMsg{}
+var _ types.BatchMsg = CreatePostMsg{}
 
 // Route - implements sdk.Msg
 func (msg CreatePostMsg) Route() string { return RouterKey }
@@ -58,34 +68,55 @@ func (msg CreatePostMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+// GetBatchSize - implements types.BatchMsg, charged by size of inline content.
+func (msg CreatePostMsg) GetBatchSize() int64 {
+	return inlineContentBatchSize(msg.Content)
+}
+
 // ValidateBasic - implements sdk.Msg
 func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 	err := checkPostBasic(msg.PostID, msg.Author, msg.Title, msg.Content)
 	if err != nil {
 		return err
 	}
+	if err := checkContentRef(msg.Content, msg.ContentRef); err != nil {
+		return err
+	}
 	if !msg.CreatedBy.IsValid() {
 		return ErrInvalidCreatedBy()
 	}
-	return nil
+	if len(msg.ParentPermlink) > 0 && !isValidPermlink(msg.ParentPermlink) {
+		return ErrInvalidParentPermlink(msg.ParentPermlink)
+	}
+	if err := checkTags(msg.Tags); err != nil {
+		return err
+	}
+	return ValidateBeneficiaries(msg.Author, msg.Beneficiaries)
 }
 
 // String implements Stringer
 func (msg CreatePostMsg) String() string {
 	return fmt.Sprintf(
-		"Post.CreatePostMsg{author:%v, postID:%v, title:%v, content:%v, created_by:%v}",
-		msg.Author, msg.PostID, msg.Title, msg.Content, msg.CreatedBy)
+		"Post.CreatePostMsg{author:%v, postID:%v, title:%v, content:%v, created_by:%v, parent_permlink:%v, beneficiaries:%v, content_ref:%v, tags:%v}",
+		msg.Author, msg.PostID, msg.Title, msg.Content, msg.CreatedBy, msg.ParentPermlink, msg.Beneficiaries, msg.ContentRef, msg.Tags)
 }
 
-// UpdatePostMsg - update post
+// UpdatePostMsg - update post, beneficiaries and content reference of the post are replaced.
+// required stateful validation:
+// beneficiaries exist.
 type UpdatePostMsg struct {
-	Author  types.AccountKey `json:"author"`
-	PostID  string           `json:"post_id"`
-	Title   string           `json:"title"`
-	Content string           `json:"content"`
+	Author        types.AccountKey `json:"author"`
+	PostID        string           `json:"post_id"`
+	Title         string           `json:"title"`
+	Content       string           `json:"content"`
+	Beneficiaries []Beneficiary    `json:"beneficiaries,omitempty"`
+	ContentRef    *ContentRef      `json:"content_ref,omitempty"`
+	// ClearBeneficiaries removes all beneficiaries, as empty Beneficiaries keeps them.
+	ClearBeneficiaries bool `json:"clear_beneficiaries,omitempty"`
 }
 
 var _ types.Msg = UpdatePostMsg{}
+var _ types.BatchMsg = UpdatePostMsg{}
 
 // Route - implements sdk.Msg
 func (msg UpdatePostMsg) Route() string { return RouterKey }
@@ -98,18 +129,40 @@ func (msg UpdatePostMsg) GetPermission() types.Permission {
 	return types.TransactionPermission
 }
 
+// GetBatchSize - implements types.BatchMsg, charged by size of inline content.
+func (msg UpdatePostMsg) GetBatchSize() int64 {
+	return inlineContentBatchSize(msg.Content)
+}
+
 // ValidateBasic - implements sdk.Msg
 func (msg UpdatePostMsg) ValidateBasic() sdk.Error {
 	err := checkPostBasic(msg.PostID, msg.Author, msg.Title, msg.Content)
 	if err != nil {
 		return err
 	}
-	return nil
+	if err := checkContentRef(msg.Content, msg.ContentRef); err != nil {
+		return err
+	}
+	if msg.ClearBeneficiaries && len(msg.Beneficiaries) > 0 {
+		return ErrInvalidBeneficiaries("beneficiaries are cleared and set at the same time")
+	}
+	return ValidateBeneficiaries(msg.Author, msg.Beneficiaries)
+}
+
+// GetBeneficiaries - beneficiaries to update the post with, nil keeps the current ones.
+func (msg UpdatePostMsg) GetBeneficiaries() []Beneficiary {
+	if msg.ClearBeneficiaries {
+		return []Beneficiary{}
+	}
+	if len(msg.Beneficiaries) == 0 {
+		return nil
+	}
+	return msg.Beneficiaries
 }
 
 func (msg UpdatePostMsg) String() string {
-	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v}",
-		msg.Author, msg.PostID, msg.Title, msg.Content)
+	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, beneficiaries:%v, content_ref:%v, clear_beneficiaries:%v}",
+		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Beneficiaries, msg.ContentRef, msg.ClearBeneficiaries)
 }
 
 // GetSignBytes - implements sdk.Msg
@@ -176,7 +229,8 @@ func (msg DeletePostMsg) String() string {
 	return fmt.Sprintf("Post.DeletePostMsg{author:%v, postID:%v}", msg.Author, msg.PostID)
 }
 
-// DonateMsg - sent from a user to a post
+// DonateMsg - sent from a user to a post, if Escrow is true, the donation
+// is held in escrow and can be refunded by FromApp until its reward event.
 type DonateMsg struct {
 	Username types.AccountKey `json:"username"`
 	Amount   types.LNO        `json:"amount"`
@@ -184,6 +238,7 @@ type DonateMsg struct {
 	PostID   string           `json:"post_id"`
 	FromApp  types.AccountKey `json:"from_app"`
 	Memo     string           `json:"memo"`
+	Escrow   bool             `json:"escrow,omitempty"`
 }
 
 var _ types.Msg = DonateMsg{}
@@ -220,6 +275,9 @@ func (msg DonateMsg) ValidateBasic() sdk.Error {
 	if msg.FromApp != "" && !msg.FromApp.IsValid() {
 		return ErrInvalidApp()
 	}
+	if msg.Escrow && msg.FromApp == "" {
+		return ErrInvalidApp()
+	}
 	if msg.Username == msg.Author {
 		return ErrCannotDonateToSelf(msg.Username)
 	}
@@ -250,8 +308,8 @@ func (msg DonateMsg) GetSigners() []sdk.AccAddress {
 
 func (msg DonateMsg) String() string {
 	return fmt.Sprintf(
-		"Post.DonateMsg{donation from: %v, amount: %v, post author:%v, post id: %v}",
-		msg.Username, msg.Amount, msg.Author, msg.PostID)
+		"Post.DonateMsg{donation from: %v, amount: %v, post author:%v, post id: %v, escrow: %v}",
+		msg.Username, msg.Amount, msg.Author, msg.PostID, msg.Escrow)
 }
 
 // GetConsumeAmount - implements types.Msg
@@ -335,7 +393,418 @@ func (msg IDADonateMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+// DonateToAccountMsg - sent from a user to the channel of an author rather than
+// to a post of the author.
+type DonateToAccountMsg struct {
+	Username types.AccountKey `json:"username"`
+	Amount   types.LNO        `json:"amount"`
+	Author   types.AccountKey `json:"author"`
+	FromApp  types.AccountKey `json:"from_app"`
+	Memo     string           `json:"memo"`
+}
+
+var _ types.Msg = DonateToAccountMsg{}
+
+// Route - implements sdk.Msg
+func (msg DonateToAccountMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg DonateToAccountMsg) Type() string { return "DonateToAccountMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg DonateToAccountMsg) ValidateBasic() sdk.Error {
+	if !msg.Username.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if !msg.Author.IsValid() {
+		return ErrInvalidTarget()
+	}
+	if msg.FromApp != "" && !msg.FromApp.IsValid() {
+		return ErrInvalidApp()
+	}
+	if msg.Username == msg.Author {
+		return ErrCannotDonateToSelf(msg.Username)
+	}
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(msg.Memo) > types.MaximumMemoLength {
+		return ErrInvalidMemo()
+	}
+	return nil
+}
+
+// GetPermission - implements types.Msg
+func (msg DonateToAccountMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg DonateToAccountMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implements sdk.Msg
+func (msg DonateToAccountMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+func (msg DonateToAccountMsg) String() string {
+	return fmt.Sprintf(
+		"Post.DonateToAccountMsg{donation from: %v, amount: %v, author:%v}",
+		msg.Username, msg.Amount, msg.Author)
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg DonateToAccountMsg) GetConsumeAmount() types.Coin {
+	coin, _ := types.LinoToCoin(msg.Amount)
+	return coin
+}
+
+// DonateEntry - a donation to a post in a batch donation, amount is in LNO
+// in BatchDonateMsg and in IDA in BatchIDADonateMsg.
+type DonateEntry struct {
+	Author types.AccountKey `json:"author"`
+	PostID string           `json:"post_id"`
+	Amount string           `json:"amount"`
+}
+
+// BatchDonateMsg - LINO donations sent from a user to multiple posts,
+// all donations succeed or none.
+type BatchDonateMsg struct {
+	Username types.AccountKey `json:"username"`
+	FromApp  types.AccountKey `json:"from_app"`
+	Entries  []DonateEntry    `json:"entries"`
+	Memo     string           `json:"memo"`
+}
+
+var _ types.Msg = BatchDonateMsg{}
+var _ types.BatchMsg = BatchDonateMsg{}
+
+// Route - implements sdk.Msg
+func (msg BatchDonateMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg BatchDonateMsg) Type() string { return "BatchDonateMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg BatchDonateMsg) ValidateBasic() sdk.Error {
+	if !msg.Username.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if msg.FromApp != "" && !msg.FromApp.IsValid() {
+		return ErrInvalidApp()
+	}
+	if err := checkDonateEntries(msg.Username, msg.Entries); err != nil {
+		return err
+	}
+	for _, entry := range msg.Entries {
+		if _, err := types.LinoToCoin(entry.Amount); err != nil {
+			return err
+		}
+	}
+	if utf8.RuneCountInString(msg.Memo) > types.MaximumMemoLength {
+		return ErrInvalidMemo()
+	}
+	return nil
+}
+
+// GetPermission - implements types.Msg
+func (msg BatchDonateMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg BatchDonateMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implements sdk.Msg
+func (msg BatchDonateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetBatchSize - implements types.BatchMsg
+func (msg BatchDonateMsg) GetBatchSize() int64 {
+	return int64(len(msg.Entries))
+}
+
+func (msg BatchDonateMsg) String() string {
+	return fmt.Sprintf(
+		"Post.BatchDonateMsg{donation from: %v, app: %v, entries: %v, memo: %v}",
+		msg.Username, msg.FromApp, msg.Entries, msg.Memo)
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg BatchDonateMsg) GetConsumeAmount() types.Coin {
+	total := types.NewCoinFromInt64(0)
+	for _, entry := range msg.Entries {
+		coin, _ := types.LinoToCoin(entry.Amount)
+		total = total.Plus(coin)
+	}
+	return total
+}
+
+// BatchIDADonateMsg - IDA donations sent from a user to multiple posts,
+// all donations succeed or none.
+type BatchIDADonateMsg struct {
+	Username types.AccountKey `json:"username"`
+	App      types.AccountKey `json:"app"`
+	Entries  []DonateEntry    `json:"entries"`
+	Memo     string           `json:"memo"`
+	Signer   types.AccountKey `json:"signer"`
+}
+
+var _ types.Msg = BatchIDADonateMsg{}
+var _ types.BatchMsg = BatchIDADonateMsg{}
+
+// Route - implements sdk.Msg
+func (msg BatchIDADonateMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg BatchIDADonateMsg) Type() string { return "BatchIDADonateMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg BatchIDADonateMsg) ValidateBasic() sdk.Error {
+	if !msg.Username.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if !msg.Signer.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if !msg.App.IsValid() {
+		return ErrInvalidApp()
+	}
+	if err := checkDonateEntries(msg.Username, msg.Entries); err != nil {
+		return err
+	}
+	for _, entry := range msg.Entries {
+		if _, err := types.IDAStr(entry.Amount).ToMiniIDA(); err != nil {
+			return err
+		}
+	}
+	if utf8.RuneCountInString(msg.Memo) > types.MaximumMemoLength {
+		return ErrInvalidMemo()
+	}
+	return nil
+}
+
+// GetPermission - implements types.Msg
+func (msg BatchIDADonateMsg) GetPermission() types.Permission {
+	return types.AppOrAffiliatedPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg BatchIDADonateMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implements sdk.Msg
+func (msg BatchIDADonateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Signer)}
+}
+
+// GetBatchSize - implements types.BatchMsg
+func (msg BatchIDADonateMsg) GetBatchSize() int64 {
+	return int64(len(msg.Entries))
+}
+
+func (msg BatchIDADonateMsg) String() string {
+	return fmt.Sprintf(
+		"Post.BatchIDADonateMsg{donation from: %v, app: %v, entries: %v, memo: %v}",
+		msg.Username, msg.App, msg.Entries, msg.Memo)
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg BatchIDADonateMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// FlagPostMsg - flag a post for censorship with a deposit.
+// required stateful validation:
+// flagger is a voter, post exists and is neither deleted nor censored.
+type FlagPostMsg struct {
+	Flagger  types.AccountKey `json:"flagger"`
+	Permlink types.Permlink   `json:"permlink"`
+	Deposit  types.LNO        `json:"deposit"`
+	Reason   string           `json:"reason"`
+}
+
+var _ types.Msg = FlagPostMsg{}
+
+// Route - implements sdk.Msg
+func (msg FlagPostMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg FlagPostMsg) Type() string { return "FlagPostMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg FlagPostMsg) ValidateBasic() sdk.Error {
+	if !msg.Flagger.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if !isValidPermlink(msg.Permlink) {
+		return ErrInvalidPermlink(msg.Permlink)
+	}
+	_, err := types.LinoToCoin(msg.Deposit)
+	if err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrInvalidCensorship("reason is too long")
+	}
+	return nil
+}
+
+// GetPermission - implements types.Msg
+func (msg FlagPostMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg FlagPostMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implements sdk.Msg
+func (msg FlagPostMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Flagger)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg FlagPostMsg) GetConsumeAmount() types.Coin {
+	coin, _ := types.LinoToCoin(msg.Deposit)
+	return coin
+}
+
+func (msg FlagPostMsg) String() string {
+	return fmt.Sprintf("Post.FlagPostMsg{flagger:%v, permlink:%v, deposit:%v, reason:%v}",
+		msg.Flagger, msg.Permlink, msg.Deposit, msg.Reason)
+}
+
+// VoteCensorshipMsg - vote on a censorship with lino stake.
+type VoteCensorshipMsg struct {
+	Voter   types.AccountKey `json:"voter"`
+	ID      int64            `json:"id"`
+	Approve bool             `json:"approve"`
+}
+
+var _ types.Msg = VoteCensorshipMsg{}
+
+// Route - implements sdk.Msg
+func (msg VoteCensorshipMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg VoteCensorshipMsg) Type() string { return "VoteCensorshipMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg VoteCensorshipMsg) ValidateBasic() sdk.Error {
+	if !msg.Voter.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if msg.ID <= 0 {
+		return ErrCensorshipNotFound(msg.ID)
+	}
+	return nil
+}
+
+// GetPermission - implements types.Msg
+func (msg VoteCensorshipMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg VoteCensorshipMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implements sdk.Msg
+func (msg VoteCensorshipMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Voter)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg VoteCensorshipMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+func (msg VoteCensorshipMsg) String() string {
+	return fmt.Sprintf("Post.VoteCensorshipMsg{voter:%v, id:%v, approve:%v}",
+		msg.Voter, msg.ID, msg.Approve)
+}
+
+// RefundDonationMsg - refund an escrowed donation of the app to its donor,
+// signed by the app or its affiliated account.
+type RefundDonationMsg struct {
+	App    types.AccountKey `json:"app"`
+	ID     int64            `json:"id"`
+	Signer types.AccountKey `json:"signer"`
+}
+
+var _ types.Msg = RefundDonationMsg{}
+
+// Route - implements sdk.Msg
+func (msg RefundDonationMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg RefundDonationMsg) Type() string { return "RefundDonationMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg RefundDonationMsg) ValidateBasic() sdk.Error {
+	if !msg.App.IsValid() {
+		return ErrInvalidApp()
+	}
+	if !msg.Signer.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if msg.ID <= 0 {
+		return ErrEscrowNotFound(msg.ID)
+	}
+	return nil
+}
+
+// GetPermission - implements types.Msg
+func (msg RefundDonationMsg) GetPermission() types.Permission {
+	return types.AppOrAffiliatedPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RefundDonationMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RefundDonationMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Signer)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg RefundDonationMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+func (msg RefundDonationMsg) String() string {
+	return fmt.Sprintf("Post.RefundDonationMsg{app:%v, id:%v, signer:%v}",
+		msg.App, msg.ID, msg.Signer)
+}
+
 // utils
+func checkDonateEntries(from types.AccountKey, entries []DonateEntry) sdk.Error {
+	if len(entries) == 0 || len(entries) > types.MaximumBatchDonateEntries {
+		return ErrInvalidBatchDonation(len(entries))
+	}
+	for _, entry := range entries {
+		if !entry.Author.IsValid() || len(entry.PostID) == 0 {
+			return ErrInvalidTarget()
+		}
+		if from == entry.Author {
+			return ErrCannotDonateToSelf(from)
+		}
+	}
+	return nil
+}
+
 func getSignBytes(msg sdk.Msg) []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
@@ -358,3 +827,46 @@ func checkPostBasic(postID string, author types.AccountKey, title, content strin
 	}
 	return nil
 }
+
+// checkContentRef - content is either inline or referenced, not both.
+func checkContentRef(content string, ref *ContentRef) sdk.Error {
+	if ref == nil {
+		return nil
+	}
+	if len(content) > 0 {
+		return ErrInvalidContentRef("inline content must be empty")
+	}
+	return ref.ValidateBasic()
+}
+
+// checkTags - at most MaxTagsPerPost distinct tags, of lowercase letters, digits and '-'.
+func checkTags(tags []string) sdk.Error {
+	if len(tags) > types.MaxTagsPerPost {
+		return ErrInvalidTags("too many tags")
+	}
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		if len(tag) == 0 || len(tag) > types.MaxTagLength {
+			return ErrInvalidTags(fmt.Sprintf("invalid length of tag %s", tag))
+		}
+		for _, c := range tag {
+			if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-') {
+				return ErrInvalidTags(fmt.Sprintf("invalid character in tag %s", tag))
+			}
+		}
+		if seen[tag] {
+			return ErrInvalidTags(fmt.Sprintf("duplicate tag %s", tag))
+		}
+		seen[tag] = true
+	}
+	return nil
+}
+
+func isValidPermlink(permlink types.Permlink) bool {
+	parts := strings.SplitN(string(permlink), types.PermlinkSeparator, 2)
+	if len(parts) != 2 {
+		return false
+	}
+	author, postID := types.AccountKey(parts[0]), parts[1]
+	return author.IsValid() && len(postID) > 0 && len(postID) <= types.MaximumLengthOfPostID
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -62,6 +63,12 @@ func TestPostMsgTestSuite(t *testing.T) {
 func (suite *PostMsgTestSuite) TestCreatePostMsgValidateBasic() {
 	author := types.AccountKey("testauthor")
 	app := types.AccountKey("app")
+	ref := &ContentRef{
+		Hash:     ContentHash("", "content"),
+		URI:      "ipfs://content",
+		Size:     7,
+		MimeType: "text/plain",
+	}
 	testCases := []struct {
 		testName       string
 		msg            CreatePostMsg
@@ -199,6 +206,157 @@ func (suite *PostMsgTestSuite) TestCreatePostMsgValidateBasic() {
 			},
 			expectedResult: ErrInvalidCreatedBy(),
 		},
+		{
+			testName: "reply",
+			msg: CreatePostMsg{
+				PostID:         "TestPostID",
+				Author:         author,
+				CreatedBy:      author,
+				ParentPermlink: types.GetPermlink("parent", "parentPostID"),
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "parent permlink without separator",
+			msg: CreatePostMsg{
+				PostID:         "TestPostID",
+				Author:         author,
+				CreatedBy:      author,
+				ParentPermlink: types.Permlink("parent"),
+			},
+			expectedResult: ErrInvalidParentPermlink(types.Permlink("parent")),
+		},
+		{
+			testName: "parent permlink with invalid author",
+			msg: CreatePostMsg{
+				PostID:         "TestPostID",
+				Author:         author,
+				CreatedBy:      author,
+				ParentPermlink: types.GetPermlink("", "parentPostID"),
+			},
+			expectedResult: ErrInvalidParentPermlink(types.GetPermlink("", "parentPostID")),
+		},
+		{
+			testName: "parent permlink without post id",
+			msg: CreatePostMsg{
+				PostID:         "TestPostID",
+				Author:         author,
+				CreatedBy:      author,
+				ParentPermlink: types.GetPermlink("parent", ""),
+			},
+			expectedResult: ErrInvalidParentPermlink(types.GetPermlink("parent", "")),
+		},
+		{
+			testName: "with beneficiaries",
+			msg: CreatePostMsg{
+				PostID:        "TestPostID",
+				Author:        author,
+				CreatedBy:     app,
+				Beneficiaries: []Beneficiary{{Account: "editor", Weight: 2500}, {Account: "cohost", Weight: 7500}},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "author as beneficiary",
+			msg: CreatePostMsg{
+				PostID:        "TestPostID",
+				Author:        author,
+				CreatedBy:     app,
+				Beneficiaries: []Beneficiary{{Account: author, Weight: 2500}},
+			},
+			expectedResult: ErrInvalidBeneficiaries("author can not be a beneficiary"),
+		},
+		{
+			testName: "with content ref",
+			msg: CreatePostMsg{
+				PostID:     "TestPostID",
+				Author:     author,
+				CreatedBy:  author,
+				ContentRef: ref,
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "content ref with inline content",
+			msg: CreatePostMsg{
+				PostID:     "TestPostID",
+				Content:    "content",
+				Author:     author,
+				CreatedBy:  author,
+				ContentRef: ref,
+			},
+			expectedResult: ErrInvalidContentRef("inline content must be empty"),
+		},
+		{
+			testName: "invalid content ref",
+			msg: CreatePostMsg{
+				PostID:     "TestPostID",
+				Author:     author,
+				CreatedBy:  author,
+				ContentRef: &ContentRef{Hash: "ABC", URI: "ipfs://content", Size: 7, MimeType: "text/plain"},
+			},
+			expectedResult: ErrInvalidContentRef("hash must be hex encoded sha256"),
+		},
+		{
+			testName: "with tags",
+			msg: CreatePostMsg{
+				PostID:    "TestPostID",
+				Author:    author,
+				CreatedBy: author,
+				Tags:      []string{"music", "live-2019"},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "too many tags",
+			msg: CreatePostMsg{
+				PostID:    "TestPostID",
+				Author:    author,
+				CreatedBy: author,
+				Tags:      []string{"a", "b", "c", "d", "e", "f"},
+			},
+			expectedResult: ErrInvalidTags("too many tags"),
+		},
+		{
+			testName: "empty tag",
+			msg: CreatePostMsg{
+				PostID:    "TestPostID",
+				Author:    author,
+				CreatedBy: author,
+				Tags:      []string{""},
+			},
+			expectedResult: ErrInvalidTags("invalid length of tag "),
+		},
+		{
+			testName: "tag too long",
+			msg: CreatePostMsg{
+				PostID:    "TestPostID",
+				Author:    author,
+				CreatedBy: author,
+				Tags:      []string{strings.Repeat("a", types.MaxTagLength+1)},
+			},
+			expectedResult: ErrInvalidTags("invalid length of tag " + strings.Repeat("a", types.MaxTagLength+1)),
+		},
+		{
+			testName: "uppercase tag",
+			msg: CreatePostMsg{
+				PostID:    "TestPostID",
+				Author:    author,
+				CreatedBy: author,
+				Tags:      []string{"Music"},
+			},
+			expectedResult: ErrInvalidTags("invalid character in tag Music"),
+		},
+		{
+			testName: "duplicate tags",
+			msg: CreatePostMsg{
+				PostID:    "TestPostID",
+				Author:    author,
+				CreatedBy: author,
+				Tags:      []string{"music", "music"},
+			},
+			expectedResult: ErrInvalidTags("duplicate tag music"),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.msg.ValidateBasic()
@@ -333,6 +491,12 @@ func (suite *PostMsgTestSuite) TestCreatePostMsgSigners() {
 
 func (suite *PostMsgTestSuite) TestUpdatePostValidateBasic() {
 	author := types.AccountKey("testauthor")
+	ref := &ContentRef{
+		Hash:     ContentHash("", "content"),
+		URI:      "ipfs://content",
+		Size:     7,
+		MimeType: "text/plain",
+	}
 	testCases := []struct {
 		testName string
 		msg      UpdatePostMsg
@@ -358,6 +522,54 @@ func (suite *PostMsgTestSuite) TestUpdatePostValidateBasic() {
 			},
 			expected: ErrInvalidAuthor(),
 		},
+		{
+			testName: "with beneficiaries",
+			msg: UpdatePostMsg{
+				Author:        author,
+				PostID:        "TestPostID",
+				Beneficiaries: []Beneficiary{{Account: "editor", Weight: 2500}},
+			},
+			expected: nil,
+		},
+		{
+			testName: "invalid beneficiaries",
+			msg: UpdatePostMsg{
+				Author:        author,
+				PostID:        "TestPostID",
+				Beneficiaries: []Beneficiary{{Account: "editor", Weight: 10001}},
+			},
+			expected: ErrInvalidBeneficiaries("invalid weight of editor: 10001"),
+		},
+		{
+			testName: "clear and set beneficiaries",
+			msg: UpdatePostMsg{
+				Author:             author,
+				PostID:             "TestPostID",
+				Beneficiaries:      []Beneficiary{{Account: "editor", Weight: 2500}},
+				ClearBeneficiaries: true,
+			},
+			expected: ErrInvalidBeneficiaries("beneficiaries are cleared and set at the same time"),
+		},
+		{
+			testName: "with content ref",
+			msg: UpdatePostMsg{
+				Author:     author,
+				PostID:     "TestPostID",
+				Title:      "TestTitle",
+				ContentRef: ref,
+			},
+			expected: nil,
+		},
+		{
+			testName: "content ref with inline content",
+			msg: UpdatePostMsg{
+				Author:     author,
+				PostID:     "TestPostID",
+				Content:    "TestContent",
+				ContentRef: ref,
+			},
+			expected: ErrInvalidContentRef("inline content must be empty"),
+		},
 	}
 	for _, c := range testCases {
 		suite.Run(c.testName, func() {
@@ -366,6 +578,22 @@ func (suite *PostMsgTestSuite) TestUpdatePostValidateBasic() {
 	}
 }
 
+func (suite *PostMsgTestSuite) TestUpdatePostMsgGetBeneficiaries() {
+	beneficiaries := []Beneficiary{{Account: "editor", Weight: 2500}}
+	suite.Nil(UpdatePostMsg{}.GetBeneficiaries())
+	suite.Nil(UpdatePostMsg{Beneficiaries: []Beneficiary{}}.GetBeneficiaries())
+	suite.Equal(beneficiaries, UpdatePostMsg{Beneficiaries: beneficiaries}.GetBeneficiaries())
+	suite.Equal([]Beneficiary{}, UpdatePostMsg{ClearBeneficiaries: true}.GetBeneficiaries())
+}
+
+func (suite *PostMsgTestSuite) TestPostMsgGetBatchSize() {
+	ref := &ContentRef{Hash: ContentHash("", "content"), URI: "ipfs://content", Size: 7, MimeType: "text/plain"}
+	suite.Equal(int64(1), CreatePostMsg{ContentRef: ref}.GetBatchSize())
+	suite.Equal(int64(1), CreatePostMsg{Content: string(make([]byte, types.InlineContentBytesPerMsg-1))}.GetBatchSize())
+	suite.Equal(int64(2), CreatePostMsg{Content: string(make([]byte, types.InlineContentBytesPerMsg))}.GetBatchSize())
+	suite.Equal(int64(3), UpdatePostMsg{Content: string(make([]byte, 2*types.InlineContentBytesPerMsg+1))}.GetBatchSize())
+}
+
 func (suite *PostMsgTestSuite) TestDeletePostValidateBasic() {
 	author := types.AccountKey("testauthor")
 	testCases := []struct {
@@ -466,6 +694,20 @@ func (suite *PostMsgTestSuite) TestDonateMsgValidateBasic() {
 			msg:      NewDonateMsg("test", types.LNO("1"), "author", "postID", "", tooLongOfUTF8Memo),
 			expected: ErrInvalidMemo(),
 		},
+		{
+			testName: "escrow",
+			msg: DonateMsg{
+				Username: "test", Amount: types.LNO("1"), Author: "author", PostID: "postID",
+				FromApp: "app1", Escrow: true},
+			expected: nil,
+		},
+		{
+			testName: "escrow without app",
+			msg: DonateMsg{
+				Username: "test", Amount: types.LNO("1"), Author: "author", PostID: "postID",
+				Escrow: true},
+			expected: ErrInvalidApp(),
+		},
 	}
 	for _, c := range testCases {
 		suite.Run(c.testName, func() {
@@ -655,6 +897,290 @@ func (suite *PostMsgTestSuite) TestIDADonateMsgValidateBasic() {
 	}
 }
 
+func (suite *PostMsgTestSuite) TestDonateToAccountMsgValidateBasic() {
+	testCases := []struct {
+		testName string
+		msg      DonateToAccountMsg
+		expected sdk.Error
+	}{
+		{
+			testName: "ok",
+			msg:      DonateToAccountMsg{Username: "user1", Amount: "1", Author: "user2", FromApp: "app1", Memo: memo1},
+			expected: nil,
+		},
+		{
+			testName: "invalid username",
+			msg:      DonateToAccountMsg{Username: "", Amount: "1", Author: "user2"},
+			expected: ErrInvalidUsername(),
+		},
+		{
+			testName: "invalid author",
+			msg:      DonateToAccountMsg{Username: "user1", Amount: "1", Author: ""},
+			expected: ErrInvalidTarget(),
+		},
+		{
+			testName: "invalid app",
+			msg:      DonateToAccountMsg{Username: "user1", Amount: "1", Author: "user2", FromApp: "x"},
+			expected: ErrInvalidApp(),
+		},
+		{
+			testName: "donate to self",
+			msg:      DonateToAccountMsg{Username: "user1", Amount: "1", Author: "user1"},
+			expected: ErrCannotDonateToSelf("user1"),
+		},
+		{
+			testName: "invalid amount",
+			msg:      DonateToAccountMsg{Username: "user1", Amount: "0", Author: "user2"},
+			expected: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName: "memo too long",
+			msg:      DonateToAccountMsg{Username: "user1", Amount: "1", Author: "user2", Memo: invalidMemo},
+			expected: ErrInvalidMemo(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, tc.msg.ValidateBasic(), "%s", tc.testName)
+	}
+	suite.Equal(types.NewCoinFromInt64(100000), testCases[0].msg.GetConsumeAmount())
+}
+
+func (suite *PostMsgTestSuite) TestBatchDonateMsgValidateBasic() {
+	entries := []DonateEntry{
+		{Author: "user2", PostID: "post1", Amount: "1"},
+		{Author: "user3", PostID: "post2", Amount: "0.5"},
+	}
+	tooMany := make([]DonateEntry, types.MaximumBatchDonateEntries+1)
+	for i := range tooMany {
+		tooMany[i] = DonateEntry{Author: "user2", PostID: "post1", Amount: "1"}
+	}
+	testCases := []struct {
+		testName string
+		msg      BatchDonateMsg
+		expected sdk.Error
+	}{
+		{
+			testName: "ok",
+			msg:      BatchDonateMsg{Username: "user1", FromApp: "app1", Entries: entries, Memo: memo1},
+			expected: nil,
+		},
+		{
+			testName: "invalid username",
+			msg:      BatchDonateMsg{Username: "", Entries: entries},
+			expected: ErrInvalidUsername(),
+		},
+		{
+			testName: "invalid app",
+			msg:      BatchDonateMsg{Username: "user1", FromApp: "x", Entries: entries},
+			expected: ErrInvalidApp(),
+		},
+		{
+			testName: "no entries",
+			msg:      BatchDonateMsg{Username: "user1"},
+			expected: ErrInvalidBatchDonation(0),
+		},
+		{
+			testName: "too many entries",
+			msg:      BatchDonateMsg{Username: "user1", Entries: tooMany},
+			expected: ErrInvalidBatchDonation(types.MaximumBatchDonateEntries + 1),
+		},
+		{
+			testName: "invalid target",
+			msg: BatchDonateMsg{Username: "user1", Entries: []DonateEntry{
+				{Author: "user2", PostID: "", Amount: "1"},
+			}},
+			expected: ErrInvalidTarget(),
+		},
+		{
+			testName: "donate to self",
+			msg: BatchDonateMsg{Username: "user1", Entries: []DonateEntry{
+				{Author: "user2", PostID: "post1", Amount: "1"},
+				{Author: "user1", PostID: "post1", Amount: "1"},
+			}},
+			expected: ErrCannotDonateToSelf("user1"),
+		},
+		{
+			testName: "invalid amount",
+			msg: BatchDonateMsg{Username: "user1", Entries: []DonateEntry{
+				{Author: "user2", PostID: "post1", Amount: "0"},
+			}},
+			expected: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName: "memo too long",
+			msg:      BatchDonateMsg{Username: "user1", Entries: entries, Memo: invalidMemo},
+			expected: ErrInvalidMemo(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, tc.msg.ValidateBasic(), "%s", tc.testName)
+	}
+}
+
+func (suite *PostMsgTestSuite) TestBatchDonateMsgConsumeAmount() {
+	msg := BatchDonateMsg{Username: "user1", Entries: []DonateEntry{
+		{Author: "user2", PostID: "post1", Amount: "1"},
+		{Author: "user3", PostID: "post2", Amount: "0.5"},
+	}}
+	suite.Equal(types.NewCoinFromInt64(150000), msg.GetConsumeAmount())
+	suite.Equal(int64(2), msg.GetBatchSize())
+	suite.Equal(types.TransactionPermission, msg.GetPermission())
+}
+
+func (suite *PostMsgTestSuite) TestBatchIDADonateMsgValidateBasic() {
+	entries := []DonateEntry{
+		{Author: "user2", PostID: "post1", Amount: "12345"},
+		{Author: "user3", PostID: "post2", Amount: "1"},
+	}
+	testCases := []struct {
+		testName string
+		msg      BatchIDADonateMsg
+		expected sdk.Error
+	}{
+		{
+			testName: "ok",
+			msg: BatchIDADonateMsg{
+				Username: "user1", App: "app1", Entries: entries, Memo: memo1, Signer: "signer"},
+			expected: nil,
+		},
+		{
+			testName: "invalid signer",
+			msg: BatchIDADonateMsg{
+				Username: "user1", App: "app1", Entries: entries, Signer: "x"},
+			expected: ErrInvalidUsername(),
+		},
+		{
+			testName: "invalid app",
+			msg: BatchIDADonateMsg{
+				Username: "user1", App: "", Entries: entries, Signer: "signer"},
+			expected: ErrInvalidApp(),
+		},
+		{
+			testName: "no entries",
+			msg: BatchIDADonateMsg{
+				Username: "user1", App: "app1", Signer: "signer"},
+			expected: ErrInvalidBatchDonation(0),
+		},
+		{
+			testName: "invalid amount",
+			msg: BatchIDADonateMsg{
+				Username: "user1", App: "app1", Signer: "signer", Entries: []DonateEntry{
+					{Author: "user2", PostID: "post1", Amount: "-1"},
+				}},
+			expected: types.ErrInvalidIDAAmount(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, tc.msg.ValidateBasic(), "%s", tc.testName)
+	}
+	msg := testCases[0].msg
+	suite.Equal(int64(2), msg.GetBatchSize())
+	suite.Equal([]sdk.AccAddress{sdk.AccAddress("signer")}, msg.GetSigners())
+}
+
+func (suite *PostMsgTestSuite) TestFlagPostMsgValidateBasic() {
+	tooLongReason := string(make([]byte, types.MaximumLengthOfProposalReason+1))
+	testCases := []struct {
+		testName string
+		msg      FlagPostMsg
+		expected sdk.Error
+	}{
+		{
+			testName: "normal case",
+			msg:      FlagPostMsg{Flagger: "test", Permlink: "author#postID", Deposit: "1", Reason: "spam"},
+			expected: nil,
+		},
+		{
+			testName: "invalid flagger",
+			msg:      FlagPostMsg{Flagger: "", Permlink: "author#postID", Deposit: "1"},
+			expected: ErrInvalidUsername(),
+		},
+		{
+			testName: "invalid permlink",
+			msg:      FlagPostMsg{Flagger: "test", Permlink: "author", Deposit: "1"},
+			expected: ErrInvalidPermlink("author"),
+		},
+		{
+			testName: "invalid deposit",
+			msg:      FlagPostMsg{Flagger: "test", Permlink: "author#postID", Deposit: "0"},
+			expected: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName: "reason is too long",
+			msg:      FlagPostMsg{Flagger: "test", Permlink: "author#postID", Deposit: "1", Reason: tooLongReason},
+			expected: ErrInvalidCensorship("reason is too long"),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, tc.msg.ValidateBasic(), "%s", tc.testName)
+		if tc.expected == nil {
+			suite.Equal(types.NewCoinFromInt64(1*types.Decimals), tc.msg.GetConsumeAmount())
+		}
+	}
+}
+
+func (suite *PostMsgTestSuite) TestVoteCensorshipMsgValidateBasic() {
+	testCases := []struct {
+		testName string
+		msg      VoteCensorshipMsg
+		expected sdk.Error
+	}{
+		{
+			testName: "normal case",
+			msg:      VoteCensorshipMsg{Voter: "test", ID: 1, Approve: true},
+			expected: nil,
+		},
+		{
+			testName: "invalid voter",
+			msg:      VoteCensorshipMsg{Voter: "", ID: 1},
+			expected: ErrInvalidUsername(),
+		},
+		{
+			testName: "invalid id",
+			msg:      VoteCensorshipMsg{Voter: "test", ID: 0},
+			expected: ErrCensorshipNotFound(0),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, tc.msg.ValidateBasic(), "%s", tc.testName)
+	}
+}
+
+func (suite *PostMsgTestSuite) TestRefundDonationMsgValidateBasic() {
+	testCases := []struct {
+		testName string
+		msg      RefundDonationMsg
+		expected sdk.Error
+	}{
+		{
+			testName: "normal case",
+			msg:      RefundDonationMsg{App: "app1", ID: 1, Signer: "signer"},
+			expected: nil,
+		},
+		{
+			testName: "invalid app",
+			msg:      RefundDonationMsg{App: "", ID: 1, Signer: "signer"},
+			expected: ErrInvalidApp(),
+		},
+		{
+			testName: "invalid signer",
+			msg:      RefundDonationMsg{App: "app1", ID: 1, Signer: ""},
+			expected: ErrInvalidUsername(),
+		},
+		{
+			testName: "invalid id",
+			msg:      RefundDonationMsg{App: "app1", ID: 0, Signer: "signer"},
+			expected: ErrEscrowNotFound(0),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, tc.msg.ValidateBasic(), "%s", tc.testName)
+	}
+	msg := RefundDonationMsg{App: "app1", ID: 1, Signer: "signer"}
+	suite.Equal(types.AppOrAffiliatedPermission, msg.GetPermission())
+	suite.Equal([]sdk.AccAddress{sdk.AccAddress("signer")}, msg.GetSigners())
+}
+
 // func (suite *PostMsgTestSuite) TestMsgPermission() {
 // 	testCases := []struct {
 // 		testName           string
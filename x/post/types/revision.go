@@ -0,0 +1,19 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// ContentHash - hex encoded sha256 of uvarint(len(title)) + title + content.
+// title is length-prefixed so that the boundary between title and content is unambiguous.
+func ContentHash(title, content string) string {
+	lenBytes := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(lenBytes, uint64(len(title)))
+	h := sha256.New()
+	h.Write(lenBytes[:n])
+	h.Write([]byte(title))
+	h.Write([]byte(content))
+	return hex.EncodeToString(h.Sum(nil))
+}
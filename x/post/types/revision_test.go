@@ -0,0 +1,15 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentHash(t *testing.T) {
+	assert.Equal(t, ContentHash("title", "content"), ContentHash("title", "content"))
+	assert.NotEqual(t, ContentHash("title", "content"), ContentHash("title", "content2"))
+	// boundary between title and content is part of the hash.
+	assert.NotEqual(t, ContentHash("ab", "c"), ContentHash("a", "bc"))
+	assert.Len(t, ContentHash("", ""), 64)
+}
@@ -242,7 +242,7 @@ func (wm WeightedMedianPriceManager) filterAndSlash(ctx sdk.Context, wvals []wei
 			// unless the validator is not in the last set, slash.
 			if lastValidatorSet[valname] {
 				if !wm.param.GetPriceParam(ctx).TestnetMode {
-					err := wm.val.PunishCommittingValidator(
+					_, err := wm.val.PunishCommittingValidator(
 						ctx, valname,
 						wm.param.GetPriceParam(ctx).PenaltyMissFeed,
 						linotypes.PunishNoPriceFed)
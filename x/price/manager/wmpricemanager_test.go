@@ -428,7 +428,7 @@ func (suite *WMPriceManagerSuite) TestUpdatePriceSlash() {
 						mock.Anything,
 						slash,
 						basicParam.PenaltyMissFeed,
-						linotypes.PunishNoPriceFed).Return(nil).Once()
+						linotypes.PunishNoPriceFed).Return(basicParam.PenaltyMissFeed, nil).Once()
 				}
 				for _, act := range round.actions {
 					suite.NextBlock(act.t)
@@ -663,7 +663,7 @@ func (suite *WMPriceManagerSuite) TestUpdatePriceCurrPrice() {
 					mock.Anything,
 					mock.Anything,
 					basicParam.PenaltyMissFeed,
-					linotypes.PunishNoPriceFed).Return(nil).Maybe()
+					linotypes.PunishNoPriceFed).Return(basicParam.PenaltyMissFeed, nil).Maybe()
 
 				for _, act := range round.actions {
 					suite.NextBlock(act.t)
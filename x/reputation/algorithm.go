@@ -0,0 +1,157 @@
+package reputation
+
+import (
+	"fmt"
+
+	codec "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	repv2 "github.com/lino-network/lino/x/reputation/repv2"
+)
+
+var (
+	// shadowSubStore - substore of the shadow algorithm, out of key space of repv2.
+	shadowSubStore = []byte{0x10}
+	// shadowDonationSubStore - donations of the current shadow round by donor,
+	// out of key space of repv2 and the shadow algorithm.
+	shadowDonationSubStore = []byte{0x11}
+
+	shadowCdc = codec.New()
+)
+
+// Algorithm - constructs a reputation algorithm on @p store, which is owned by
+// the algorithm exclusively, with current reputation params.
+type Algorithm func(store repv2.Store, param *param.ReputationParam) repv2.Reputation
+
+// Repv2Algorithm - the reputation algorithm in use.
+func Repv2Algorithm(store repv2.Store, param *param.ReputationParam) repv2.Reputation {
+	repStore := repv2.NewReputationStore(store, repv2.DefaultInitialReputation)
+	return repv2.NewReputation(
+		repStore, param.BestContentIndexN, param.UserMaxN,
+		repv2.DefaultRoundDurationSeconds,
+		repv2.DefaultSampleWindowSize,
+		repv2.DefaultDecayFactor)
+}
+
+// ReputationComparison - reputation of a user under repv2 and the shadow algorithm.
+type ReputationComparison struct {
+	Username         types.AccountKey `json:"username"`
+	Reputation       types.MiniDollar `json:"reputation"`
+	Shadow           string           `json:"shadow"`
+	ShadowReputation types.MiniDollar `json:"shadow_reputation"`
+}
+
+// getShadow - construct the shadow algorithm on its substore, nil if not registered.
+func (rep ReputationManager) getShadow(ctx sdk.Context) repv2.Reputation {
+	if rep.shadow == nil {
+		return nil
+	}
+	store := prefix.NewStore(ctx.KVStore(rep.storeKey), shadowSubStore)
+	return rep.shadow(store, rep.paramHolder.GetReputationParam(ctx))
+}
+
+// runShadow - run @p f on the shadow algorithm, if registered, with a cached
+// context. Writes are committed only if @p f returns, a panic of the shadow
+// algorithm is logged and its writes are discarded, so that it never affects repv2.
+func (rep ReputationManager) runShadow(ctx sdk.Context, f func(ctx sdk.Context, shadow repv2.Reputation)) {
+	if rep.shadow == nil {
+		return
+	}
+	cctx, write := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			ctx.Logger().Error(fmt.Sprintf("shadow reputation %s failed: %v", rep.shadowName, r))
+		}
+	}()
+	f(cctx, rep.getShadow(cctx))
+	write()
+}
+
+// shadowDonation - a donation of @p Amount to @p Pid, of @p Impact under repv2
+// and @p ShadowImpact under the shadow algorithm.
+type shadowDonation struct {
+	Pid          repv2.Pid `json:"pid"`
+	Amount       repv2.Int `json:"amount"`
+	Impact       repv2.Int `json:"impact"`
+	ShadowImpact repv2.Int `json:"shadow_impact"`
+}
+
+// shadowDonations - donations of a user in shadow round @p Round, only
+// donations of the current round can be reverted.
+type shadowDonations struct {
+	Round     repv2.RoundId    `json:"round"`
+	Donations []shadowDonation `json:"donations"`
+}
+
+func (rep ReputationManager) getShadowDonations(ctx sdk.Context, uid repv2.Uid) *shadowDonations {
+	store := ctx.KVStore(rep.storeKey)
+	bz := store.Get(append(shadowDonationSubStore, uid...))
+	if bz == nil {
+		return &shadowDonations{}
+	}
+	rst := &shadowDonations{}
+	shadowCdc.MustUnmarshalJSON(bz, rst)
+	return rst
+}
+
+func (rep ReputationManager) setShadowDonations(ctx sdk.Context, uid repv2.Uid, donations *shadowDonations) {
+	store := ctx.KVStore(rep.storeKey)
+	key := append(shadowDonationSubStore, uid...)
+	if len(donations.Donations) == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, shadowCdc.MustMarshalJSON(donations))
+}
+
+// recordShadowDonation - record the impact of a donation under the shadow algorithm.
+func (rep ReputationManager) recordShadowDonation(ctx sdk.Context, shadow repv2.Reputation, uid repv2.Uid, donation shadowDonation) {
+	round, _ := shadow.GetCurrentRound()
+	donations := rep.getShadowDonations(ctx, uid)
+	if donations.Round != round {
+		donations = &shadowDonations{Round: round}
+	}
+	donations.Donations = append(donations.Donations, donation)
+	rep.setShadowDonations(ctx, uid, donations)
+}
+
+// popShadowDonation - remove the donation of @p amount with @p impact under repv2
+// to @p pid in the current shadow round, return its impact under the shadow algorithm,
+// false if not found.
+func (rep ReputationManager) popShadowDonation(ctx sdk.Context, shadow repv2.Reputation,
+	uid repv2.Uid, pid repv2.Pid, amount, impact repv2.Int) (repv2.Int, bool) {
+	round, _ := shadow.GetCurrentRound()
+	donations := rep.getShadowDonations(ctx, uid)
+	if donations.Round != round {
+		return repv2.NewInt(0), false
+	}
+	for i, v := range donations.Donations {
+		if v.Pid == pid && v.Amount.Cmp(amount) == 0 && v.Impact.Cmp(impact) == 0 {
+			donations.Donations = append(donations.Donations[:i], donations.Donations[i+1:]...)
+			rep.setShadowDonations(ctx, uid, donations)
+			return v.ShadowImpact, true
+		}
+	}
+	return repv2.NewInt(0), false
+}
+
+// CompareReputation - reputation of @p username under repv2 and the shadow algorithm.
+func (rep ReputationManager) CompareReputation(ctx sdk.Context, username types.AccountKey) (*ReputationComparison, sdk.Error) {
+	shadow := rep.getShadow(ctx)
+	if shadow == nil {
+		return nil, ErrShadowNotRegistered()
+	}
+	reputation, err := rep.GetReputation(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return &ReputationComparison{
+		Username:         username,
+		Reputation:       reputation,
+		Shadow:           rep.shadowName,
+		ShadowReputation: types.NewMiniDollarFromBig(shadow.GetReputation(repv2.Uid(username)).Int),
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 	linotypes "github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/utils"
 	types "github.com/lino-network/lino/x/reputation"
+	"github.com/lino-network/lino/x/reputation/repv2"
 )
 
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
@@ -22,6 +23,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	cmd.AddCommand(client.GetCommands(
 		getCmdShow(cdc),
+		getCmdDetail(cdc),
+		getCmdCompare(cdc),
 	)...)
 	return cmd
 }
@@ -41,3 +44,35 @@ func getCmdShow(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// getCmdDetail - show components of the reputation, explaining how it changes.
+func getCmdDetail(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "detail <username>",
+		Short: "detail <username>, scores, consumption of the last donation round and its decay",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			username := args[0]
+			uri := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryReputationDetail, username)
+			rst := repv2.ReputationDetail{}
+			return utils.CLIQueryJSONPrint(cdc, uri, nil,
+				func() interface{} { return &rst })
+		},
+	}
+}
+
+// getCmdCompare - compare reputation under repv2 and the shadow algorithm.
+func getCmdCompare(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "compare <username>",
+		Short: "compare <username>, reputation under repv2 and the shadow algorithm",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			username := args[0]
+			uri := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryCompare, username)
+			rst := types.ReputationComparison{}
+			return utils.CLIQueryJSONPrint(cdc, uri, nil,
+				func() interface{} { return &rst })
+		},
+	}
+}
@@ -21,3 +21,8 @@ func ErrPostNotFound(permlink types.Permlink) sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeReputationQueryFailed, fmt.Sprintf("query reputation store failed"))
 }
+
+// ErrShadowNotRegistered - error when no shadow reputation algorithm is registered
+func ErrShadowNotRegistered() sdk.Error {
+	return types.NewError(types.CodeShadowNotRegistered, fmt.Sprintf("shadow reputation algorithm is not registered"))
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/reputation/repv2"
 )
 
 type ReputationKeeper interface {
@@ -17,10 +18,24 @@ type ReputationKeeper interface {
 		post types.Permlink,
 		amount types.MiniDollar) (types.MiniDollar, sdk.Error)
 
+	// revert a donation made in the current round, return the impact reverted.
+	RevertDonation(
+		ctx sdk.Context,
+		username types.AccountKey,
+		post types.Permlink,
+		amount types.MiniDollar,
+		impact types.MiniDollar) (types.MiniDollar, sdk.Error)
+
 	// get user's latest reputation, which is the largest impact factor a user can
 	// make in a window.
 	GetReputation(ctx sdk.Context, username types.AccountKey) (types.MiniDollar, sdk.Error)
 
+	// get components of user's reputation, explaining how it changes.
+	GetReputationDetail(ctx sdk.Context, username types.AccountKey) (*repv2.ReputationDetail, sdk.Error)
+
+	// get user's reputation under repv2 and the shadow algorithm.
+	CompareReputation(ctx sdk.Context, username types.AccountKey) (*ReputationComparison, sdk.Error)
+
 	// update game status on block end.
 	Update(ctx sdk.Context) sdk.Error
 
@@ -13,6 +13,11 @@ import (
 type ReputationManager struct {
 	storeKey    sdk.StoreKey
 	paramHolder param.ParamHolder
+
+	// shadow algorithm, if registered, receives the same donations and updates
+	// as repv2 on its own substore, but never affects reputation in use.
+	shadowName string
+	shadow     Algorithm
 }
 
 // NewReputationManager - require holder for BestContentIndexN
@@ -23,17 +28,23 @@ func NewReputationManager(storeKey sdk.StoreKey, holder param.ParamHolder) Reput
 	}
 }
 
+// NewReputationManagerWithShadow - reputation manager that runs @p shadow algorithm
+// named @p name in shadow mode alongside repv2, to be compared by CompareReputation.
+// The shadow state is part of the application state but is not exported, so
+// registering, or changing, a shadow algorithm must be done at an upgrade.
+func NewReputationManagerWithShadow(storeKey sdk.StoreKey, holder param.ParamHolder, name string, shadow Algorithm) ReputationKeeper {
+	return ReputationManager{
+		storeKey:    storeKey,
+		paramHolder: holder,
+		shadowName:  name,
+		shadow:      shadow,
+	}
+}
+
 // construct a handler.
 func (rep ReputationManager) getHandlerV2(ctx sdk.Context) repv2.Reputation {
 	store := ctx.KVStore(rep.storeKey)
-	repStore := repv2.NewReputationStore(store, repv2.DefaultInitialReputation)
-	param := rep.paramHolder.GetReputationParam(ctx)
-	handler := repv2.NewReputation(
-		repStore, param.BestContentIndexN, param.UserMaxN,
-		repv2.DefaultRoundDurationSeconds,
-		repv2.DefaultSampleWindowSize,
-		repv2.DefaultDecayFactor)
-	return handler
+	return Repv2Algorithm(store, rep.paramHolder.GetReputationParam(ctx))
 }
 
 func (rep ReputationManager) checkUsername(uid repv2.Uid) sdk.Error {
@@ -73,13 +84,52 @@ func (rep ReputationManager) DonateAt(ctx sdk.Context,
 	// Update6, start to use new reputation algorithm.
 	handler := rep.getHandlerV2(ctx)
 	dp := handler.DonateAt(repv2.Uid(uid), repv2.Pid(pid), repv2.NewIntFromBig(amount.Int.BigInt()))
+	rep.runShadow(ctx, func(ctx sdk.Context, shadow repv2.Reputation) {
+		coins := repv2.NewIntFromBig(amount.Int.BigInt())
+		rep.recordShadowDonation(ctx, shadow, repv2.Uid(uid), shadowDonation{
+			Pid:          repv2.Pid(pid),
+			Amount:       coins,
+			Impact:       dp,
+			ShadowImpact: shadow.DonateAt(repv2.Uid(uid), repv2.Pid(pid), coins),
+		})
+	})
 	return types.NewMiniDollarFromBig(dp.Int), nil
 }
 
+// RevertDonation - revert a donation of @p amount with @p impact, only donations
+// of the current round can be reverted, returns the impact reverted.
+func (rep ReputationManager) RevertDonation(ctx sdk.Context,
+	username types.AccountKey, post types.Permlink, amount, impact types.MiniDollar) (types.MiniDollar, sdk.Error) {
+	uid := string(username)
+	pid := string(post)
+	err := rep.basicCheck(repv2.Uid(uid), repv2.Pid(pid))
+	if err != nil {
+		return types.NewMiniDollar(0), err
+	}
+	handler := rep.getHandlerV2(ctx)
+	reverted := handler.RevertDonation(repv2.Uid(uid), repv2.Pid(pid),
+		repv2.NewIntFromBig(amount.Int.BigInt()), repv2.NewIntFromBig(impact.Int.BigInt()))
+	// revert the impact that the shadow recorded for the same donation, donations
+	// made before the shadow was registered are not known to the shadow.
+	rep.runShadow(ctx, func(ctx sdk.Context, shadow repv2.Reputation) {
+		coins := repv2.NewIntFromBig(amount.Int.BigInt())
+		shadowImpact, found := rep.popShadowDonation(ctx, shadow,
+			repv2.Uid(uid), repv2.Pid(pid), coins, repv2.NewIntFromBig(impact.Int.BigInt()))
+		if !found {
+			return
+		}
+		shadow.RevertDonation(repv2.Uid(uid), repv2.Pid(pid), coins, shadowImpact)
+	})
+	return types.NewMiniDollarFromBig(reverted.Int), nil
+}
+
 // Update - on blocker end, update reputation time related information.
 func (rep ReputationManager) Update(ctx sdk.Context) sdk.Error {
 	handler := rep.getHandlerV2(ctx)
 	handler.Update(repv2.Time(ctx.BlockHeader().Time.Unix()))
+	rep.runShadow(ctx, func(ctx sdk.Context, shadow repv2.Reputation) {
+		shadow.Update(repv2.Time(ctx.BlockHeader().Time.Unix()))
+	})
 	return nil
 }
 
@@ -95,6 +145,19 @@ func (rep ReputationManager) GetReputation(ctx sdk.Context, username types.Accou
 	return types.NewMiniDollarFromBig(handler.GetReputation(repv2.Uid(uid)).Int), nil
 }
 
+// GetReputationDetail - return components of the reputation of @p username,
+// explaining how the reputation changes, see repv2.ReputationDetail.
+func (rep ReputationManager) GetReputationDetail(ctx sdk.Context, username types.AccountKey) (*repv2.ReputationDetail, sdk.Error) {
+	uid := repv2.Uid(username)
+	err := rep.checkUsername(uid)
+	if err != nil {
+		return nil, err
+	}
+	handler := rep.getHandlerV2(ctx)
+	detail := handler.GetReputationDetail(uid)
+	return &detail, nil
+}
+
 // GetCurrentRound of now
 func (rep ReputationManager) GetCurrentRound(ctx sdk.Context) (int64, sdk.Error) {
 	repv2 := rep.getHandlerV2(ctx)
@@ -252,3 +252,157 @@ func (suite *reputationTestSuite) TestExportImport() {
 		}
 	}
 }
+
+func (suite *reputationTestSuite) TestRevertDonation() {
+	rep := suite.rep
+	_, err := rep.RevertDonation(suite.ctx, "", "post1", types.NewMiniDollar(1), types.NewMiniDollar(1))
+	suite.NotNil(err)
+
+	dp, err := rep.DonateAt(suite.ctx, "user1", "post1", types.NewMiniDollar(100*100000))
+	suite.Nil(err)
+	reverted, err := rep.RevertDonation(suite.ctx, "user1", "post1", types.NewMiniDollar(100*100000), dp)
+	suite.Nil(err)
+	suite.Equal(dp, reverted)
+
+	// nothing left to revert.
+	reverted, err = rep.RevertDonation(suite.ctx, "user1", "post1", types.NewMiniDollar(100*100000), dp)
+	suite.Nil(err)
+	suite.Equal(types.NewMiniDollar(0), reverted)
+}
+
+func (suite *reputationTestSuite) TestGetReputationDetail() {
+	rep := suite.rep
+	_, err := rep.GetReputationDetail(suite.ctx, "")
+	suite.NotNil(err)
+
+	suite.timefies()
+	_, err = rep.DonateAt(suite.ctx, "user1", "post1", types.NewMiniDollar(100*100000))
+	suite.Nil(err)
+	detail, err := rep.GetReputationDetail(suite.ctx, "user1")
+	suite.Nil(err)
+	suite.Require().NotNil(detail.Round)
+	suite.False(detail.Round.Ended)
+
+	suite.timefies()
+	detail, err = rep.GetReputationDetail(suite.ctx, "user1")
+	suite.Nil(err)
+	suite.Require().NotNil(detail.Round)
+	suite.True(detail.Round.Ended)
+	suite.True(detail.Round.Donations[0].BestContent)
+	rv, err := rep.GetReputation(suite.ctx, "user1")
+	suite.Nil(err)
+	suite.Equal(rv.String(), detail.CustomerScore.String())
+}
+
+func (suite *reputationTestSuite) TestShadow() {
+	_, err := suite.rep.CompareReputation(suite.ctx, "user1")
+	suite.Equal(ErrShadowNotRegistered(), err)
+
+	// a shadow of a stricter decay factor.
+	strict := func(store repv2.Store, param *param.ReputationParam) repv2.Reputation {
+		return repv2.NewReputation(
+			repv2.NewReputationStore(store, repv2.DefaultInitialReputation),
+			param.BestContentIndexN, param.UserMaxN,
+			repv2.DefaultRoundDurationSeconds, repv2.DefaultSampleWindowSize, 100)
+	}
+	primary := suite.rep
+	suite.rep = NewReputationManagerWithShadow(primary.storeKey, suite.ph, "strict", strict).(ReputationManager)
+	rep := suite.rep
+	suite.timefies()
+	for i := 0; i < 10; i++ {
+		_, err := rep.DonateAt(suite.ctx, "user1", "good", types.NewMiniDollar(1000*100000))
+		suite.Nil(err)
+		_, err = rep.DonateAt(suite.ctx, "user1", "trash", types.NewMiniDollar(1*100000))
+		suite.Nil(err)
+		_, err = rep.DonateAt(suite.ctx, "user2", "good", types.NewMiniDollar(10000*100000))
+		suite.Nil(err)
+		suite.timefies()
+	}
+	dp, err := rep.DonateAt(suite.ctx, "user1", "trash", types.NewMiniDollar(100*100000))
+	suite.Nil(err)
+	_, err = rep.RevertDonation(suite.ctx, "user1", "trash", types.NewMiniDollar(100*100000), dp)
+	suite.Nil(err)
+	_, err = rep.DonateAt(suite.ctx, "user1", "trash", types.NewMiniDollar(100*100000))
+	suite.Nil(err)
+	_, err = rep.DonateAt(suite.ctx, "user2", "good", types.NewMiniDollar(10000*100000))
+	suite.Nil(err)
+	suite.timefies()
+
+	// repv2 is not affected by the shadow.
+	reputation, err := rep.GetReputation(suite.ctx, "user1")
+	suite.Nil(err)
+	unshadowed, err := primary.GetReputation(suite.ctx, "user1")
+	suite.Nil(err)
+	suite.Equal(unshadowed, reputation)
+
+	comparison, err := rep.CompareReputation(suite.ctx, "user1")
+	suite.Nil(err)
+	suite.Equal(types.AccountKey("user1"), comparison.Username)
+	suite.Equal("strict", comparison.Shadow)
+	suite.Equal(reputation, comparison.Reputation)
+	suite.True(comparison.ShadowReputation.LT(comparison.Reputation),
+		"shadow %s, repv2 %s", comparison.ShadowReputation, comparison.Reputation)
+
+	// a failing shadow neither fails repv2 nor writes its state.
+	failing := func(store repv2.Store, param *param.ReputationParam) repv2.Reputation {
+		store.Set([]byte("partial"), []byte("write"))
+		panic("failing shadow")
+	}
+	suite.rep = NewReputationManagerWithShadow(primary.storeKey, suite.ph, "failing", failing).(ReputationManager)
+	_, err = suite.rep.DonateAt(suite.ctx, "user1", "good", types.NewMiniDollar(100*100000))
+	suite.Nil(err)
+	suite.NotPanics(suite.timefies)
+	store := suite.ctx.KVStore(primary.storeKey)
+	suite.False(store.Has(append(shadowSubStore, []byte("partial")...)))
+}
+
+func (suite *reputationTestSuite) TestShadowRevertDonation() {
+	// a shadow of a lower initial reputation, which caps impact of donations.
+	capped := func(store repv2.Store, param *param.ReputationParam) repv2.Reputation {
+		return repv2.NewReputation(
+			repv2.NewReputationStore(store, 60*100000),
+			param.BestContentIndexN, param.UserMaxN,
+			repv2.DefaultRoundDurationSeconds, repv2.DefaultSampleWindowSize,
+			repv2.DefaultDecayFactor)
+	}
+	suite.rep = NewReputationManagerWithShadow(suite.rep.storeKey, suite.ph, "capped", capped).(ReputationManager)
+	rep := suite.rep
+	suite.timefies()
+	shadowImpact := func() repv2.Int {
+		detail := rep.getShadow(suite.ctx).GetReputationDetail("user1")
+		suite.Require().NotNil(detail.Round)
+		sum := repv2.NewInt(0)
+		for _, d := range detail.Round.Donations {
+			sum = repv2.IntAdd(sum, d.Impact)
+		}
+		return sum
+	}
+
+	small := types.NewMiniDollar(10 * 100000)
+	smallImpact, err := rep.DonateAt(suite.ctx, "user1", "post1", small)
+	suite.Nil(err)
+	afterSmall := shadowImpact()
+	suite.Equal(repv2.NewInt(10*100000).String(), afterSmall.String())
+
+	// impact of the large donation under the shadow is capped below its amount.
+	large := types.NewMiniDollar(100 * 100000)
+	largeImpact, err := rep.DonateAt(suite.ctx, "user1", "post1", large)
+	suite.Nil(err)
+	suite.Equal(repv2.NewInt(60*100000).String(), shadowImpact().String())
+
+	// reverting the large donation reverts exactly its shadow impact.
+	_, err = rep.RevertDonation(suite.ctx, "user1", "post1", large, largeImpact)
+	suite.Nil(err)
+	suite.Equal(afterSmall.String(), shadowImpact().String())
+
+	// reverting an unknown donation does not touch the shadow.
+	_, err = rep.RevertDonation(suite.ctx, "user1", "post1", large, largeImpact)
+	suite.Nil(err)
+	suite.Equal(afterSmall.String(), shadowImpact().String())
+
+	_, err = rep.RevertDonation(suite.ctx, "user1", "post1", small, smallImpact)
+	suite.Nil(err)
+	suite.Equal("0", shadowImpact().String())
+	store := suite.ctx.KVStore(rep.storeKey)
+	suite.False(store.Has(append(shadowDonationSubStore, []byte("user1")...)))
+}
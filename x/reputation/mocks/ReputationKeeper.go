@@ -4,6 +4,8 @@ package mocks
 
 import (
 	linotypes "github.com/lino-network/lino/types"
+	reputation "github.com/lino-network/lino/x/reputation"
+	repv2 "github.com/lino-network/lino/x/reputation/repv2"
 	amino "github.com/tendermint/go-amino"
 
 	mock "github.com/stretchr/testify/mock"
@@ -16,6 +18,31 @@ type ReputationKeeper struct {
 	mock.Mock
 }
 
+// CompareReputation provides a mock function with given fields: ctx, username
+func (_m *ReputationKeeper) CompareReputation(ctx types.Context, username linotypes.AccountKey) (*reputation.ReputationComparison, types.Error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 *reputation.ReputationComparison
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) *reputation.ReputationComparison); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reputation.ReputationComparison)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey) types.Error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // DonateAt provides a mock function with given fields: ctx, username, post, amount
 func (_m *ReputationKeeper) DonateAt(ctx types.Context, username linotypes.AccountKey, post linotypes.Permlink, amount linotypes.MiniDollar) (linotypes.MiniDollar, types.Error) {
 	ret := _m.Called(ctx, username, post, amount)
@@ -99,6 +126,31 @@ func (_m *ReputationKeeper) GetReputation(ctx types.Context, username linotypes.
 	return r0, r1
 }
 
+// GetReputationDetail provides a mock function with given fields: ctx, username
+func (_m *ReputationKeeper) GetReputationDetail(ctx types.Context, username linotypes.AccountKey) (*repv2.ReputationDetail, types.Error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 *repv2.ReputationDetail
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) *repv2.ReputationDetail); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repv2.ReputationDetail)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey) types.Error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // ImportFromFile provides a mock function with given fields: ctx, cdc, file
 func (_m *ReputationKeeper) ImportFromFile(ctx types.Context, cdc *amino.Codec, file string) error {
 	ret := _m.Called(ctx, cdc, file)
@@ -113,6 +165,29 @@ func (_m *ReputationKeeper) ImportFromFile(ctx types.Context, cdc *amino.Codec,
 	return r0
 }
 
+// RevertDonation provides a mock function with given fields: ctx, username, post, amount, impact
+func (_m *ReputationKeeper) RevertDonation(ctx types.Context, username linotypes.AccountKey, post linotypes.Permlink, amount linotypes.MiniDollar, impact linotypes.MiniDollar) (linotypes.MiniDollar, types.Error) {
+	ret := _m.Called(ctx, username, post, amount, impact)
+
+	var r0 linotypes.MiniDollar
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.Permlink, linotypes.MiniDollar, linotypes.MiniDollar) linotypes.MiniDollar); ok {
+		r0 = rf(ctx, username, post, amount, impact)
+	} else {
+		r0 = ret.Get(0).(linotypes.MiniDollar)
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey, linotypes.Permlink, linotypes.MiniDollar, linotypes.MiniDollar) types.Error); ok {
+		r1 = rf(ctx, username, post, amount, impact)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx
 func (_m *ReputationKeeper) Update(ctx types.Context) types.Error {
 	ret := _m.Called(ctx)
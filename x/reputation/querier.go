@@ -17,7 +17,9 @@ const (
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
 
-	QueryReputation = "rep"
+	QueryReputation       = "rep"
+	QueryReputationDetail = "rep-detail"
+	QueryCompare          = "compare"
 )
 
 // creates a querier for vote REST endpoints
@@ -28,6 +30,10 @@ func NewQuerier(rm ReputationKeeper) sdk.Querier {
 		switch path[0] {
 		case QueryReputation:
 			return queryReputation(ctx, cdc, path[1:], req, rm)
+		case QueryReputationDetail:
+			return queryReputationDetail(ctx, cdc, path[1:], req, rm)
+		case QueryCompare:
+			return queryCompare(ctx, cdc, path[1:], req, rm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown reputation query endpoint")
 		}
@@ -48,3 +54,33 @@ func queryReputation(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.R
 	}
 	return res, nil
 }
+
+func queryReputationDetail(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, rm ReputationKeeper) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	detail, err := rm.GetReputationDetail(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(detail)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryCompare(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, rm ReputationKeeper) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	comparison, err := rm.CompareReputation(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(comparison)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
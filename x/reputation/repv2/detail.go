@@ -0,0 +1,116 @@
+package repv2
+
+// ReputationDetail - components of the reputation of a user, to explain how it
+// changes. CustomerScore = max(Consumption - Hold * SampleWindowSize, 0).
+type ReputationDetail struct {
+	Username      Uid `json:"username"`
+	CustomerScore Rep `json:"customer_score"`
+	// FreeScore is merged into consumption by IncFreeScore, so it is always zero,
+	// kept for compatibility with UserReputation.
+	FreeScore        Rep      `json:"free_score"`
+	Consumption      LinoCoin `json:"consumption"`
+	Hold             Rep      `json:"hold"`
+	SampleWindowSize int64    `json:"sample_window_size"`
+	CurrentRound     RoundId  `json:"current_round"`
+	LastSettledRound RoundId  `json:"last_settled_round"`
+	// Round is the last round that the user donated in, nil if it has been
+	// settled by a later donation of the user.
+	Round *RoundConsumption `json:"round,omitempty"`
+}
+
+// RoundConsumption - donations of a user in a round and how they affect the
+// reputation. The best content set of the round, and so the fields below
+// Donations, are decided only when the round has ended, and the scores of
+// ReputationDetail include the round since then.
+type RoundConsumption struct {
+	Round     RoundId          `json:"round"`
+	Ended     bool             `json:"ended"`
+	Donations []DonationDetail `json:"donations"`
+	// amount and impact of donations to posts in and out of the best content set.
+	SeedConsumption  LinoCoin `json:"seed_consumption"`
+	OtherConsumption LinoCoin `json:"other_consumption"`
+	SeedIF           IF       `json:"seed_if"`
+	OtherIF          IF       `json:"other_if"`
+	// if OtherIF exceeds OtherIFLimit, 20% of the total impact, DecayFactor percent
+	// of the excess is deducted from consumption, as DecayPenalty.
+	DecayFactor  int64    `json:"decay_factor"`
+	OtherIFLimit IF       `json:"other_if_limit"`
+	DecayPenalty LinoCoin `json:"decay_penalty"`
+	// consumption of the user before the round is settled.
+	ConsumptionBefore LinoCoin `json:"consumption_before"`
+}
+
+// DonationDetail - merged donations of a user to a post in a round.
+type DonationDetail struct {
+	Pid         Pid      `json:"pid"`
+	Amount      LinoCoin `json:"amount"`
+	Impact      IF       `json:"impact"`
+	BestContent bool     `json:"best_content"`
+}
+
+// GetReputationDetail - return the components of the reputation of @p u, same as
+// GetReputation, an ended round is settled, but nothing is written to the store.
+func (rep ReputationImpl) GetReputationDetail(u Uid) ReputationDetail {
+	user := rep.store.GetUserMeta(u)
+	current := rep.store.GetCurrentRound()
+	rst := ReputationDetail{
+		Username:         u,
+		CustomerScore:    user.Reputation,
+		FreeScore:        NewInt(0),
+		Consumption:      user.Consumption,
+		Hold:             user.Hold,
+		SampleWindowSize: rep.SampleWindowSize,
+		CurrentRound:     current,
+		LastSettledRound: user.LastSettledRound,
+	}
+	if !(user.LastSettledRound < user.LastDonationRound) {
+		return rst
+	}
+
+	round := &RoundConsumption{
+		Round:             user.LastDonationRound,
+		Ended:             user.LastDonationRound < current,
+		Donations:         make([]DonationDetail, 0),
+		SeedConsumption:   NewInt(0),
+		OtherConsumption:  NewInt(0),
+		SeedIF:            NewInt(0),
+		OtherIF:           NewInt(0),
+		DecayFactor:       rep.DecayFactor,
+		OtherIFLimit:      NewInt(0),
+		DecayPenalty:      NewInt(0),
+		ConsumptionBefore: user.Consumption,
+	}
+	seedset := make(map[Pid]bool)
+	if round.Ended {
+		seedset = rep.getSeedSet(round.Round)
+	}
+	for _, pd := range user.Unsettled {
+		round.Donations = append(round.Donations, DonationDetail{
+			Pid:         pd.Pid,
+			Amount:      pd.Amount,
+			Impact:      pd.Impact,
+			BestContent: seedset[pd.Pid],
+		})
+	}
+	rst.Round = round
+	if !round.Ended {
+		return rst
+	}
+
+	consumptions := rep.extractConsumptionInfo(user, seedset)
+	round.SeedConsumption = consumptions.seed
+	round.OtherConsumption = consumptions.other
+	round.SeedIF = consumptions.seedIF
+	round.OtherIF = consumptions.otherIF
+	round.OtherIFLimit, round.DecayPenalty = rep.decayPenalty(consumptions)
+	newrep := rep.computeNewRepData(reputationData{
+		consumption: user.Consumption,
+		hold:        user.Hold,
+		reputation:  user.Reputation,
+	}, consumptions)
+	rst.CustomerScore = newrep.reputation
+	rst.Consumption = newrep.consumption
+	rst.Hold = newrep.hold
+	rst.LastSettledRound = round.Round
+	return rst
+}
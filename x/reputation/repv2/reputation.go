@@ -14,6 +14,10 @@ type Reputation interface {
 	// Note that if migrate is required, it must be done before donate.
 	DonateAt(u Uid, p Pid, s LinoCoin) IF
 
+	// Revert a donation to post @p p of @p s coins with @p dp impact, return the
+	// impact reverted. Only donations of the current round can be reverted.
+	RevertDonation(u Uid, p Pid, s LinoCoin, dp IF) IF
+
 	// user's freescore += @p r, NOTE: unit is COIN.
 	IncFreeScore(u Uid, r Rep)
 
@@ -23,6 +27,9 @@ type Reputation interface {
 	// current reputation of the user.
 	GetReputation(u Uid) Rep
 
+	// components of the current reputation of the user, read only.
+	GetReputationDetail(u Uid) ReputationDetail
+
 	// Round 0 is an invalidated round
 	// Round 1 is a short round that will last for only one block, because round-1's
 	// start time is set to 0.
@@ -171,7 +178,6 @@ func (rep ReputationImpl) computeNewRepData(repData reputationData, consumptions
 	seed := consumptions.seed
 	other := consumptions.other
 	seedIF := consumptions.seedIF
-	otherIF := consumptions.otherIF
 
 	adjustedConsumption := IntMin(
 		IntMax(IntDivFrac(seedIF, 8, 10), seed),
@@ -181,12 +187,8 @@ func (rep ReputationImpl) computeNewRepData(repData reputationData, consumptions
 	if IntGreater(adjustedConsumption, repData.consumption) {
 		newConsumption = IntEMA(repData.consumption, adjustedConsumption, rep.SampleWindowSize)
 	}
-	otherLimit := IntDiv(IntAdd(seedIF, otherIF), NewInt(5)) // * 20%
-	if IntGreater(otherIF, otherLimit) {
-		newConsumption = IntSub(newConsumption,
-			IntMax(NewInt(1),
-				IntMulFrac(IntSub(otherIF, otherLimit), rep.DecayFactor, 100)))
-		newConsumption = IntMax(NewInt(0), newConsumption)
+	if _, penalty := rep.decayPenalty(consumptions); IntGreater(penalty, NewInt(0)) {
+		newConsumption = IntMax(NewInt(0), IntSub(newConsumption, penalty))
 	}
 
 	if IntGTE(newConsumption, repData.consumption) {
@@ -199,6 +201,20 @@ func (rep ReputationImpl) computeNewRepData(repData reputationData, consumptions
 	return repData
 }
 
+// return the limit of impact of donations to posts out of the seed set, which is
+// 20% of the total impact, and the consumption to decay if it is exceeded,
+// DecayFactor percent of the excess and at least 1.
+func (rep ReputationImpl) decayPenalty(consumptions consumptionInfo) (IF, LinoCoin) {
+	seedIF := consumptions.seedIF
+	otherIF := consumptions.otherIF
+	otherLimit := IntDiv(IntAdd(seedIF, otherIF), NewInt(5)) // * 20%
+	if !IntGreater(otherIF, otherLimit) {
+		return otherLimit, NewInt(0)
+	}
+	return otherLimit, IntMax(NewInt(1),
+		IntMulFrac(IntSub(otherIF, otherLimit), rep.DecayFactor, 100))
+}
+
 // update @p user with information of @p current round.
 func (rep ReputationImpl) updateReputation(user *userMeta, current RoundId) {
 	// needs to update user's reputation only when the last settled
@@ -268,6 +284,41 @@ func (rep ReputationImpl) DonateAt(u Uid, p Pid, amount LinoCoin) IF {
 	return impact
 }
 
+// RevertDonation - revert a donation made by @p u in the current round, as if
+// it had not been made. Donations of a settled round have already been
+// counted into reputation and can not be reverted, 0 is returned.
+// Note that a post whose sum of impact factors is decreased only moves down
+// within TopN, posts that were not in TopN are not promoted.
+func (rep ReputationImpl) RevertDonation(u Uid, p Pid, amount LinoCoin, impact IF) IF {
+	var current RoundId = rep.store.GetCurrentRound()
+	user := rep.store.GetUserMeta(u)
+	if user.LastDonationRound != current {
+		return NewInt(0)
+	}
+	pos := -1
+	for i, v := range user.Unsettled {
+		if v.Pid == p {
+			pos = i
+			break
+		}
+	}
+	if pos == -1 {
+		return NewInt(0)
+	}
+	donation := user.Unsettled[pos]
+	reverted := IntMax(IntMin(impact, donation.Impact), NewInt(0))
+	donation.Amount = IntMax(IntSub(donation.Amount, amount), NewInt(0))
+	donation.Impact = IntSub(donation.Impact, reverted)
+	if donation.Amount.Cmp(NewInt(0)) == 0 && donation.Impact.Cmp(NewInt(0)) == 0 {
+		user.Unsettled = append(user.Unsettled[:pos], user.Unsettled[pos+1:]...)
+	} else {
+		user.Unsettled[pos] = donation
+	}
+	rep.store.SetUserMeta(u, user)
+	rep.decRoundPostSumImpact(current, p, reverted)
+	return reverted
+}
+
 // appendDonation: append a new donation to user's unsettled list, return the impact
 // factor of this donation.
 // contract: before calling this, user's reputation needs to
@@ -353,6 +404,23 @@ func (rep ReputationImpl) incRoundPostSumImpact(round RoundId, p Pid, dp IF) {
 	}
 }
 
+// decrease the sum of impact factors of @p post by @p dp, in @p round.
+func (rep ReputationImpl) decRoundPostSumImpact(round RoundId, p Pid, dp IF) {
+	roundPost := rep.store.GetRoundPostMeta(round, p)
+	roundMeta := rep.store.GetRoundMeta(round)
+	roundMeta.SumIF.Sub(dp)
+	roundPost.SumIF = IntSub(roundPost.SumIF, dp)
+	for i, v := range roundMeta.TopN {
+		if v.Pid == p {
+			roundMeta.TopN[i].SumIF = roundPost.SumIF
+			bubbleDown(roundMeta.TopN, i)
+			break
+		}
+	}
+	rep.store.SetRoundPostMeta(round, p, roundPost)
+	rep.store.SetRoundMeta(round, roundMeta)
+}
+
 // return the current round id the the start time of the round.
 func (rep ReputationImpl) GetCurrentRound() (RoundId, Time) {
 	rid := rep.store.GetCurrentRound()
@@ -449,3 +517,16 @@ func bubbleUp(posts []PostIFPair, pos int) {
 		}
 	}
 }
+
+// contract:
+//     before: all inversions are related to posts[pos].
+//     after:  posts are sorted by SumIF, decreasingly.
+func bubbleDown(posts []PostIFPair, pos int) {
+	for i := pos; i < len(posts)-1; i++ {
+		if IntLess(posts[i].SumIF, posts[i+1].SumIF) {
+			posts[i], posts[i+1] = posts[i+1], posts[i]
+		} else {
+			break
+		}
+	}
+}
@@ -829,3 +829,94 @@ func BenchmarkDonateAtWorstCase(b *testing.B) {
 		suite.rep.DonateAt("user1", posts[n], NewInt(10000*100000))
 	}
 }
+
+func (suite *ReputationTestSuite) TestRevertDonation() {
+	rep := suite.rep
+	rep.IncFreeScore("user1", NewInt(1000000))
+	rep.IncFreeScore("user2", NewInt(1000000))
+	suite.MoveToNewRound()
+	dp1 := rep.DonateAt("user1", "post1", NewInt(1000))
+	dp2 := rep.DonateAt("user2", "post2", NewInt(500))
+	rep.DonateAt("user1", "post2", NewInt(100))
+	suite.Equal(NewInt(1000), dp1)
+	suite.Equal(NewInt(500), dp2)
+	round := rep.store.GetRoundMeta(2)
+	suite.Equal(Pid("post1"), round.TopN[0].Pid)
+
+	// partially revert.
+	suite.Equal(NewInt(600), rep.RevertDonation("user1", "post1", NewInt(600), NewInt(600)))
+	suite.Equal(NewInt(400), rep.store.GetRoundPostMeta(2, "post1").SumIF)
+	round = rep.store.GetRoundMeta(2)
+	suite.Equal([]PostIFPair{
+		{Pid: "post2", SumIF: IntAdd(dp2, NewInt(100))},
+		{Pid: "post1", SumIF: NewInt(400)},
+	}, round.TopN)
+	suite.Equal(IntAdd(dp2, NewInt(500)), round.SumIF)
+
+	// fully revert, impact reverted is bounded by the impact of donations.
+	suite.Equal(NewInt(400), rep.RevertDonation("user1", "post1", NewInt(400), NewInt(1000)))
+	suite.EqualZero(rep.store.GetRoundPostMeta(2, "post1").SumIF)
+	user := rep.store.GetUserMeta("user1")
+	suite.Equal([]Donation{{Pid: "post2", Amount: NewInt(100), Impact: NewInt(100)}}, user.Unsettled)
+	suite.EqualZero(rep.RevertDonation("user1", "post1", NewInt(1), NewInt(1)))
+	suite.EqualZero(rep.RevertDonation("user3", "post1", NewInt(1), NewInt(1)))
+
+	// donations of settled rounds can not be reverted.
+	suite.MoveToNewRound()
+	suite.EqualZero(rep.RevertDonation("user2", "post2", NewInt(500), dp2))
+	suite.Equal(IntAdd(dp2, NewInt(100)), rep.store.GetRoundPostMeta(2, "post2").SumIF)
+}
+
+func (suite *ReputationTestSuite) TestGetReputationDetail() {
+	rep := suite.rep
+	rep.IncFreeScore("user1", NewInt(1000))
+	rep.IncFreeScore("majority", NewInt(1000000))
+	suite.MoveToNewRound()
+
+	consumption := NewInt(1000 + DefaultInitialReputation)
+	detail := rep.GetReputationDetail("user1")
+	suite.Nil(detail.Round)
+	suite.Equal(consumption, detail.Consumption)
+	suite.Equal(rep.GetReputation("user1"), detail.CustomerScore)
+	suite.EqualZero(detail.FreeScore)
+
+	rep.DonateAt("majority", "good", NewInt(1000000))
+	rep.DonateAt("user1", "good", NewInt(100))
+	rep.DonateAt("user1", "trash", NewInt(900))
+
+	// best content is not decided before the round ends.
+	detail = rep.GetReputationDetail("user1")
+	suite.Require().NotNil(detail.Round)
+	suite.Equal(RoundId(2), detail.Round.Round)
+	suite.False(detail.Round.Ended)
+	suite.Equal([]DonationDetail{
+		{Pid: "good", Amount: NewInt(100), Impact: NewInt(100), BestContent: false},
+		{Pid: "trash", Amount: NewInt(900), Impact: NewInt(900), BestContent: false},
+	}, detail.Round.Donations)
+	suite.EqualZero(detail.Round.DecayPenalty)
+	suite.Equal(consumption, detail.Consumption)
+
+	suite.MoveToNewRound()
+	before := rep.store.GetUserMeta("user1")
+	detail = rep.GetReputationDetail("user1")
+	suite.Equal(before, rep.store.GetUserMeta("user1"), "detail must not write the store")
+	suite.Require().NotNil(detail.Round)
+	suite.True(detail.Round.Ended)
+	suite.Equal([]DonationDetail{
+		{Pid: "good", Amount: NewInt(100), Impact: NewInt(100), BestContent: true},
+		{Pid: "trash", Amount: NewInt(900), Impact: NewInt(900), BestContent: false},
+	}, detail.Round.Donations)
+	suite.Equal(NewInt(100), detail.Round.SeedConsumption)
+	suite.Equal(NewInt(900), detail.Round.OtherConsumption)
+	suite.Equal(NewInt(200), detail.Round.OtherIFLimit)
+	// (900 - 200) * DefaultDecayFactor%
+	suite.Equal(IntMulFrac(NewInt(700), DefaultDecayFactor, 100), detail.Round.DecayPenalty)
+	suite.Equal(consumption, detail.Round.ConsumptionBefore)
+	suite.Equal(RoundId(2), detail.LastSettledRound)
+
+	// same as the settled reputation.
+	suite.Equal(rep.GetReputation("user1"), detail.CustomerScore)
+	user := rep.store.GetUserMeta("user1")
+	suite.Equal(user.Consumption, detail.Consumption)
+	suite.Equal(user.Hold, detail.Hold)
+}
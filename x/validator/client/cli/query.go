@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -25,6 +26,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdShow(cdc),
 		getCmdList(cdc),
 		getCmdVoteInfo(cdc),
+		getCmdReward(cdc),
+		getCmdEvidence(cdc),
+		getCmdSigning(cdc),
+		getCmdSimulateVotes(cdc),
+		getCmdLedger(cdc),
 	)...)
 	return cmd
 }
@@ -75,3 +81,110 @@ func getCmdVoteInfo(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdReward
+func getCmdReward(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reward",
+		Short: "reward <username>",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			user := linotypes.AccountKey(args[0])
+			uri := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryVoterReward, user)
+			rst := model.VoterReward{}
+			return utils.CLIQueryJSONPrint(cdc, uri, nil,
+				func() interface{} { return &rst })
+		},
+	}
+}
+
+// GetCmdEvidence
+func getCmdEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "evidence",
+		Short: "evidence <username>",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			user := linotypes.AccountKey(args[0])
+			uri := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryEvidence, user)
+			rst := model.EvidenceHistory{}
+			return utils.CLIQueryJSONPrint(cdc, uri, nil,
+				func() interface{} { return &rst })
+		},
+	}
+}
+
+// GetCmdSigning -
+func getCmdSigning(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "signing",
+		Short: "signing [username], all committing validators if username is not set",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				uri := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfos)
+				rst := make([]model.SigningInfo, 0)
+				return utils.CLIQueryJSONPrint(cdc, uri, nil,
+					func() interface{} { return &rst })
+			}
+			user := linotypes.AccountKey(args[0])
+			uri := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QuerySigningInfo, user)
+			rst := model.SigningInfo{}
+			return utils.CLIQueryJSONPrint(cdc, uri, nil,
+				func() interface{} { return &rst })
+		},
+	}
+}
+
+// GetCmdSimulateVotes -
+func getCmdSimulateVotes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "simulate-votes",
+		Short: "simulate-votes <validator>:<lino change>..., e.g. val1:100 val2:-50",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			changes := make([]model.ElectionVote, 0)
+			for _, arg := range args {
+				parts := strings.SplitN(arg, ":", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid vote change: %s", arg)
+				}
+				amount := strings.TrimPrefix(parts[1], "-")
+				coin, err := linotypes.LinoToCoin(amount)
+				if err != nil {
+					return err
+				}
+				if amount != parts[1] {
+					coin = coin.Neg()
+				}
+				changes = append(changes, model.ElectionVote{
+					ValidatorName: linotypes.AccountKey(parts[0]),
+					Vote:          coin,
+				})
+			}
+			data, err := cdc.MarshalJSON(changes)
+			if err != nil {
+				return err
+			}
+			uri := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySimulateVotes)
+			rst := model.ValidatorList{}
+			return utils.CLIQueryJSONPrint(cdc, uri, data,
+				func() interface{} { return &rst })
+		},
+	}
+}
+
+// GetCmdLedger -
+func getCmdLedger(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "ledger",
+		Short: "ledger <username> [start unix time] [end unix time]",
+		Args:  cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			uri := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryLedger, strings.Join(args, "/"))
+			rst := make([]model.LedgerEntry, 0)
+			return utils.CLIQueryJSONPrint(cdc, uri, nil,
+				func() interface{} { return &rst })
+		},
+	}
+}
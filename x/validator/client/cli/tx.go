@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	cfg "github.com/tendermint/tendermint/config"
@@ -22,6 +23,8 @@ const (
 	FlagAmount     = "amount"
 	FlagLink       = "link"
 	FlagValidators = "validators"
+	FlagRate       = "rate"
+	FlagPubKey     = "pubkey"
 )
 
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
@@ -38,6 +41,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdRevoke(cdc),
 		GetCmdVote(cdc),
 		GetCmdUpdate(cdc),
+		GetCmdUpdateCommission(cdc),
+		GetCmdClaimReward(cdc),
+		GetCmdUnjail(cdc),
+		GetCmdRotateKey(cdc),
 	)...)
 
 	return cmd
@@ -142,3 +149,84 @@ func GetCmdUpdate(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagLink, "", "link of the validator")
 	return cmd
 }
+
+// GetCmdUpdateCommission -
+func GetCmdUpdateCommission(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-commission",
+		Short: "update-commission user --rate <rate>",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			validator := args[0]
+			rate, err := sdk.NewDecFromStr(viper.GetString(FlagRate))
+			if err != nil {
+				return err
+			}
+			msg := types.NewValidatorUpdateCommissionMsg(validator, rate)
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().String(FlagRate, "", "commission rate of the validator, e.g. 0.1")
+	_ = cmd.MarkFlagRequired(FlagRate)
+	return cmd
+}
+
+// GetCmdClaimReward -
+func GetCmdClaimReward(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-reward",
+		Short: "claim-reward user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			voter := args[0]
+			msg := types.NewClaimValidatorRewardMsg(voter)
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	return cmd
+}
+
+// GetCmdUnjail -
+func GetCmdUnjail(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Short: "unjail user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			validator := args[0]
+			msg := types.NewValidatorUnjailMsg(validator)
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	return cmd
+}
+
+// GetCmdRotateKey -
+func GetCmdRotateKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key",
+		Short: "rotate-key user --pubkey <bech32 consensus pubkey>, local validator key if not set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			validator := args[0]
+			var pubKey crypto.PubKey
+			var err error
+			if bech := viper.GetString(FlagPubKey); bech != "" {
+				pubKey, err = sdk.GetConsPubKeyBech32(bech)
+			} else {
+				pubKey, err = getLocalUserPubKey()
+			}
+			if err != nil {
+				return err
+			}
+			msg := types.NewValidatorRotateKeyMsg(validator, pubKey)
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().String(FlagPubKey, "", "new consensus public key of the validator")
+	return cmd
+}
@@ -21,6 +21,14 @@ func NewHandler(vm ValidatorKeeper) sdk.Handler {
 			return handleVoteValidatorMsg(ctx, vm, msg)
 		case types.ValidatorUpdateMsg:
 			return handleValidatorUpdateMsg(ctx, vm, msg)
+		case types.ValidatorUpdateCommissionMsg:
+			return handleValidatorUpdateCommissionMsg(ctx, vm, msg)
+		case types.ClaimValidatorRewardMsg:
+			return handleClaimValidatorRewardMsg(ctx, vm, msg)
+		case types.ValidatorUnjailMsg:
+			return handleValidatorUnjailMsg(ctx, vm, msg)
+		case types.ValidatorRotateKeyMsg:
+			return handleValidatorRotateKeyMsg(ctx, vm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized validator msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -57,3 +65,35 @@ func handleValidatorUpdateMsg(ctx sdk.Context, vm ValidatorKeeper, msg types.Val
 	}
 	return sdk.Result{}
 }
+
+func handleValidatorUpdateCommissionMsg(
+	ctx sdk.Context, vm ValidatorKeeper, msg types.ValidatorUpdateCommissionMsg) sdk.Result {
+	if err := vm.UpdateCommission(ctx, msg.Username, msg.CommissionRate); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleClaimValidatorRewardMsg(
+	ctx sdk.Context, vm ValidatorKeeper, msg types.ClaimValidatorRewardMsg) sdk.Result {
+	if err := vm.ClaimValidatorReward(ctx, msg.Username); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleValidatorUnjailMsg(
+	ctx sdk.Context, vm ValidatorKeeper, msg types.ValidatorUnjailMsg) sdk.Result {
+	if err := vm.UnjailValidator(ctx, msg.Username); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleValidatorRotateKeyMsg(
+	ctx sdk.Context, vm ValidatorKeeper, msg types.ValidatorRotateKeyMsg) sdk.Result {
+	if err := vm.RotateValidatorKey(ctx, msg.Username, msg.ValPubKey); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
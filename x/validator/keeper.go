@@ -18,12 +18,17 @@ type ValidatorKeeper interface {
 	OnBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock)
 	RegisterValidator(ctx sdk.Context, username linotypes.AccountKey, valPubKey crypto.PubKey, link string) sdk.Error
 	RevokeValidator(ctx sdk.Context, username linotypes.AccountKey) sdk.Error
+	UnjailValidator(ctx sdk.Context, username linotypes.AccountKey) sdk.Error
 	VoteValidator(ctx sdk.Context, username linotypes.AccountKey, votedValidators []linotypes.AccountKey) sdk.Error
 	DistributeInflationToValidator(ctx sdk.Context) sdk.Error
+	// returns the coins actually slashed, which can be less than penalty.
 	PunishCommittingValidator(ctx sdk.Context, username linotypes.AccountKey,
-		penalty linotypes.Coin, punishType linotypes.PunishType) sdk.Error
+		penalty linotypes.Coin, punishType linotypes.PunishType) (linotypes.Coin, sdk.Error)
 	Hooks() votemn.Hooks
 	UpdateValidator(ctx sdk.Context, username linotypes.AccountKey, link string) sdk.Error
+	UpdateCommission(ctx sdk.Context, username linotypes.AccountKey, rate sdk.Dec) sdk.Error
+	ClaimValidatorReward(ctx sdk.Context, username linotypes.AccountKey) sdk.Error
+	RotateValidatorKey(ctx sdk.Context, username linotypes.AccountKey, valPubKey crypto.PubKey) sdk.Error
 
 	// getters
 	GetInitValidators(ctx sdk.Context) ([]abci.ValidatorUpdate, sdk.Error)
@@ -33,6 +38,16 @@ type ValidatorKeeper interface {
 	GetElectionVoteList(ctx sdk.Context, accKey linotypes.AccountKey) *model.ElectionVoteList
 	GetCommittingValidators(ctx sdk.Context) []linotypes.AccountKey
 	GetCommittingValidatorVoteStatus(ctx sdk.Context) []model.ReceivedVotesStatus
+	GetVoterReward(ctx sdk.Context, username linotypes.AccountKey) *model.VoterReward
+	GetEvidenceHistory(ctx sdk.Context, username linotypes.AccountKey) *model.EvidenceHistory
+	GetKeyRotation(ctx sdk.Context, username linotypes.AccountKey) *model.KeyRotation
+	GetSigningInfo(ctx sdk.Context, username linotypes.AccountKey) (*model.SigningInfo, sdk.Error)
+	GetCommittingSigningInfos(ctx sdk.Context) ([]model.SigningInfo, sdk.Error)
+	GetLedger(ctx sdk.Context, username linotypes.AccountKey, start, end int64) []model.LedgerEntry
+	SimulateVoteChanges(ctx sdk.Context, changes []model.ElectionVote) (*model.ValidatorList, sdk.Error)
+
+	// invariants
+	RegisterInvariants(ir sdk.InvariantRegistry)
 
 	// import export
 	ExportToFile(ctx sdk.Context, cdc *codec.Codec, filepath string) error
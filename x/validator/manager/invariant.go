@@ -0,0 +1,62 @@
+package manager
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator/model"
+	"github.com/lino-network/lino/x/validator/types"
+)
+
+// RegisterInvariants - register validator invariants.
+func (vm ValidatorManager) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(types.ModuleName, "received-votes", vm.receivedVotesInvariant)
+}
+
+// receivedVotesInvariant - received votes of each validator equals the sum of
+// election votes it received from voters.
+func (vm ValidatorManager) receivedVotesInvariant(ctx sdk.Context) (string, bool) {
+	votes := make(map[linotypes.AccountKey]linotypes.Coin)
+	vm.storage.IterateElectionVoteLists(ctx, func(user linotypes.AccountKey, lst *model.ElectionVoteList) bool {
+		for _, v := range lst.ElectionVotes {
+			if sum, ok := votes[v.ValidatorName]; ok {
+				votes[v.ValidatorName] = sum.Plus(v.Vote)
+			} else {
+				votes[v.ValidatorName] = v.Vote
+			}
+		}
+		return false
+	})
+
+	msg := ""
+	broken := false
+	vm.storage.StoreMap(ctx)[string(model.ValidatorSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		validator := val.(*model.Validator)
+		sum, ok := votes[validator.Username]
+		if !ok {
+			sum = linotypes.NewCoinFromInt64(0)
+		}
+		delete(votes, validator.Username)
+		if !validator.ReceivedVotes.IsEqual(sum) {
+			broken = true
+			msg += fmt.Sprintf("\tvalidator %s received votes: %s, sum of election votes: %s\n",
+				validator.Username, validator.ReceivedVotes, sum)
+		}
+		return false
+	})
+	unknown := make([]string, 0, len(votes))
+	for name := range votes {
+		unknown = append(unknown, string(name))
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		if sum := votes[linotypes.AccountKey(name)]; !sum.IsZero() {
+			broken = true
+			msg += fmt.Sprintf("\tunknown validator %s received election votes: %s\n", name, sum)
+		}
+	}
+	return sdk.FormatInvariant(types.ModuleName, "received-votes", msg), broken
+}
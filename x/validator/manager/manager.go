@@ -23,8 +23,13 @@ import (
 )
 
 const (
-	exportVersion = 1
-	importVersion = 1
+	exportVersion = 7
+	importVersion = 7
+	// validators exported before commission was introduced keep all inflation.
+	preCommissionVersion = 1
+
+	// validator updates returned at end of block H are used by tendermint from block H+2.
+	validatorUpdateDelay = 2
 )
 
 // ValidatorManager - validator manager
@@ -74,6 +79,10 @@ func (vm ValidatorManager) OnBeginBlock(ctx sdk.Context, req abci.RequestBeginBl
 	if err := vm.fireIncompetentValidator(ctx, req.ByzantineValidators); err != nil {
 		panic(err)
 	}
+
+	if err := vm.applyKeyRotations(ctx); err != nil {
+		panic(err)
+	}
 }
 
 func (vm ValidatorManager) UpdateValidator(ctx sdk.Context, username linotypes.AccountKey, link string) sdk.Error {
@@ -86,6 +95,118 @@ func (vm ValidatorManager) UpdateValidator(ctx sdk.Context, username linotypes.A
 	return nil
 }
 
+// UpdateCommission - update commission rate of validator, the rate is bounded by
+// MaxCommissionRate and can only change MaxCommissionChangeRate per CommissionChangeIntervalSec.
+func (vm ValidatorManager) UpdateCommission(ctx sdk.Context, username linotypes.AccountKey, rate sdk.Dec) sdk.Error {
+	if !vm.IsLegalValidator(ctx, username) {
+		return types.ErrInvalidValidator()
+	}
+	val, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	param := vm.paramHolder.GetValidatorParam(ctx)
+	if rate.IsNegative() || rate.GT(param.MaxCommissionRate) {
+		return types.ErrInvalidCommissionRate(rate)
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	if val.CommissionUpdatedAt+param.CommissionChangeIntervalSec > now {
+		return types.ErrCommissionUpdateTooFrequent()
+	}
+	// validator above the max rate, e.g. one migrated at 100%, can move into range at once.
+	if val.Commission().LTE(param.MaxCommissionRate) &&
+		rate.Sub(val.Commission()).Abs().GT(param.MaxCommissionChangeRate) {
+		return types.ErrCommissionChangeTooLarge(rate)
+	}
+	val.CommissionRate = rate
+	val.CommissionUpdatedAt = now
+	vm.storage.SetValidator(ctx, username, val)
+	return nil
+}
+
+// RotateValidatorKey - replace consensus key of validator, the new key takes effect
+// from the next block. Only one rotation can be in progress. Jailed validators and
+// validators that ever double signed can not rotate, as it would bypass the tombstone.
+func (vm ValidatorManager) RotateValidatorKey(ctx sdk.Context, username linotypes.AccountKey, valPubKey crypto.PubKey) sdk.Error {
+	if !vm.IsLegalValidator(ctx, username) {
+		return types.ErrInvalidValidator()
+	}
+	if linotypes.FindAccountInList(username, vm.storage.GetValidatorList(ctx).Jail) != -1 {
+		return types.ErrValidatorJailed(username)
+	}
+	if vm.hasTombstonedKey(ctx, username) {
+		return types.ErrValidatorPubKeyTombstoned()
+	}
+	if vm.storage.GetKeyRotation(ctx, username) != nil {
+		return types.ErrKeyRotationPending(username)
+	}
+	if err := vm.checkDupPubKey(ctx, valPubKey); err != nil {
+		return err
+	}
+	vm.storage.SetKeyRotation(ctx, username, &model.KeyRotation{
+		NewPubKey:   valPubKey,
+		RequestedAt: ctx.BlockHeight(),
+	})
+	return nil
+}
+
+// applyKeyRotations - switch validators to keys requested in previous blocks. Applied
+// rotations are kept until tendermint signs with the new key, so that commits and
+// evidences of the old key are still recognized.
+func (vm ValidatorManager) applyKeyRotations(ctx sdk.Context) sdk.Error {
+	type userRotation struct {
+		user     linotypes.AccountKey
+		rotation *model.KeyRotation
+	}
+	height := ctx.BlockHeight()
+	rotations := []userRotation{}
+	vm.storage.IterateKeyRotations(ctx, func(user linotypes.AccountKey, rotation *model.KeyRotation) bool {
+		rotations = append(rotations, userRotation{user: user, rotation: rotation})
+		return false
+	})
+	for _, r := range rotations {
+		if r.rotation.AppliedAt != 0 {
+			if height >= r.rotation.AppliedAt+validatorUpdateDelay {
+				vm.storage.DeleteKeyRotation(ctx, r.user)
+			}
+			continue
+		}
+		if r.rotation.RequestedAt >= height {
+			continue
+		}
+		validator, err := vm.storage.GetValidator(ctx, r.user)
+		if err != nil {
+			return err
+		}
+		r.rotation.OldPubKey = validator.PubKey
+		r.rotation.AppliedAt = height
+		validator.PubKey = r.rotation.NewPubKey
+		validator.ABCIValidator.Address = r.rotation.NewPubKey.Address()
+		vm.storage.SetValidator(ctx, r.user, validator)
+		vm.storage.SetKeyRotation(ctx, r.user, r.rotation)
+	}
+	return nil
+}
+
+// ClaimValidatorReward - move all unclaimed validator reward of voter to its saving.
+func (vm ValidatorManager) ClaimValidatorReward(ctx sdk.Context, username linotypes.AccountKey) sdk.Error {
+	vm.settleVoterReward(ctx, username)
+	reward := vm.storage.GetVoterReward(ctx, username)
+	if !reward.Unclaimed.IsPositive() {
+		return types.ErrNoValidatorReward(username)
+	}
+	err := vm.acc.MoveFromPool(ctx, linotypes.InflationValidatorPool,
+		linotypes.NewAccOrAddrFromAcc(username), reward.Unclaimed)
+	if err != nil {
+		return err
+	}
+	vm.storage.SetUnclaimedReward(ctx, vm.storage.GetUnclaimedReward(ctx).Minus(reward.Unclaimed))
+	reward.Claimed = reward.Claimed.Plus(reward.Unclaimed)
+	reward.Unclaimed = linotypes.NewCoinFromInt64(0)
+	vm.storage.SetVoterReward(ctx, username, reward)
+	return nil
+}
+
 // RegisterValidator - register a validator.
 func (vm ValidatorManager) RegisterValidator(ctx sdk.Context, username linotypes.AccountKey, valPubKey crypto.PubKey, link string) sdk.Error {
 	lst := vm.storage.GetValidatorList(ctx)
@@ -113,17 +234,21 @@ func (vm ValidatorManager) RegisterValidator(ctx sdk.Context, username linotypes
 		return err
 	}
 
-	// recover data if was revoked: inherite the votes.
+	// recover data if was revoked: inherite the votes and the reward index,
+	// which voters of the revoked validator have settled against.
 	prevVotes := vm.getPrevVotes(ctx, username)
+	rewardIndex := vm.getRewardIndex(ctx, username)
 	validator := &model.Validator{
 		ABCIValidator: abci.Validator{
 			Address: valPubKey.Address(),
 			Power:   0,
 		},
-		ReceivedVotes: prevVotes,
-		PubKey:        valPubKey,
-		Username:      username,
-		Link:          link,
+		ReceivedVotes:  prevVotes,
+		PubKey:         valPubKey,
+		Username:       username,
+		Link:           link,
+		CommissionRate: sdk.ZeroDec(),
+		RewardPerVote:  rewardIndex,
 	}
 	vm.storage.SetValidator(ctx, username, validator)
 
@@ -153,6 +278,10 @@ func (vm ValidatorManager) RevokeValidator(ctx sdk.Context, username linotypes.A
 
 	me.HasRevoked = true
 	vm.storage.SetValidator(ctx, username, me)
+	// a rotation not applied yet is dropped, re-register with the new key instead.
+	if rotation := vm.storage.GetKeyRotation(ctx, username); rotation != nil && rotation.AppliedAt == 0 {
+		vm.storage.DeleteKeyRotation(ctx, username)
+	}
 
 	if err := vm.removeValidatorFromAllLists(ctx, username); err != nil {
 		return err
@@ -162,7 +291,7 @@ func (vm ValidatorManager) RevokeValidator(ctx sdk.Context, username linotypes.A
 	}
 
 	param := vm.paramHolder.GetValidatorParam(ctx)
-	if err = vm.vote.UnassignDuty(ctx, username, param.ValidatorRevokePendingSec); err != nil {
+	if err = vm.vote.UnassignDuty(ctx, username, votetypes.DutyValidator, param.ValidatorRevokePendingSec); err != nil {
 		return err
 	}
 
@@ -201,11 +330,20 @@ func (vm ValidatorManager) VoteValidator(ctx sdk.Context, username linotypes.Acc
 	return nil
 }
 
+// DistributeInflationToValidator - distribute validator inflation to oncall and standby
+// validators according to their weights. Each validator keeps its commission, the rest
+// is added to its reward index, from which voters settle their reward lazily pro rata
+// by election votes. Unsettled and unclaimed voter rewards stay in the validator inflation pool.
 func (vm ValidatorManager) DistributeInflationToValidator(ctx sdk.Context) sdk.Error {
-	coin, err := vm.acc.GetPool(ctx, linotypes.InflationValidatorPool)
+	pool, err := vm.acc.GetPool(ctx, linotypes.InflationValidatorPool)
 	if err != nil {
 		return err
 	}
+	unclaimed := vm.storage.GetUnclaimedReward(ctx)
+	coin := pool.Minus(unclaimed)
+	if !coin.IsPositive() {
+		return nil
+	}
 	param := vm.paramHolder.GetValidatorParam(ctx)
 	lst := vm.storage.GetValidatorList(ctx)
 	totalWeight := int64(len(lst.Oncall))*param.OncallInflationWeight +
@@ -214,31 +352,126 @@ func (vm ValidatorManager) DistributeInflationToValidator(ctx sdk.Context) sdk.E
 	// give inflation to each validator according it's weight
 	for _, oncall := range lst.Oncall {
 		ratPerOncall := coin.ToDec().Mul(sdk.NewDec(param.OncallInflationWeight)).Quo(sdk.NewDec(totalWeight - index))
-		err := vm.acc.MoveFromPool(ctx, linotypes.InflationValidatorPool,
-			linotypes.NewAccOrAddrFromAcc(oncall),
-			linotypes.DecToCoin(ratPerOncall))
+		share, err := vm.payCommission(ctx, oncall, linotypes.DecToCoin(ratPerOncall))
 		if err != nil {
 			return err
 		}
+		unclaimed = unclaimed.Plus(share)
 		coin = coin.Minus(linotypes.DecToCoin(ratPerOncall))
 		index += param.OncallInflationWeight
 	}
 
 	for _, standby := range lst.Standby {
 		ratPerStandby := coin.ToDec().Mul(sdk.NewDec(param.StandbyInflationWeight)).Quo(sdk.NewDec(totalWeight - index))
-		err := vm.acc.MoveFromPool(ctx, linotypes.InflationValidatorPool,
-			linotypes.NewAccOrAddrFromAcc(standby),
-			linotypes.DecToCoin(ratPerStandby))
+		share, err := vm.payCommission(ctx, standby, linotypes.DecToCoin(ratPerStandby))
 		if err != nil {
 			return err
 		}
+		unclaimed = unclaimed.Plus(share)
 		coin = coin.Minus(linotypes.DecToCoin(ratPerStandby))
 		index += param.StandbyInflationWeight
 	}
+	vm.storage.SetUnclaimedReward(ctx, unclaimed)
 	return nil
 }
 
+// payCommission - pay commission of inflation to validator and add the rest to its reward
+// index, returns the voters' share. If validator has no votes, all inflation goes to validator.
+func (vm ValidatorManager) payCommission(ctx sdk.Context, username linotypes.AccountKey, inflation linotypes.Coin) (linotypes.Coin, sdk.Error) {
+	val, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return linotypes.NewCoinFromInt64(0), err
+	}
+	commission := linotypes.DecToCoin(inflation.ToDec().Mul(val.Commission()))
+	if !val.ReceivedVotes.IsPositive() {
+		commission = inflation
+	}
+	if commission.IsPositive() {
+		if err := vm.acc.MoveFromPool(ctx, linotypes.InflationValidatorPool,
+			linotypes.NewAccOrAddrFromAcc(username), commission); err != nil {
+			return linotypes.NewCoinFromInt64(0), err
+		}
+	}
+	vm.addLedgerEntry(ctx, username, model.LedgerEntry{
+		Type:       types.LedgerEntryInflation,
+		Amount:     inflation,
+		Commission: commission,
+	})
+	share := inflation.Minus(commission)
+	if !share.IsPositive() {
+		return linotypes.NewCoinFromInt64(0), nil
+	}
+	val.RewardPerVote = val.RewardIndex().Add(share.ToDec().Quo(val.ReceivedVotes.ToDec()))
+	vm.storage.SetValidator(ctx, username, val)
+	return share, nil
+}
+
+// accrueVoterReward - returns reward of election votes since they were last settled and
+// moves their reward indexes to the current ones of validators. Rounding dust is not
+// settled and stays in the validator inflation pool.
+func (vm ValidatorManager) accrueVoterReward(ctx sdk.Context, lst *model.ElectionVoteList) linotypes.Coin {
+	reward := linotypes.NewCoinFromInt64(0)
+	for i, vote := range lst.ElectionVotes {
+		index := vm.getRewardIndex(ctx, vote.ValidatorName)
+		growth := index
+		if !vote.RewardIndex.IsNil() {
+			growth = growth.Sub(vote.RewardIndex)
+		}
+		reward = reward.Plus(linotypes.DecToCoin(vote.Vote.ToDec().Mul(growth)))
+		lst.ElectionVotes[i].RewardIndex = index
+	}
+	return reward
+}
+
+// getRewardIndex - reward index of validator, revoked validator's record is still
+// in kv, zero for unknown ones.
+func (vm ValidatorManager) getRewardIndex(ctx sdk.Context, username linotypes.AccountKey) sdk.Dec {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+	return validator.RewardIndex()
+}
+
+// settleVoterReward - add reward of voter's election votes since they were last settled
+// to its unclaimed reward, must be called before the votes change.
+func (vm ValidatorManager) settleVoterReward(ctx sdk.Context, username linotypes.AccountKey) {
+	lst := vm.storage.GetElectionVoteList(ctx, username)
+	if len(lst.ElectionVotes) == 0 {
+		return
+	}
+	reward := vm.accrueVoterReward(ctx, lst)
+	vm.storage.SetElectionVoteList(ctx, username, lst)
+	if reward.IsPositive() {
+		voterReward := vm.storage.GetVoterReward(ctx, username)
+		voterReward.Unclaimed = voterReward.Unclaimed.Plus(reward)
+		vm.storage.SetVoterReward(ctx, username, voterReward)
+	}
+}
+
+// UnjailValidator - validator in jail rejoins as candidate.
+func (vm ValidatorManager) UnjailValidator(ctx sdk.Context, username linotypes.AccountKey) sdk.Error {
+	lst := vm.storage.GetValidatorList(ctx)
+	if linotypes.FindAccountInList(username, lst.Jail) == -1 {
+		return types.ErrValidatorNotJailed(username)
+	}
+	return vm.rejoinFromJail(ctx, username)
+}
+
+// rejoinFromJail - validator can leave jail after the jail duration and
+// if its stake is not less than min deposit.
 func (vm ValidatorManager) rejoinFromJail(ctx sdk.Context, username linotypes.AccountKey) sdk.Error {
+	me, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockHeader().Time.Unix() < me.JailRecord.UnjailAt {
+		return types.ErrUnjailTooEarly(me.JailRecord.UnjailAt)
+	}
+	if vm.storage.IsTombstoned(ctx, me.ABCIValidator.Address) || vm.hasTombstonedKey(ctx, username) {
+		return types.ErrValidatorPubKeyTombstoned()
+	}
+
 	param := vm.paramHolder.GetValidatorParam(ctx)
 	totalStake, err := vm.vote.GetLinoStake(ctx, username)
 	if err != nil {
@@ -249,6 +482,10 @@ func (vm ValidatorManager) rejoinFromJail(ctx sdk.Context, username linotypes.Ac
 		return types.ErrInsufficientDeposit()
 	}
 
+	me.JailRecord = model.JailRecord{}
+	vm.storage.SetValidator(ctx, username, me)
+	vm.addLedgerEntry(ctx, username, model.LedgerEntry{Type: types.LedgerEntryUnjail})
+
 	vm.removeValidatorFromJailList(ctx, username)
 	if err := vm.addValidatortToCandidateList(ctx, username); err != nil {
 		return err
@@ -259,6 +496,28 @@ func (vm ValidatorManager) rejoinFromJail(ctx sdk.Context, username linotypes.Ac
 	return nil
 }
 
+// SimulateVoteChanges - returns the validator list after applying changes of received
+// votes, computed on a cached context so that nothing is committed.
+func (vm ValidatorManager) SimulateVoteChanges(ctx sdk.Context, changes []model.ElectionVote) (*model.ValidatorList, sdk.Error) {
+	cachedCtx, _ := ctx.CacheContext()
+	updates := []*model.ElectionVote{}
+	for i := range changes {
+		validator, err := vm.storage.GetValidator(cachedCtx, changes[i].ValidatorName)
+		if err != nil {
+			return nil, err
+		}
+		if validator.ReceivedVotes.Plus(changes[i].Vote).IsNegative() {
+			return nil, types.ErrInvalidVoteChanges(
+				fmt.Sprintf("%s received votes become negative", changes[i].ValidatorName))
+		}
+		updates = append(updates, &changes[i])
+	}
+	if err := vm.updateValidatorReceivedVotes(cachedCtx, updates); err != nil {
+		return nil, err
+	}
+	return vm.storage.GetValidatorList(cachedCtx), nil
+}
+
 // calculate the changed votes between current election votes and previous election votes
 // negative number means the corresponding validator need to decrease it's received votes
 // positive number means the corresponding validator need to increase it's received votes
@@ -368,6 +627,7 @@ func (vm ValidatorManager) setNewElectionVoteList(ctx sdk.Context, username lino
 	if len(votedValidators) == 0 {
 		return nil
 	}
+	vm.settleVoterReward(ctx, username)
 	lst := &model.ElectionVoteList{}
 	totalStake, err := vm.vote.GetLinoStake(ctx, username)
 	if err != nil {
@@ -379,6 +639,7 @@ func (vm ValidatorManager) setNewElectionVoteList(ctx sdk.Context, username lino
 		electionVote := model.ElectionVote{
 			ValidatorName: validatorName,
 			Vote:          linotypes.DecToCoin(voteStakeDec),
+			RewardIndex:   vm.getRewardIndex(ctx, validatorName),
 		}
 		lst.ElectionVotes = append(lst.ElectionVotes, electionVote)
 	}
@@ -422,7 +683,23 @@ func (vm ValidatorManager) GetValidatorUpdates(ctx sdk.Context) ([]abci.Validato
 	committingValidators := vm.GetCommittingValidators(ctx)
 	committingSet := linotypes.AccountListToSet(committingValidators)
 
+	// keys rotated in this block are replaced by the current key.
+	rotatedKeys := make(map[linotypes.AccountKey]crypto.PubKey)
+	vm.storage.IterateKeyRotations(ctx, func(user linotypes.AccountKey, rotation *model.KeyRotation) bool {
+		if rotation.AppliedAt == ctx.BlockHeight() {
+			rotatedKeys[user] = rotation.OldPubKey
+		}
+		return false
+	})
+
 	for _, preValidator := range validatorList.PreBlockValidators {
+		if oldKey, ok := rotatedKeys[preValidator]; ok {
+			updates = append(updates, abci.ValidatorUpdate{
+				PubKey: tmtypes.TM2PB.PubKey(oldKey),
+				Power:  0,
+			})
+			continue
+		}
 		// set power to 0 if a previous validator not in oncall and standby list anymore
 		if committingSet[preValidator] == false {
 			validator, err := vm.storage.GetValidator(ctx, preValidator)
@@ -460,20 +737,30 @@ func (vm ValidatorManager) updateSigningStats(ctx sdk.Context, voteInfos []abci.
 	}
 
 	// go through oncall and standby validator list to get all address and name mapping
+	param := vm.paramHolder.GetValidatorParam(ctx)
 	committingValidators := vm.GetCommittingValidators(ctx)
 	for _, curValidator := range committingValidators {
 		validator, err := vm.storage.GetValidator(ctx, curValidator)
 		if err != nil {
 			return err
 		}
-		signed, exist := addressSigned[string(validator.ABCIValidator.Address)]
-		if !exist || !signed {
-			validator.AbsentCommit++
-		} else {
+		signed := addressSigned[string(validator.ABCIValidator.Address)]
+		if rotation := vm.storage.GetKeyRotation(ctx, curValidator); !signed && rotation != nil && rotation.OldPubKey != nil {
+			// tendermint signs with the old key until the rotation takes effect.
+			signed = addressSigned[string(rotation.OldPubKey.Address())]
+		}
+		window := vm.storage.GetSigningWindow(ctx, curValidator)
+		if window == nil || window.Size != param.SignedBlocksWindow {
+			// restart the window if the window size param changed.
+			window = model.NewSigningWindow(param.SignedBlocksWindow)
+		}
+		window.Record(signed)
+		vm.storage.SetSigningWindow(ctx, curValidator, window)
+
+		// absent commit is the missed blocks in window.
+		validator.AbsentCommit = window.Missed
+		if signed {
 			validator.ProducedBlocks++
-			if validator.AbsentCommit > 0 {
-				validator.AbsentCommit--
-			}
 		}
 		vm.storage.SetValidator(ctx, curValidator, validator)
 	}
@@ -482,48 +769,65 @@ func (vm ValidatorManager) updateSigningStats(ctx sdk.Context, voteInfos []abci.
 }
 
 // PunishOncallValidator - punish committing validator
-// if 1) byzantine or 2) missing blocks reach limiation
+// if 1) byzantine or 2) missing blocks reach limiation, returns the slashed coins,
+// which can be less than penalty if validator's stake is not enough.
 func (vm ValidatorManager) PunishCommittingValidator(ctx sdk.Context, username linotypes.AccountKey,
-	penalty linotypes.Coin, punishType linotypes.PunishType) sdk.Error {
+	penalty linotypes.Coin, punishType linotypes.PunishType) (linotypes.Coin, sdk.Error) {
 	// slash and add slashed coin back into validator inflation pool
-	_, err := vm.vote.SlashStake(ctx, username, penalty, linotypes.InflationValidatorPool)
+	slashed, err := vm.vote.SlashStake(ctx, username, penalty, linotypes.InflationValidatorPool)
 	if err != nil {
-		return err
+		return linotypes.NewCoinFromInt64(0), err
 	}
+	vm.addLedgerEntry(ctx, username, model.LedgerEntry{
+		Type:       types.LedgerEntryPenalty,
+		Amount:     slashed,
+		PunishType: punishType,
+	})
 	validator, err := vm.storage.GetValidator(ctx, username)
 	if err != nil {
-		return err
+		return linotypes.NewCoinFromInt64(0), err
 	}
 	validator.NumSlash++
 	// reset absent commit
 	if punishType == linotypes.PunishAbsentCommit {
 		validator.AbsentCommit = 0
+		vm.storage.DeleteSigningWindow(ctx, username)
 	}
 	vm.storage.SetValidator(ctx, username, validator)
 
 	totalStake, err := vm.vote.GetLinoStake(ctx, username)
 	if err != nil {
-		return err
+		return linotypes.NewCoinFromInt64(0), err
 	}
 	// remove this validator and put into jail if its remaining stake is not enough
 	// OR, this is byzantine validator
+	// OR, its missed blocks in the signing window exceed the limit
 	// OR, the num of slash exceeds limit
 	param := vm.paramHolder.GetValidatorParam(ctx)
-	if punishType == linotypes.PunishByzantine ||
-		!totalStake.IsGTE(param.ValidatorMinDeposit) ||
-		validator.NumSlash > param.SlashLimitation {
+	reason := types.JailReasonNone
+	switch {
+	case punishType == linotypes.PunishByzantine:
+		reason = types.JailReasonByzantine
+	case punishType == linotypes.PunishAbsentCommit:
+		reason = types.JailReasonMissedCommits
+	case !totalStake.IsGTE(param.ValidatorMinDeposit):
+		reason = types.JailReasonInsufficientDeposit
+	case validator.NumSlash > param.SlashLimitation:
+		reason = types.JailReasonSlashLimit
+	}
+	if reason != types.JailReasonNone {
 		if err := vm.removeValidatorFromAllLists(ctx, username); err != nil {
-			return err
+			return linotypes.NewCoinFromInt64(0), err
 		}
-		if err := vm.addValidatortToJailList(ctx, username); err != nil {
-			return err
+		if err := vm.addValidatortToJailList(ctx, username, reason); err != nil {
+			return linotypes.NewCoinFromInt64(0), err
 		}
 		if err := vm.balanceValidatorList(ctx); err != nil {
-			return err
+			return linotypes.NewCoinFromInt64(0), err
 		}
 	}
 
-	return nil
+	return slashed, nil
 }
 
 // FireIncompetentValidator - fire oncall validator if
@@ -531,27 +835,22 @@ func (vm ValidatorManager) PunishCommittingValidator(ctx sdk.Context, username l
 // 2. byzantine
 func (vm ValidatorManager) fireIncompetentValidator(ctx sdk.Context,
 	byzantineValidators []abci.Evidence) sdk.Error {
+	for _, evidence := range byzantineValidators {
+		if err := vm.handleByzantineEvidence(ctx, evidence); err != nil {
+			return err
+		}
+	}
+
 	param := vm.paramHolder.GetValidatorParam(ctx)
 	committingValidators := vm.GetCommittingValidators(ctx)
-
 	for _, validatorName := range committingValidators {
 		validator, err := vm.storage.GetValidator(ctx, validatorName)
 		if err != nil {
 			return err
 		}
 
-		for _, evidence := range byzantineValidators {
-			if reflect.DeepEqual(validator.ABCIValidator.Address, evidence.Validator.Address) {
-				if err := vm.PunishCommittingValidator(ctx, validator.Username, param.PenaltyByzantine,
-					linotypes.PunishByzantine); err != nil {
-					return err
-				}
-				break
-			}
-		}
-
 		if validator.AbsentCommit > param.AbsentCommitLimitation {
-			if err := vm.PunishCommittingValidator(ctx, validator.Username, param.PenaltyMissCommit,
+			if _, err := vm.PunishCommittingValidator(ctx, validator.Username, param.PenaltyMissCommit,
 				linotypes.PunishAbsentCommit); err != nil {
 				return err
 			}
@@ -561,9 +860,155 @@ func (vm ValidatorManager) fireIncompetentValidator(ctx sdk.Context,
 	return nil
 }
 
+// handleByzantineEvidence - slash a fraction of byzantine validator's stake and of
+// its voters' votes, then tombstone the consensus key and record the evidence.
+// Evidence of unknown or tombstoned key, or of a handled height, is ignored.
+func (vm ValidatorManager) handleByzantineEvidence(ctx sdk.Context, evidence abci.Evidence) sdk.Error {
+	addr := evidence.Validator.Address
+	if vm.storage.IsTombstoned(ctx, addr) {
+		return nil
+	}
+	validator := vm.getValidatorByAddress(ctx, addr)
+	if validator == nil {
+		return nil
+	}
+	history := vm.storage.GetEvidenceHistory(ctx, validator.Username)
+	for _, e := range history.Evidences {
+		if e.Height == evidence.Height {
+			return nil
+		}
+	}
+
+	param := vm.paramHolder.GetValidatorParam(ctx)
+	totalStake, err := vm.vote.GetLinoStake(ctx, validator.Username)
+	if err != nil {
+		return err
+	}
+	penalty := linotypes.DecToCoin(totalStake.ToDec().Mul(param.ByzantineSlashFraction))
+	if !penalty.IsGTE(param.PenaltyByzantine) {
+		penalty = param.PenaltyByzantine
+	}
+	// only committing validators are jailed, the others are still slashed.
+	var slashed linotypes.Coin
+	if linotypes.FindAccountInList(validator.Username, vm.GetCommittingValidators(ctx)) != -1 {
+		slashed, err = vm.PunishCommittingValidator(
+			ctx, validator.Username, penalty, linotypes.PunishByzantine)
+		if err != nil {
+			return err
+		}
+	} else {
+		slashed, err = vm.vote.SlashStake(ctx, validator.Username, penalty, linotypes.InflationValidatorPool)
+		if err != nil {
+			return err
+		}
+		vm.addLedgerEntry(ctx, validator.Username, model.LedgerEntry{
+			Type:       types.LedgerEntryPenalty,
+			Amount:     slashed,
+			PunishType: linotypes.PunishByzantine,
+		})
+	}
+
+	voterSlashed, err := vm.slashVoters(ctx, validator.Username, param.ByzantineSlashFraction)
+	if err != nil {
+		return err
+	}
+
+	vm.storage.SetTombstone(ctx, addr, &model.Tombstone{
+		Username: validator.Username,
+		Height:   evidence.Height,
+	})
+	history.Evidences = append(history.Evidences, model.Evidence{
+		Height:       evidence.Height,
+		Time:         evidence.Time.Unix(),
+		Address:      addr,
+		Slashed:      slashed,
+		VoterSlashed: voterSlashed,
+	})
+	vm.storage.SetEvidenceHistory(ctx, validator.Username, history)
+	return nil
+}
+
+// slashVoters - slash fraction of votes from voters of validator, validator itself excluded.
+func (vm ValidatorManager) slashVoters(ctx sdk.Context, username linotypes.AccountKey, fraction sdk.Dec) (linotypes.Coin, sdk.Error) {
+	type voterPenalty struct {
+		voter   linotypes.AccountKey
+		penalty linotypes.Coin
+	}
+	// slashing changes election votes, so collect penalties before slashing.
+	penalties := []voterPenalty{}
+	vm.storage.IterateElectionVoteLists(ctx, func(user linotypes.AccountKey, votes *model.ElectionVoteList) bool {
+		if user == username {
+			return false
+		}
+		for _, vote := range votes.ElectionVotes {
+			if vote.ValidatorName == username {
+				penalty := linotypes.DecToCoin(vote.Vote.ToDec().Mul(fraction))
+				if penalty.IsPositive() {
+					penalties = append(penalties, voterPenalty{voter: user, penalty: penalty})
+				}
+				break
+			}
+		}
+		return false
+	})
+
+	total := linotypes.NewCoinFromInt64(0)
+	for _, p := range penalties {
+		slashed, err := vm.vote.SlashStake(ctx, p.voter, p.penalty, linotypes.InflationValidatorPool)
+		if err != nil {
+			return total, err
+		}
+		total = total.Plus(slashed)
+	}
+	return total, nil
+}
+
+// getValidatorByAddress - returns validator in validator list with consensus address,
+// or the validator that just rotated away from it, nil if not found.
+func (vm ValidatorManager) getValidatorByAddress(ctx sdk.Context, addr []byte) *model.Validator {
+	lst := vm.GetValidatorList(ctx)
+	for _, name := range append(vm.GetAllValidators(ctx), lst.Jail...) {
+		validator, err := vm.storage.GetValidator(ctx, name)
+		if err != nil {
+			continue
+		}
+		if reflect.DeepEqual(validator.ABCIValidator.Address, addr) {
+			return validator
+		}
+	}
+	var rst *model.Validator
+	vm.storage.IterateKeyRotations(ctx, func(user linotypes.AccountKey, rotation *model.KeyRotation) bool {
+		if rotation.OldPubKey == nil || !reflect.DeepEqual(rotation.OldPubKey.Address().Bytes(), addr) {
+			return false
+		}
+		validator, err := vm.storage.GetValidator(ctx, user)
+		if err == nil {
+			rst = validator
+		}
+		return true
+	})
+	return rst
+}
+
+// hasTombstonedKey - returns if any consensus key validator ever used is tombstoned,
+// every handled evidence tombstones the key that double signed.
+func (vm ValidatorManager) hasTombstonedKey(ctx sdk.Context, username linotypes.AccountKey) bool {
+	for _, e := range vm.storage.GetEvidenceHistory(ctx, username).Evidences {
+		if vm.storage.IsTombstoned(ctx, e.Address) {
+			return true
+		}
+	}
+	return false
+}
+
 func (vm ValidatorManager) checkDupPubKey(ctx sdk.Context, pubKey crypto.PubKey) sdk.Error {
+	if vm.storage.IsTombstoned(ctx, pubKey.Address()) {
+		return types.ErrValidatorPubKeyTombstoned()
+	}
+
 	// make sure the pub key has not been registered
-	allValidators := vm.GetAllValidators(ctx)
+	lst := vm.GetValidatorList(ctx)
+	allValidators := append(vm.GetAllValidators(ctx), lst.Jail...)
 	for _, validatorName := range allValidators {
 		validator, err := vm.storage.GetValidator(ctx, validatorName)
 		if err != nil {
@@ -574,6 +1019,16 @@ func (vm ValidatorManager) checkDupPubKey(ctx sdk.Context, pubKey crypto.PubKey)
 		}
 	}
 
+	// nor is it being rotated to or from.
+	dup := false
+	vm.storage.IterateKeyRotations(ctx, func(user linotypes.AccountKey, rotation *model.KeyRotation) bool {
+		dup = rotation.NewPubKey.Equals(pubKey) ||
+			(rotation.OldPubKey != nil && rotation.OldPubKey.Equals(pubKey))
+		return dup
+	})
+	if dup {
+		return types.ErrValidatorPubKeyAlreadyExist()
+	}
 	return nil
 }
 
@@ -904,7 +1359,7 @@ func (vm ValidatorManager) addValidatortToCandidateList(ctx sdk.Context, usernam
 	return nil
 }
 
-func (vm ValidatorManager) addValidatortToJailList(ctx sdk.Context, username linotypes.AccountKey) sdk.Error {
+func (vm ValidatorManager) addValidatortToJailList(ctx sdk.Context, username linotypes.AccountKey, reason types.JailReason) sdk.Error {
 	lst := vm.storage.GetValidatorList(ctx)
 	lst.Jail = append(lst.Jail, username)
 	me, err := vm.storage.GetValidator(ctx, username)
@@ -916,11 +1371,43 @@ func (vm ValidatorManager) addValidatortToJailList(ctx sdk.Context, username lin
 	me.ABCIValidator.Power = 0
 	me.AbsentCommit = 0
 	me.NumSlash = 0
+	vm.storage.DeleteSigningWindow(ctx, username)
+
+	// validator with insufficient deposit can unjail once deposit is enough.
+	param := vm.paramHolder.GetValidatorParam(ctx)
+	now := ctx.BlockHeader().Time.Unix()
+	me.JailRecord = model.JailRecord{
+		Reason:   reason,
+		JailedAt: now,
+		UnjailAt: now,
+	}
+	switch reason {
+	case types.JailReasonByzantine:
+		me.JailRecord.UnjailAt = now + param.ByzantineJailDurationSec
+	case types.JailReasonMissedCommits, types.JailReasonSlashLimit:
+		me.JailRecord.UnjailAt = now + param.JailDurationSec
+	}
 	vm.storage.SetValidator(ctx, username, me)
 	vm.storage.SetValidatorList(ctx, lst)
+	vm.addLedgerEntry(ctx, username, model.LedgerEntry{
+		Type:       types.LedgerEntryJail,
+		JailReason: reason,
+	})
 	return nil
 }
 
+// addLedgerEntry - add entry to ledger of validator at block time, unset coins are zero.
+func (vm ValidatorManager) addLedgerEntry(ctx sdk.Context, username linotypes.AccountKey, entry model.LedgerEntry) {
+	entry.Time = ctx.BlockHeader().Time.Unix()
+	if entry.Amount == (linotypes.Coin{}) {
+		entry.Amount = linotypes.NewCoinFromInt64(0)
+	}
+	if entry.Commission == (linotypes.Coin{}) {
+		entry.Commission = linotypes.NewCoinFromInt64(0)
+	}
+	vm.storage.AddLedgerEntry(ctx, username, entry)
+}
+
 func removeFromList(me linotypes.AccountKey, users []linotypes.AccountKey) []linotypes.AccountKey {
 	for i := 0; i < len(users); i++ {
 		if me == users[i] {
@@ -1058,6 +1545,55 @@ func (vm ValidatorManager) GetValidator(ctx sdk.Context, accKey linotypes.Accoun
 	return vm.storage.GetValidator(ctx, accKey)
 }
 
+// GetEvidenceHistory - returns handled byzantine evidences of validator.
+func (vm ValidatorManager) GetEvidenceHistory(ctx sdk.Context, username linotypes.AccountKey) *model.EvidenceHistory {
+	return vm.storage.GetEvidenceHistory(ctx, username)
+}
+
+// GetSigningInfo - returns recent signing status of validator.
+func (vm ValidatorManager) GetSigningInfo(ctx sdk.Context, username linotypes.AccountKey) (*model.SigningInfo, sdk.Error) {
+	if _, err := vm.storage.GetValidator(ctx, username); err != nil {
+		return nil, err
+	}
+	window := vm.storage.GetSigningWindow(ctx, username)
+	if window == nil {
+		window = model.NewSigningWindow(0)
+	}
+	info := model.NewSigningInfo(username, *window)
+	return &info, nil
+}
+
+// GetCommittingSigningInfos - returns recent signing status of all committing validators.
+func (vm ValidatorManager) GetCommittingSigningInfos(ctx sdk.Context) ([]model.SigningInfo, sdk.Error) {
+	infos := []model.SigningInfo{}
+	for _, name := range vm.GetCommittingValidators(ctx) {
+		info, err := vm.GetSigningInfo(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, *info)
+	}
+	return infos, nil
+}
+
+// GetLedger - returns ledger entries of validator from start to end time, both inclusive.
+func (vm ValidatorManager) GetLedger(ctx sdk.Context, username linotypes.AccountKey, start, end int64) []model.LedgerEntry {
+	return vm.storage.GetLedgerEntries(ctx, username, start, end)
+}
+
+// GetKeyRotation - returns key rotation of validator in progress, nil if none.
+func (vm ValidatorManager) GetKeyRotation(ctx sdk.Context, username linotypes.AccountKey) *model.KeyRotation {
+	return vm.storage.GetKeyRotation(ctx, username)
+}
+
+// GetVoterReward - returns validator reward shared to voter, including reward not settled yet.
+func (vm ValidatorManager) GetVoterReward(ctx sdk.Context, username linotypes.AccountKey) *model.VoterReward {
+	reward := vm.storage.GetVoterReward(ctx, username)
+	pending := vm.accrueVoterReward(ctx, vm.storage.GetElectionVoteList(ctx, username))
+	reward.Unclaimed = reward.Unclaimed.Plus(pending)
+	return reward
+}
+
 func (vm ValidatorManager) GetAllValidators(ctx sdk.Context) []linotypes.AccountKey {
 	lst := vm.GetValidatorList(ctx)
 	tmp := append(lst.Standby, lst.Candidates...)
@@ -1121,13 +1657,21 @@ func (vm ValidatorManager) ExportToFile(ctx sdk.Context, cdc *codec.Codec, filep
 				Address: validator.ABCIValidator.Address,
 				Power:   validator.ABCIValidator.Power,
 			},
-			PubKey:         model.NewABCIPubKeyIRFromTM(validator.PubKey),
-			Username:       validator.Username,
-			ReceivedVotes:  validator.ReceivedVotes,
-			HasRevoked:     validator.HasRevoked,
-			AbsentCommit:   validator.AbsentCommit,
-			ProducedBlocks: validator.ProducedBlocks,
-			Link:           validator.Link,
+			PubKey:              model.NewABCIPubKeyIRFromTM(validator.PubKey),
+			Username:            validator.Username,
+			ReceivedVotes:       validator.ReceivedVotes,
+			HasRevoked:          validator.HasRevoked,
+			AbsentCommit:        validator.AbsentCommit,
+			ProducedBlocks:      validator.ProducedBlocks,
+			Link:                validator.Link,
+			CommissionRate:      validator.Commission(),
+			CommissionUpdatedAt: validator.CommissionUpdatedAt,
+			JailRecord: model.JailRecordIR{
+				Reason:   int(validator.JailRecord.Reason),
+				JailedAt: validator.JailRecord.JailedAt,
+				UnjailAt: validator.JailRecord.UnjailAt,
+			},
+			RewardPerVote: validator.RewardIndex(),
 		})
 		return false
 	})
@@ -1154,6 +1698,91 @@ func (vm ValidatorManager) ExportToFile(ctx sdk.Context, cdc *codec.Codec, filep
 		return false
 	})
 
+	// export voter rewards.
+	substores[string(model.VoterRewardSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		reward := val.(*model.VoterReward)
+		state.Rewards = append(state.Rewards, model.VoterRewardIR{
+			Username:  linotypes.AccountKey(key),
+			Unclaimed: reward.Unclaimed,
+			Claimed:   reward.Claimed,
+		})
+		return false
+	})
+	state.UnclaimedReward = vm.storage.GetUnclaimedReward(ctx)
+
+	// export evidences.
+	substores[string(model.EvidenceSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		history := val.(*model.EvidenceHistory)
+		evidences := make([]model.EvidenceIR, 0)
+		for _, e := range history.Evidences {
+			evidences = append(evidences, model.EvidenceIR(e))
+		}
+		state.Evidences = append(state.Evidences, model.EvidenceHistoryIR{
+			Username:  linotypes.AccountKey(key),
+			Evidences: evidences,
+		})
+		return false
+	})
+
+	// export tombstones.
+	substores[string(model.TombstoneSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		tombstone := val.(*model.Tombstone)
+		state.Tombstones = append(state.Tombstones, model.TombstoneIR{
+			Address:  key,
+			Username: tombstone.Username,
+			Height:   tombstone.Height,
+		})
+		return false
+	})
+
+	// export key rotations.
+	substores[string(model.KeyRotationSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		rotation := val.(*model.KeyRotation)
+		rotationIR := model.KeyRotationIR{
+			Username:    linotypes.AccountKey(key),
+			NewPubKey:   model.NewABCIPubKeyIRFromTM(rotation.NewPubKey),
+			RequestedAt: rotation.RequestedAt,
+			AppliedAt:   rotation.AppliedAt,
+		}
+		if rotation.OldPubKey != nil {
+			oldKey := model.NewABCIPubKeyIRFromTM(rotation.OldPubKey)
+			rotationIR.OldPubKey = &oldKey
+		}
+		state.KeyRotations = append(state.KeyRotations, rotationIR)
+		return false
+	})
+
+	// export signing windows.
+	substores[string(model.SigningWindowSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		window := val.(*model.SigningWindow)
+		state.SigningWindows = append(state.SigningWindows, model.SigningWindowIR{
+			Username: linotypes.AccountKey(key),
+			Bitmap:   window.Bitmap,
+			Size:     window.Size,
+			Index:    window.Index,
+			Counted:  window.Counted,
+			Missed:   window.Missed,
+		})
+		return false
+	})
+
+	// export ledgers.
+	substores[string(model.LedgerSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		user, _ := model.ParseLedgerKey(key)
+		for _, entry := range val.(*model.LedgerEntries).Entries {
+			state.Ledgers = append(state.Ledgers, model.LedgerEntryIR{
+				Username:   user,
+				Time:       entry.Time,
+				Type:       int(entry.Type),
+				Amount:     entry.Amount,
+				Commission: entry.Commission,
+				PunishType: int(entry.PunishType),
+				JailReason: int(entry.JailReason),
+			})
+		}
+		return false
+	})
+
 	return utils.Save(filepath, cdc, state)
 }
 
@@ -1165,9 +1794,16 @@ func (vs ValidatorManager) ImportFromFile(ctx sdk.Context, cdc *codec.Codec, fil
 	}
 	table := rst.(*model.ValidatorTablesIR)
 
-	if table.Version != importVersion {
+	if table.Version != importVersion && table.Version != preCommissionVersion {
 		return fmt.Errorf("unsupported import version: %d", table.Version)
 	}
+	if table.Version == preCommissionVersion {
+		// other tables are added after version 1, which are empty.
+		table.UnclaimedReward = linotypes.NewCoinFromInt64(0)
+		for i := range table.Validators {
+			table.Validators[i].CommissionRate = sdk.OneDec()
+		}
+	}
 
 	// import validators.
 	for _, val := range table.Validators {
@@ -1176,13 +1812,21 @@ func (vs ValidatorManager) ImportFromFile(ctx sdk.Context, cdc *codec.Codec, fil
 				Address: val.ABCIValidator.Address,
 				Power:   val.ABCIValidator.Power,
 			},
-			PubKey:         val.PubKey.ToTM(),
-			Username:       val.Username,
-			ReceivedVotes:  val.ReceivedVotes,
-			HasRevoked:     val.HasRevoked,
-			AbsentCommit:   val.AbsentCommit,
-			ProducedBlocks: val.ProducedBlocks,
-			Link:           val.Link,
+			PubKey:              val.PubKey.ToTM(),
+			Username:            val.Username,
+			ReceivedVotes:       val.ReceivedVotes,
+			HasRevoked:          val.HasRevoked,
+			AbsentCommit:        val.AbsentCommit,
+			ProducedBlocks:      val.ProducedBlocks,
+			Link:                val.Link,
+			CommissionRate:      val.CommissionRate,
+			CommissionUpdatedAt: val.CommissionUpdatedAt,
+			JailRecord: model.JailRecord{
+				Reason:   types.JailReason(val.JailRecord.Reason),
+				JailedAt: val.JailRecord.JailedAt,
+				UnjailAt: val.JailRecord.UnjailAt,
+			},
+			RewardPerVote: val.RewardPerVote,
 		})
 	}
 
@@ -1200,5 +1844,69 @@ func (vs ValidatorManager) ImportFromFile(ctx sdk.Context, cdc *codec.Codec, fil
 	// import validator list
 	validatorList := model.ValidatorList(table.List)
 	vs.storage.SetValidatorList(ctx, &validatorList)
+
+	// import voter rewards.
+	for _, reward := range table.Rewards {
+		vs.storage.SetVoterReward(ctx, reward.Username, &model.VoterReward{
+			Unclaimed: reward.Unclaimed,
+			Claimed:   reward.Claimed,
+		})
+	}
+	vs.storage.SetUnclaimedReward(ctx, table.UnclaimedReward)
+
+	// import evidences.
+	for _, history := range table.Evidences {
+		evidences := make([]model.Evidence, 0)
+		for _, e := range history.Evidences {
+			evidences = append(evidences, model.Evidence(e))
+		}
+		vs.storage.SetEvidenceHistory(ctx, history.Username, &model.EvidenceHistory{
+			Evidences: evidences,
+		})
+	}
+
+	// import tombstones.
+	for _, tombstone := range table.Tombstones {
+		vs.storage.SetTombstone(ctx, tombstone.Address, &model.Tombstone{
+			Username: tombstone.Username,
+			Height:   tombstone.Height,
+		})
+	}
+
+	// import key rotations.
+	for _, rotation := range table.KeyRotations {
+		keyRotation := &model.KeyRotation{
+			NewPubKey:   rotation.NewPubKey.ToTM(),
+			RequestedAt: rotation.RequestedAt,
+			AppliedAt:   rotation.AppliedAt,
+		}
+		if rotation.OldPubKey != nil {
+			keyRotation.OldPubKey = rotation.OldPubKey.ToTM()
+		}
+		vs.storage.SetKeyRotation(ctx, rotation.Username, keyRotation)
+	}
+
+	// import signing windows.
+	for _, window := range table.SigningWindows {
+		vs.storage.SetSigningWindow(ctx, window.Username, &model.SigningWindow{
+			Bitmap:  window.Bitmap,
+			Size:    window.Size,
+			Index:   window.Index,
+			Counted: window.Counted,
+			Missed:  window.Missed,
+		})
+	}
+
+	// import ledgers.
+	for _, entry := range table.Ledgers {
+		vs.storage.AddLedgerEntry(ctx, entry.Username, model.LedgerEntry{
+			Time:       entry.Time,
+			Type:       types.LedgerEntryType(entry.Type),
+			Amount:     entry.Amount,
+			Commission: entry.Commission,
+			PunishType: linotypes.PunishType(entry.PunishType),
+			JailReason: types.JailReason(entry.JailReason),
+		})
+	}
 	return nil
 }
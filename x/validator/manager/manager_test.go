@@ -1,10 +1,14 @@
 package manager
 
 import (
+	"io/ioutil"
 	"math"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	codec "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -17,6 +21,7 @@ import (
 	param "github.com/lino-network/lino/param/mocks"
 	"github.com/lino-network/lino/testsuites"
 	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/utils"
 	acc "github.com/lino-network/lino/x/account/mocks"
 	global "github.com/lino-network/lino/x/global/mocks"
 	"github.com/lino-network/lino/x/validator/model"
@@ -59,11 +64,11 @@ func (suite *ValidatorManagerTestSuite) SetupTest() {
 	suite.vote.On("GetVoterDuty", suite.Ctx, linotypes.AccountKey("val")).Return(votetypes.DutyVoter, nil).Maybe()
 	suite.vote.On("AssignDuty", suite.Ctx, linotypes.AccountKey("val"), votetypes.DutyValidator,
 		linotypes.NewCoinFromInt64(200000*linotypes.Decimals)).Return(nil).Maybe()
-	suite.vote.On("UnassignDuty", suite.Ctx, linotypes.AccountKey("val"), mock.Anything).Return(nil).Maybe()
+	suite.vote.On("UnassignDuty", suite.Ctx, linotypes.AccountKey("val"), votetypes.DutyValidator, mock.Anything).Return(nil).Maybe()
 	suite.vote.On("SlashStake", suite.Ctx, linotypes.AccountKey("abs"),
 		linotypes.NewCoinFromInt64(200*linotypes.Decimals), linotypes.InflationValidatorPool).Return(linotypes.NewCoinFromInt64(200*linotypes.Decimals), nil).Maybe()
 	suite.vote.On("SlashStake", suite.Ctx, linotypes.AccountKey("byz"),
-		linotypes.NewCoinFromInt64(1000*linotypes.Decimals), linotypes.InflationValidatorPool).Return(linotypes.NewCoinFromInt64(200*linotypes.Decimals), nil).Maybe()
+		linotypes.NewCoinFromInt64(100000*linotypes.Decimals), linotypes.InflationValidatorPool).Return(linotypes.NewCoinFromInt64(100000*linotypes.Decimals), nil).Maybe()
 
 	suite.vote.On("ClaimInterest", suite.Ctx, mock.Anything).Return(nil).Maybe()
 
@@ -83,6 +88,13 @@ func (suite *ValidatorManagerTestSuite) SetupTest() {
 		StandbyInflationWeight:         int64(1),
 		MaxVotedValidators:             int64(3),
 		SlashLimitation:                int64(5),
+		MaxCommissionRate:              linotypes.NewDecFromRat(50, 100),
+		MaxCommissionChangeRate:        linotypes.NewDecFromRat(5, 100),
+		CommissionChangeIntervalSec:    int64(24 * 3600),
+		JailDurationSec:                int64(24 * 3600),
+		ByzantineJailDurationSec:       int64(7 * 24 * 3600),
+		ByzantineSlashFraction:         linotypes.NewDecFromRat(5, 100),
+		SignedBlocksWindow:             int64(2400),
 	}, nil).Maybe()
 
 }
@@ -1641,6 +1653,11 @@ func (suite *ValidatorManagerTestSuite) TestOnOncallVotesInc() {
 func (suite *ValidatorManagerTestSuite) TestCheckDupPubKey() {
 	key1 := secp256k1.GenPrivKey().PubKey()
 	key2 := secp256k1.GenPrivKey().PubKey()
+	key3 := secp256k1.GenPrivKey().PubKey()
+	suite.vm.storage.SetTombstone(suite.Ctx, key3.Address(), &model.Tombstone{
+		Username: linotypes.AccountKey("byz"),
+		Height:   1,
+	})
 	testCases := []struct {
 		testName    string
 		newKey      crypto.PubKey
@@ -1688,6 +1705,26 @@ func (suite *ValidatorManagerTestSuite) TestCheckDupPubKey() {
 			},
 			expectedRes: types.ErrValidatorPubKeyAlreadyExist(),
 		},
+		{
+			testName: "tombstoned pubkey",
+			newKey:   key3,
+			existVal: model.Validator{
+				ABCIValidator: abci.Validator{
+					Address: key2.Address(),
+					Power:   0},
+				Username: linotypes.AccountKey("test1"),
+			},
+			prevList: model.ValidatorList{
+				Oncall: []linotypes.AccountKey{
+					linotypes.AccountKey("test1"),
+				},
+				LowestOncallVotes:  linotypes.NewCoinFromInt64(0),
+				LowestOncall:       linotypes.AccountKey(""),
+				LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+				LowestStandby:      linotypes.AccountKey(""),
+			},
+			expectedRes: types.ErrValidatorPubKeyTombstoned(),
+		},
 	}
 	for _, tc := range testCases {
 		suite.vm.storage.SetValidatorList(suite.Ctx, &tc.prevList)
@@ -1885,10 +1922,12 @@ func (suite *ValidatorManagerTestSuite) TestSetNewElectionVoteList() {
 					{
 						ValidatorName: linotypes.AccountKey("val1"),
 						Vote:          linotypes.NewCoinFromInt64(150),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 					{
 						ValidatorName: linotypes.AccountKey("val2"),
 						Vote:          linotypes.NewCoinFromInt64(150),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 				},
 			},
@@ -1910,6 +1949,7 @@ func (suite *ValidatorManagerTestSuite) TestSetNewElectionVoteList() {
 					{
 						ValidatorName: linotypes.AccountKey("val6"),
 						Vote:          linotypes.NewCoinFromInt64(100),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 				},
 			},
@@ -2386,19 +2426,63 @@ func (suite *ValidatorManagerTestSuite) TestGetValidatorUpdates() {
 func (suite *ValidatorManagerTestSuite) TestRejoinFromJail() {
 	validators := map[linotypes.AccountKey]linotypes.Coin{
 		linotypes.AccountKey("jail1"): linotypes.NewCoinFromInt64(100),
+		linotypes.AccountKey("jail2"): linotypes.NewCoinFromInt64(100),
 		linotypes.AccountKey("test1"): linotypes.NewCoinFromInt64(200),
 	}
 	suite.SetupValidatorAndVotes(validators)
+	suite.vote.On("GetLinoStake", mock.Anything, linotypes.AccountKey("jail1")).Return(linotypes.NewCoinFromInt64(200000*linotypes.Decimals), nil).Maybe()
+	suite.vote.On("GetLinoStake", mock.Anything, linotypes.AccountKey("jail2")).Return(linotypes.NewCoinFromInt64(200), nil).Maybe()
+	now := suite.Ctx.BlockHeader().Time.Unix()
+	jail1, err := suite.vm.storage.GetValidator(suite.Ctx, linotypes.AccountKey("jail1"))
+	suite.NoError(err)
+	jail1.JailRecord = model.JailRecord{
+		Reason:   types.JailReasonMissedCommits,
+		JailedAt: now - 100,
+		UnjailAt: now + 100,
+	}
+	suite.vm.storage.SetValidator(suite.Ctx, linotypes.AccountKey("jail1"), jail1)
 
 	testCases := []struct {
 		testName   string
+		blockTime  int64
 		prevList   model.ValidatorList
 		rejoinUser linotypes.AccountKey
 		expectList model.ValidatorList
 		expectRes  sdk.Error
 	}{
 		{
-			testName: "rejoin from jail",
+			testName:  "rejoin from jail too early",
+			blockTime: now + 99,
+			prevList: model.ValidatorList{
+				Oncall: []linotypes.AccountKey{
+					linotypes.AccountKey("test1"),
+				},
+				Jail: []linotypes.AccountKey{
+					linotypes.AccountKey("jail1"),
+				},
+				LowestOncallVotes:  linotypes.NewCoinFromInt64(200),
+				LowestOncall:       linotypes.AccountKey("test1"),
+				LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+				LowestStandby:      linotypes.AccountKey(""),
+			},
+			rejoinUser: linotypes.AccountKey("jail1"),
+			expectList: model.ValidatorList{
+				Oncall: []linotypes.AccountKey{
+					linotypes.AccountKey("test1"),
+				},
+				Jail: []linotypes.AccountKey{
+					linotypes.AccountKey("jail1"),
+				},
+				LowestOncallVotes:  linotypes.NewCoinFromInt64(200),
+				LowestOncall:       linotypes.AccountKey("test1"),
+				LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+				LowestStandby:      linotypes.AccountKey(""),
+			},
+			expectRes: types.ErrUnjailTooEarly(now + 100),
+		},
+		{
+			testName:  "rejoin from jail",
+			blockTime: now + 100,
 			prevList: model.ValidatorList{
 				Oncall: []linotypes.AccountKey{
 					linotypes.AccountKey("test1"),
@@ -2425,7 +2509,8 @@ func (suite *ValidatorManagerTestSuite) TestRejoinFromJail() {
 			expectRes: nil,
 		},
 		{
-			testName: "rejoin from jail2",
+			testName:  "rejoin from jail2",
+			blockTime: now,
 			prevList: model.ValidatorList{
 				Oncall: []linotypes.AccountKey{
 					linotypes.AccountKey("test1"),
@@ -2455,11 +2540,312 @@ func (suite *ValidatorManagerTestSuite) TestRejoinFromJail() {
 		},
 	}
 	for _, tc := range testCases {
-		suite.vm.storage.SetValidatorList(suite.Ctx, &tc.prevList)
-		err := suite.vm.rejoinFromJail(suite.Ctx, tc.rejoinUser)
+		ctx := suite.Ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.blockTime, 0)})
+		suite.vm.storage.SetValidatorList(ctx, &tc.prevList)
+		err := suite.vm.rejoinFromJail(ctx, tc.rejoinUser)
 		suite.Equal(tc.expectRes, err, "%s", tc.testName)
-		lst := suite.vm.storage.GetValidatorList(suite.Ctx)
+		lst := suite.vm.storage.GetValidatorList(ctx)
 		suite.Equal(tc.expectList, *lst, "%s", tc.testName)
+		if tc.expectRes == nil {
+			val, err := suite.vm.storage.GetValidator(ctx, tc.rejoinUser)
+			suite.NoError(err)
+			suite.Equal(model.JailRecord{}, val.JailRecord, "%s", tc.testName)
+		}
+	}
+}
+
+func (suite *ValidatorManagerTestSuite) TestUnjailValidator() {
+	validators := map[linotypes.AccountKey]linotypes.Coin{
+		linotypes.AccountKey("jail1"): linotypes.NewCoinFromInt64(100),
+		linotypes.AccountKey("test1"): linotypes.NewCoinFromInt64(200),
+	}
+	suite.SetupValidatorAndVotes(validators)
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Oncall: []linotypes.AccountKey{
+			linotypes.AccountKey("test1"),
+		},
+		Jail: []linotypes.AccountKey{
+			linotypes.AccountKey("jail1"),
+		},
+		LowestOncallVotes:  linotypes.NewCoinFromInt64(200),
+		LowestOncall:       linotypes.AccountKey("test1"),
+		LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+		LowestStandby:      linotypes.AccountKey(""),
+	})
+
+	err := suite.vm.UnjailValidator(suite.Ctx, linotypes.AccountKey("test1"))
+	suite.Equal(types.ErrValidatorNotJailed(linotypes.AccountKey("test1")), err)
+	err = suite.vm.UnjailValidator(suite.Ctx, linotypes.AccountKey("jail1"))
+	suite.NoError(err)
+	lst := suite.vm.storage.GetValidatorList(suite.Ctx)
+	suite.Equal([]linotypes.AccountKey{"test1", "jail1"}, lst.Oncall)
+	suite.Empty(lst.Jail)
+	suite.Equal([]model.LedgerEntry{
+		{
+			Time:       suite.Ctx.BlockHeader().Time.Unix(),
+			Type:       types.LedgerEntryUnjail,
+			Amount:     linotypes.NewCoinFromInt64(0),
+			Commission: linotypes.NewCoinFromInt64(0),
+		},
+	}, suite.vm.GetLedger(suite.Ctx, linotypes.AccountKey("jail1"), 0, math.MaxInt64))
+}
+
+func (suite *ValidatorManagerTestSuite) TestRotateValidatorKey() {
+	key1 := secp256k1.GenPrivKey().PubKey()
+	key2 := secp256k1.GenPrivKey().PubKey()
+	newKey := secp256k1.GenPrivKey().PubKey()
+	user1 := linotypes.AccountKey("user1")
+	user2 := linotypes.AccountKey("user2")
+	for user, key := range map[linotypes.AccountKey]crypto.PubKey{user1: key1, user2: key2} {
+		suite.vm.storage.SetValidator(suite.Ctx, user, &model.Validator{
+			ABCIValidator: abci.Validator{
+				Address: key.Address(),
+				Power:   linotypes.TendermintValidatorPower,
+			},
+			PubKey:        key,
+			Username:      user,
+			ReceivedVotes: linotypes.NewCoinFromInt64(0),
+		})
+	}
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Oncall:             []linotypes.AccountKey{user1, user2},
+		PreBlockValidators: []linotypes.AccountKey{user1, user2},
+	})
+
+	ctx := suite.Ctx.WithBlockHeight(10)
+	suite.Equal(types.ErrInvalidValidator(),
+		suite.vm.RotateValidatorKey(ctx, linotypes.AccountKey("nobody"), newKey))
+	suite.Equal(types.ErrValidatorPubKeyAlreadyExist(), suite.vm.RotateValidatorKey(ctx, user1, key2))
+	suite.NoError(suite.vm.RotateValidatorKey(ctx, user1, newKey))
+	suite.Equal(types.ErrKeyRotationPending(user1), suite.vm.RotateValidatorKey(ctx, user1, newKey))
+	suite.Equal(types.ErrValidatorPubKeyAlreadyExist(), suite.vm.RotateValidatorKey(ctx, user2, newKey))
+
+	// not applied in the same block.
+	suite.NoError(suite.vm.applyKeyRotations(ctx))
+	val1, err := suite.vm.GetValidator(ctx, user1)
+	suite.NoError(err)
+	suite.Equal(key1, val1.PubKey)
+
+	// applied in the next block, old key is removed and new key is added.
+	ctx = suite.Ctx.WithBlockHeight(11)
+	suite.NoError(suite.vm.applyKeyRotations(ctx))
+	val1, err = suite.vm.GetValidator(ctx, user1)
+	suite.NoError(err)
+	suite.Equal(newKey, val1.PubKey)
+	suite.Equal(newKey.Address().Bytes(), val1.ABCIValidator.Address)
+	suite.Equal(&model.KeyRotation{
+		NewPubKey:   newKey,
+		OldPubKey:   key1,
+		RequestedAt: 10,
+		AppliedAt:   11,
+	}, suite.vm.GetKeyRotation(ctx, user1))
+	updates, err := suite.vm.GetValidatorUpdates(ctx)
+	suite.NoError(err)
+	suite.Equal([]abci.ValidatorUpdate{
+		{PubKey: tmtypes.TM2PB.PubKey(key1), Power: 0},
+		{PubKey: tmtypes.TM2PB.PubKey(newKey), Power: linotypes.TendermintValidatorPower},
+		{PubKey: tmtypes.TM2PB.PubKey(key2), Power: linotypes.TendermintValidatorPower},
+	}, updates)
+	// old key can not be used while rotating.
+	suite.Equal(types.ErrValidatorPubKeyAlreadyExist(), suite.vm.RotateValidatorKey(ctx, user2, key1))
+
+	// commits signed by the old key still count.
+	ctx = suite.Ctx.WithBlockHeight(12)
+	suite.NoError(suite.vm.updateSigningStats(ctx, []abci.VoteInfo{
+		{Validator: abci.Validator{Address: key1.Address()}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: key2.Address()}, SignedLastBlock: true},
+	}))
+	val1, err = suite.vm.GetValidator(ctx, user1)
+	suite.NoError(err)
+	suite.Equal(int64(1), val1.ProducedBlocks)
+	suite.Equal(int64(0), val1.AbsentCommit)
+	suite.NoError(suite.vm.applyKeyRotations(ctx))
+	suite.NotNil(suite.vm.GetKeyRotation(ctx, user1))
+
+	// rotation is done once tendermint uses the new key.
+	ctx = suite.Ctx.WithBlockHeight(13)
+	suite.NoError(suite.vm.applyKeyRotations(ctx))
+	suite.Nil(suite.vm.GetKeyRotation(ctx, user1))
+	updates, err = suite.vm.GetValidatorUpdates(ctx)
+	suite.NoError(err)
+	suite.Equal([]abci.ValidatorUpdate{
+		{PubKey: tmtypes.TM2PB.PubKey(newKey), Power: linotypes.TendermintValidatorPower},
+		{PubKey: tmtypes.TM2PB.PubKey(key2), Power: linotypes.TendermintValidatorPower},
+	}, updates)
+}
+
+func (suite *ValidatorManagerTestSuite) TestRotateKeyOfJailedOrTombstonedValidator() {
+	oldKey := secp256k1.GenPrivKey().PubKey()
+	key1 := secp256k1.GenPrivKey().PubKey()
+	key2 := secp256k1.GenPrivKey().PubKey()
+	newKey := secp256k1.GenPrivKey().PubKey()
+	jailed := linotypes.AccountKey("jailed")
+	byz := linotypes.AccountKey("byz")
+	for user, key := range map[linotypes.AccountKey]crypto.PubKey{jailed: key1, byz: key2} {
+		suite.vm.storage.SetValidator(suite.Ctx, user, &model.Validator{
+			ABCIValidator: abci.Validator{
+				Address: key.Address(),
+			},
+			PubKey:        key,
+			Username:      user,
+			ReceivedVotes: linotypes.NewCoinFromInt64(0),
+		})
+	}
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Candidates: []linotypes.AccountKey{byz},
+		Jail:       []linotypes.AccountKey{jailed},
+	})
+	// byz double signed with a key it has rotated away from.
+	suite.vm.storage.SetTombstone(suite.Ctx, oldKey.Address(), &model.Tombstone{Username: byz, Height: 10})
+	suite.vm.storage.SetEvidenceHistory(suite.Ctx, byz, &model.EvidenceHistory{
+		Evidences: []model.Evidence{{
+			Height:       10,
+			Address:      oldKey.Address(),
+			Slashed:      linotypes.NewCoinFromInt64(0),
+			VoterSlashed: linotypes.NewCoinFromInt64(0),
+		}},
+	})
+
+	suite.Equal(types.ErrValidatorJailed(jailed), suite.vm.RotateValidatorKey(suite.Ctx, jailed, newKey))
+	suite.Equal(types.ErrValidatorPubKeyTombstoned(), suite.vm.RotateValidatorKey(suite.Ctx, byz, newKey))
+	suite.Nil(suite.vm.GetKeyRotation(suite.Ctx, jailed))
+	suite.Nil(suite.vm.GetKeyRotation(suite.Ctx, byz))
+
+	// nor can it leave jail with the new key.
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Jail: []linotypes.AccountKey{jailed, byz},
+	})
+	suite.Equal(types.ErrValidatorPubKeyTombstoned(), suite.vm.UnjailValidator(suite.Ctx, byz))
+}
+
+func (suite *ValidatorManagerTestSuite) TestUpdateSigningStats() {
+	key := secp256k1.GenPrivKey().PubKey()
+	user := linotypes.AccountKey("user1")
+	suite.vm.storage.SetValidator(suite.Ctx, user, &model.Validator{
+		ABCIValidator: abci.Validator{
+			Address: key.Address(),
+			Power:   linotypes.TendermintValidatorPower,
+		},
+		PubKey:        key,
+		Username:      user,
+		ReceivedVotes: linotypes.NewCoinFromInt64(0),
+	})
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Oncall: []linotypes.AccountKey{user},
+	})
+
+	// missed blocks are not forgiven by signed blocks.
+	for _, signed := range []bool{false, false, false, true} {
+		suite.NoError(suite.vm.updateSigningStats(suite.Ctx, []abci.VoteInfo{
+			{Validator: abci.Validator{Address: key.Address()}, SignedLastBlock: signed},
+		}))
+	}
+	val, err := suite.vm.GetValidator(suite.Ctx, user)
+	suite.NoError(err)
+	suite.Equal(int64(3), val.AbsentCommit)
+	suite.Equal(int64(1), val.ProducedBlocks)
+
+	info, err := suite.vm.GetSigningInfo(suite.Ctx, user)
+	suite.NoError(err)
+	suite.Equal(&model.SigningInfo{
+		Username:   user,
+		WindowSize: 2400,
+		Missed:     3,
+		Bitmap:     "0001",
+		Uptime:     linotypes.NewDecFromRat(1, 4),
+	}, info)
+	infos, err := suite.vm.GetCommittingSigningInfos(suite.Ctx)
+	suite.NoError(err)
+	suite.Equal([]model.SigningInfo{*info}, infos)
+
+	_, err = suite.vm.GetSigningInfo(suite.Ctx, linotypes.AccountKey("nobody"))
+	suite.Equal(types.ErrValidatorNotFound(linotypes.AccountKey("nobody")), err)
+}
+
+func (suite *ValidatorManagerTestSuite) TestSimulateVoteChanges() {
+	validators := map[linotypes.AccountKey]linotypes.Coin{
+		linotypes.AccountKey("test1"): linotypes.NewCoinFromInt64(100),
+		linotypes.AccountKey("test2"): linotypes.NewCoinFromInt64(200),
+		linotypes.AccountKey("test3"): linotypes.NewCoinFromInt64(300),
+		linotypes.AccountKey("test4"): linotypes.NewCoinFromInt64(400),
+		linotypes.AccountKey("test5"): linotypes.NewCoinFromInt64(500),
+		linotypes.AccountKey("test6"): linotypes.NewCoinFromInt64(50),
+	}
+	suite.SetupValidatorAndVotes(validators)
+	prevList := model.ValidatorList{
+		Standby: []linotypes.AccountKey{
+			linotypes.AccountKey("test1"),
+			linotypes.AccountKey("test2"),
+		},
+		Oncall: []linotypes.AccountKey{
+			linotypes.AccountKey("test3"),
+			linotypes.AccountKey("test4"),
+			linotypes.AccountKey("test5"),
+		},
+		Candidates: []linotypes.AccountKey{
+			linotypes.AccountKey("test6"),
+		},
+		LowestOncallVotes:  linotypes.NewCoinFromInt64(300),
+		LowestOncall:       linotypes.AccountKey("test3"),
+		LowestStandbyVotes: linotypes.NewCoinFromInt64(100),
+		LowestStandby:      linotypes.AccountKey("test1"),
+	}
+	suite.vm.storage.SetValidatorList(suite.Ctx, &prevList)
+
+	testCases := []struct {
+		testName     string
+		changes      []model.ElectionVote
+		expectedList *model.ValidatorList
+		expectedErr  sdk.Error
+	}{
+		{
+			testName: "candidate becomes oncall and oncall becomes standby",
+			changes: []model.ElectionVote{
+				{ValidatorName: linotypes.AccountKey("test6"), Vote: linotypes.NewCoinFromInt64(550)},
+				{ValidatorName: linotypes.AccountKey("test4"), Vote: linotypes.NewCoinFromInt64(-200)},
+			},
+			expectedList: &model.ValidatorList{
+				Standby: []linotypes.AccountKey{
+					linotypes.AccountKey("test2"),
+					linotypes.AccountKey("test4"),
+					linotypes.AccountKey("test1"),
+				},
+				Oncall: []linotypes.AccountKey{
+					linotypes.AccountKey("test5"),
+					linotypes.AccountKey("test6"),
+					linotypes.AccountKey("test3"),
+				},
+				LowestOncallVotes:  linotypes.NewCoinFromInt64(300),
+				LowestOncall:       linotypes.AccountKey("test3"),
+				LowestStandbyVotes: linotypes.NewCoinFromInt64(100),
+				LowestStandby:      linotypes.AccountKey("test1"),
+			},
+		},
+		{
+			testName: "received votes become negative",
+			changes: []model.ElectionVote{
+				{ValidatorName: linotypes.AccountKey("test1"), Vote: linotypes.NewCoinFromInt64(-101)},
+			},
+			expectedErr: types.ErrInvalidVoteChanges("test1 received votes become negative"),
+		},
+		{
+			testName: "validator not found",
+			changes: []model.ElectionVote{
+				{ValidatorName: linotypes.AccountKey("nobody"), Vote: linotypes.NewCoinFromInt64(1)},
+			},
+			expectedErr: types.ErrValidatorNotFound(linotypes.AccountKey("nobody")),
+		},
+	}
+
+	for _, tc := range testCases {
+		lst, err := suite.vm.SimulateVoteChanges(suite.Ctx, tc.changes)
+		suite.Equal(tc.expectedErr, err, "%s", tc.testName)
+		suite.Equal(tc.expectedList, lst, "%s", tc.testName)
+		// nothing is committed.
+		suite.Equal(prevList, *suite.vm.storage.GetValidatorList(suite.Ctx), "%s", tc.testName)
+		val, err := suite.vm.storage.GetValidator(suite.Ctx, linotypes.AccountKey("test6"))
+		suite.NoError(err)
+		suite.Equal(linotypes.NewCoinFromInt64(50), val.ReceivedVotes, "%s", tc.testName)
 	}
 }
 
@@ -2504,14 +2890,17 @@ func (suite *ValidatorManagerTestSuite) TestVoteValidator() {
 					{
 						ValidatorName: linotypes.AccountKey("test1"),
 						Vote:          linotypes.NewCoinFromInt64(100),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 					{
 						ValidatorName: linotypes.AccountKey("test2"),
 						Vote:          linotypes.NewCoinFromInt64(100),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 					{
 						ValidatorName: linotypes.AccountKey("test3"),
 						Vote:          linotypes.NewCoinFromInt64(100),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 				},
 			},
@@ -2546,14 +2935,17 @@ func (suite *ValidatorManagerTestSuite) TestVoteValidator() {
 					{
 						ValidatorName: linotypes.AccountKey("test1"),
 						Vote:          linotypes.NewCoinFromInt64(100),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 					{
 						ValidatorName: linotypes.AccountKey("test2"),
 						Vote:          linotypes.NewCoinFromInt64(100),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 					{
 						ValidatorName: linotypes.AccountKey("test3"),
 						Vote:          linotypes.NewCoinFromInt64(100),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 				},
 			},
@@ -2683,6 +3075,27 @@ func (suite *ValidatorManagerTestSuite) TestFireIncompetentValidator() {
 	}
 	suite.vm.storage.SetValidator(suite.Ctx, byz, &byzVal)
 	suite.vm.storage.SetValidator(suite.Ctx, abs, &absVal)
+	// voter of byz is slashed by fraction of its votes, byz's self vote is not.
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, byz, &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: byz, Vote: linotypes.NewCoinFromInt64(1000)},
+		},
+	})
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, linotypes.AccountKey("voter1"), &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: linotypes.AccountKey("test1"), Vote: linotypes.NewCoinFromInt64(500)},
+			{ValidatorName: byz, Vote: linotypes.NewCoinFromInt64(1000)},
+		},
+	})
+	suite.vote.On("SlashStake", suite.Ctx, linotypes.AccountKey("voter1"),
+		linotypes.NewCoinFromInt64(50), linotypes.InflationValidatorPool).Return(linotypes.NewCoinFromInt64(50), nil).Once()
+	evidence := abci.Evidence{
+		Validator: abci.Validator{
+			Address: byzKey.Address(),
+		},
+		Height: 10,
+		Time:   time.Unix(100, 0),
+	}
 
 	testCases := []struct {
 		testName            string
@@ -2752,11 +3165,45 @@ func (suite *ValidatorManagerTestSuite) TestFireIncompetentValidator() {
 				LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
 				LowestStandby:      linotypes.AccountKey(""),
 			},
+			byzantineValidators: []abci.Evidence{evidence, evidence},
+		},
+		{
+			testName: "tombstoned key is not punished again",
+			prevList: model.ValidatorList{
+				Oncall: []linotypes.AccountKey{
+					linotypes.AccountKey("test3"),
+					linotypes.AccountKey("test2"),
+					linotypes.AccountKey("test1"),
+				},
+				Jail: []linotypes.AccountKey{
+					linotypes.AccountKey("byz"),
+				},
+				LowestOncallVotes:  linotypes.NewCoinFromInt64(100),
+				LowestOncall:       linotypes.AccountKey("test1"),
+				LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+				LowestStandby:      linotypes.AccountKey(""),
+			},
+			expectedList: model.ValidatorList{
+				Oncall: []linotypes.AccountKey{
+					linotypes.AccountKey("test3"),
+					linotypes.AccountKey("test2"),
+					linotypes.AccountKey("test1"),
+				},
+				Jail: []linotypes.AccountKey{
+					linotypes.AccountKey("byz"),
+				},
+				LowestOncallVotes:  linotypes.NewCoinFromInt64(100),
+				LowestOncall:       linotypes.AccountKey("test1"),
+				LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+				LowestStandby:      linotypes.AccountKey(""),
+			},
 			byzantineValidators: []abci.Evidence{
 				{
 					Validator: abci.Validator{
 						Address: byzKey.Address(),
 					},
+					Height: 11,
+					Time:   time.Unix(110, 0),
 				},
 			},
 		},
@@ -2769,6 +3216,63 @@ func (suite *ValidatorManagerTestSuite) TestFireIncompetentValidator() {
 		actualList := suite.vm.storage.GetValidatorList(suite.Ctx)
 		suite.Equal(tc.expectedList, *actualList, "%s", tc.testName)
 	}
+	suite.vote.AssertExpectations(suite.T())
+	suite.True(suite.vm.storage.IsTombstoned(suite.Ctx, byzKey.Address()))
+	suite.Equal(&model.EvidenceHistory{
+		Evidences: []model.Evidence{
+			{
+				Height:       10,
+				Time:         100,
+				Address:      byzKey.Address(),
+				Slashed:      linotypes.NewCoinFromInt64(100000 * linotypes.Decimals),
+				VoterSlashed: linotypes.NewCoinFromInt64(50),
+			},
+		},
+	}, suite.vm.GetEvidenceHistory(suite.Ctx, byz))
+}
+
+func (suite *ValidatorManagerTestSuite) TestByzantineSlashMoreThanStake() {
+	key := secp256k1.GenPrivKey().PubKey()
+	user := linotypes.AccountKey("short")
+	suite.vm.storage.SetValidator(suite.Ctx, user, &model.Validator{
+		ABCIValidator: abci.Validator{
+			Address: key.Address(),
+			Power:   linotypes.TendermintValidatorPower,
+		},
+		PubKey:        key,
+		Username:      user,
+		ReceivedVotes: linotypes.NewCoinFromInt64(0),
+	})
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Oncall:             []linotypes.AccountKey{user},
+		LowestOncallVotes:  linotypes.NewCoinFromInt64(0),
+		LowestOncall:       user,
+		LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+	})
+	// penalty is PenaltyByzantine, but only the remaining stake can be slashed.
+	stake := linotypes.NewCoinFromInt64(500 * linotypes.Decimals)
+	suite.vote.On("GetLinoStake", suite.Ctx, user).Return(stake, nil)
+	suite.vote.On("SlashStake", suite.Ctx, user,
+		linotypes.NewCoinFromInt64(1000*linotypes.Decimals), linotypes.InflationValidatorPool).Return(stake, nil).Once()
+
+	err := suite.vm.handleByzantineEvidence(suite.Ctx, abci.Evidence{
+		Validator: abci.Validator{Address: key.Address()},
+		Height:    10,
+		Time:      time.Unix(100, 0),
+	})
+	suite.NoError(err)
+	suite.vote.AssertExpectations(suite.T())
+	suite.Equal(&model.EvidenceHistory{
+		Evidences: []model.Evidence{
+			{
+				Height:       10,
+				Time:         100,
+				Address:      key.Address(),
+				Slashed:      stake,
+				VoterSlashed: linotypes.NewCoinFromInt64(0),
+			},
+		},
+	}, suite.vm.GetEvidenceHistory(suite.Ctx, user))
 }
 
 func (suite *ValidatorManagerTestSuite) TestOnStakeChange() {
@@ -2823,14 +3327,17 @@ func (suite *ValidatorManagerTestSuite) TestOnStakeChange() {
 					{
 						ValidatorName: linotypes.AccountKey("test1"),
 						Vote:          linotypes.NewCoinFromInt64(200),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 					{
 						ValidatorName: linotypes.AccountKey("test2"),
 						Vote:          linotypes.NewCoinFromInt64(200),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 					{
 						ValidatorName: linotypes.AccountKey("test3"),
 						Vote:          linotypes.NewCoinFromInt64(200),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 				},
 			},
@@ -2884,10 +3391,12 @@ func (suite *ValidatorManagerTestSuite) TestRegisterValidator() {
 					Address: valKey.Address(),
 					Power:   1,
 				},
-				Link:          "web1",
-				PubKey:        valKey,
-				Username:      val,
-				ReceivedVotes: linotypes.NewCoinFromInt64(300),
+				Link:           "web1",
+				PubKey:         valKey,
+				Username:       val,
+				ReceivedVotes:  linotypes.NewCoinFromInt64(300),
+				CommissionRate: sdk.ZeroDec(),
+				RewardPerVote:  sdk.ZeroDec(),
 			},
 			expectRes: nil,
 		},
@@ -2977,10 +3486,12 @@ func (suite *ValidatorManagerTestSuite) TestRegisterFromRevoked() {
 					Address: valKey.Address(),
 					Power:   1,
 				},
-				Link:          "web1",
-				PubKey:        valKey,
-				Username:      valName,
-				ReceivedVotes: linotypes.NewCoinFromInt64(300),
+				Link:           "web1",
+				PubKey:         valKey,
+				Username:       valName,
+				ReceivedVotes:  linotypes.NewCoinFromInt64(300),
+				CommissionRate: sdk.ZeroDec(),
+				RewardPerVote:  sdk.ZeroDec(),
 			},
 			expectRes: nil,
 		},
@@ -2997,27 +3508,65 @@ func (suite *ValidatorManagerTestSuite) TestRegisterFromRevoked() {
 }
 
 func (suite *ValidatorManagerTestSuite) TestDistributeInflationToValidator() {
+	for _, v := range []struct {
+		name       linotypes.AccountKey
+		votes      linotypes.Coin
+		commission sdk.Dec
+	}{
+		{"oncall1", linotypes.NewCoinFromInt64(100), linotypes.NewDecFromRat(1, 10)},
+		{"oncall2", linotypes.NewCoinFromInt64(0), linotypes.NewDecFromRat(1, 10)},
+		{"standby1", linotypes.NewCoinFromInt64(100), sdk.ZeroDec()},
+		{"standby2", linotypes.NewCoinFromInt64(50), linotypes.NewDecFromRat(2, 10)},
+	} {
+		suite.vm.storage.SetValidator(suite.Ctx, v.name, &model.Validator{
+			ABCIValidator: abci.Validator{
+				Address: secp256k1.GenPrivKey().PubKey().Address(),
+				Power:   0},
+			Username:       v.name,
+			ReceivedVotes:  v.votes,
+			CommissionRate: v.commission,
+		})
+	}
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, "oncall1", &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: "oncall1", Vote: linotypes.NewCoinFromInt64(40)},
+		},
+	})
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, "voterA", &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: "oncall1", Vote: linotypes.NewCoinFromInt64(60)},
+			{ValidatorName: "standby1", Vote: linotypes.NewCoinFromInt64(100)},
+		},
+	})
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, "voterB", &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: "standby2", Vote: linotypes.NewCoinFromInt64(50)},
+		},
+	})
+	// previous unclaimed reward is not distributed again.
+	suite.vm.storage.SetVoterReward(suite.Ctx, "voterC", &model.VoterReward{
+		Unclaimed: linotypes.NewCoinFromInt64(600),
+		Claimed:   linotypes.NewCoinFromInt64(0),
+	})
+	suite.vm.storage.SetUnclaimedReward(suite.Ctx, linotypes.NewCoinFromInt64(600))
+
 	suite.acc.On("GetPool", mock.Anything, linotypes.InflationValidatorPool).Return(
-		(linotypes.NewCoinFromInt64(6)), nil).Once()
+		(linotypes.NewCoinFromInt64(6600)), nil).Once()
 	for _, v := range []struct {
 		validator linotypes.AccountKey
 		amount    linotypes.Coin
 	}{
 		{
 			linotypes.AccountKey("oncall1"),
-			linotypes.NewCoinFromInt64(2),
+			linotypes.NewCoinFromInt64(200),
 		},
 		{
 			linotypes.AccountKey("oncall2"),
-			linotypes.NewCoinFromInt64(2),
-		},
-		{
-			linotypes.AccountKey("standby1"),
-			linotypes.NewCoinFromInt64(1),
+			linotypes.NewCoinFromInt64(2000),
 		},
 		{
 			linotypes.AccountKey("standby2"),
-			linotypes.NewCoinFromInt64(1),
+			linotypes.NewCoinFromInt64(200),
 		},
 	} {
 		suite.acc.On(
@@ -3026,8 +3575,10 @@ func (suite *ValidatorManagerTestSuite) TestDistributeInflationToValidator() {
 	}
 
 	testCases := []struct {
-		testName string
-		prevList model.ValidatorList
+		testName        string
+		prevList        model.ValidatorList
+		expectRewards   map[linotypes.AccountKey]linotypes.Coin
+		expectUnclaimed linotypes.Coin
 	}{
 		{
 			testName: "distribute inflation",
@@ -3045,6 +3596,13 @@ func (suite *ValidatorManagerTestSuite) TestDistributeInflationToValidator() {
 				LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
 				LowestStandby:      linotypes.AccountKey(""),
 			},
+			expectRewards: map[linotypes.AccountKey]linotypes.Coin{
+				"oncall1": linotypes.NewCoinFromInt64(720),
+				"voterA":  linotypes.NewCoinFromInt64(2080),
+				"voterB":  linotypes.NewCoinFromInt64(800),
+				"voterC":  linotypes.NewCoinFromInt64(600),
+			},
+			expectUnclaimed: linotypes.NewCoinFromInt64(4200),
 		},
 	}
 	for _, tc := range testCases {
@@ -3052,7 +3610,267 @@ func (suite *ValidatorManagerTestSuite) TestDistributeInflationToValidator() {
 		err := suite.vm.DistributeInflationToValidator(suite.Ctx)
 		suite.NoError(err)
 		suite.acc.AssertExpectations(suite.T())
+		for user, coin := range tc.expectRewards {
+			suite.Equal(coin, suite.vm.GetVoterReward(suite.Ctx, user).Unclaimed, "%s: %s", tc.testName, user)
+		}
+		suite.Equal(tc.expectUnclaimed, suite.vm.storage.GetUnclaimedReward(suite.Ctx), "%s", tc.testName)
 	}
+	now := suite.Ctx.BlockHeader().Time.Unix()
+	suite.Equal([]model.LedgerEntry{
+		{
+			Time:       now,
+			Type:       types.LedgerEntryInflation,
+			Amount:     linotypes.NewCoinFromInt64(2000),
+			Commission: linotypes.NewCoinFromInt64(200),
+		},
+	}, suite.vm.GetLedger(suite.Ctx, "oncall1", now, now))
+	suite.Equal([]model.LedgerEntry{
+		{
+			Time:       now,
+			Type:       types.LedgerEntryInflation,
+			Amount:     linotypes.NewCoinFromInt64(2000),
+			Commission: linotypes.NewCoinFromInt64(2000),
+		},
+	}, suite.vm.GetLedger(suite.Ctx, "oncall2", 0, now))
+	suite.Empty(suite.vm.GetLedger(suite.Ctx, "oncall1", now+1, now+100))
+}
+
+func (suite *ValidatorManagerTestSuite) TestDistributeInflationOnlyUnclaimed() {
+	suite.vm.storage.SetUnclaimedReward(suite.Ctx, linotypes.NewCoinFromInt64(600))
+	suite.acc.On("GetPool", mock.Anything, linotypes.InflationValidatorPool).Return(
+		(linotypes.NewCoinFromInt64(600)), nil).Once()
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Oncall:             []linotypes.AccountKey{linotypes.AccountKey("oncall1")},
+		LowestOncallVotes:  linotypes.NewCoinFromInt64(0),
+		LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+	})
+	err := suite.vm.DistributeInflationToValidator(suite.Ctx)
+	suite.NoError(err)
+	suite.acc.AssertExpectations(suite.T())
+	suite.Equal(linotypes.NewCoinFromInt64(600), suite.vm.storage.GetUnclaimedReward(suite.Ctx))
+}
+
+func (suite *ValidatorManagerTestSuite) TestClaimValidatorReward() {
+	user := linotypes.AccountKey("voterA")
+	suite.vm.storage.SetVoterReward(suite.Ctx, user, &model.VoterReward{
+		Unclaimed: linotypes.NewCoinFromInt64(300),
+		Claimed:   linotypes.NewCoinFromInt64(100),
+	})
+	suite.vm.storage.SetUnclaimedReward(suite.Ctx, linotypes.NewCoinFromInt64(500))
+	suite.acc.On("MoveFromPool", mock.Anything, linotypes.InflationValidatorPool,
+		linotypes.NewAccOrAddrFromAcc(user), linotypes.NewCoinFromInt64(300)).Return(nil).Once()
+
+	err := suite.vm.ClaimValidatorReward(suite.Ctx, user)
+	suite.NoError(err)
+	suite.acc.AssertExpectations(suite.T())
+	suite.Equal(&model.VoterReward{
+		Unclaimed: linotypes.NewCoinFromInt64(0),
+		Claimed:   linotypes.NewCoinFromInt64(400),
+	}, suite.vm.GetVoterReward(suite.Ctx, user))
+	suite.Equal(linotypes.NewCoinFromInt64(200), suite.vm.storage.GetUnclaimedReward(suite.Ctx))
+
+	// nothing left to claim.
+	err = suite.vm.ClaimValidatorReward(suite.Ctx, user)
+	suite.Equal(types.ErrNoValidatorReward(user), err)
+	err = suite.vm.ClaimValidatorReward(suite.Ctx, linotypes.AccountKey("voterB"))
+	suite.Equal(types.ErrNoValidatorReward(linotypes.AccountKey("voterB")), err)
+}
+
+func (suite *ValidatorManagerTestSuite) TestVoterRewardSettledLazily() {
+	suite.vm.storage.SetValidator(suite.Ctx, "oncall1", &model.Validator{
+		ABCIValidator: abci.Validator{
+			Address: secp256k1.GenPrivKey().PubKey().Address(),
+			Power:   0},
+		Username:       "oncall1",
+		ReceivedVotes:  linotypes.NewCoinFromInt64(100),
+		CommissionRate: sdk.ZeroDec(),
+	})
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, "voterA", &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: "oncall1", Vote: linotypes.NewCoinFromInt64(30)},
+		},
+	})
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, "voterB", &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: "oncall1", Vote: linotypes.NewCoinFromInt64(70)},
+		},
+	})
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Oncall:             []linotypes.AccountKey{"oncall1"},
+		LowestOncallVotes:  linotypes.NewCoinFromInt64(0),
+		LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+	})
+
+	// distribution only moves the reward index of validator.
+	suite.acc.On("GetPool", mock.Anything, linotypes.InflationValidatorPool).Return(
+		linotypes.NewCoinFromInt64(1000), nil).Once()
+	err := suite.vm.DistributeInflationToValidator(suite.Ctx)
+	suite.NoError(err)
+	val, err := suite.vm.storage.GetValidator(suite.Ctx, "oncall1")
+	suite.NoError(err)
+	suite.Equal(sdk.NewDec(10), val.RewardIndex())
+	suite.Equal(linotypes.NewCoinFromInt64(0), suite.vm.storage.GetVoterReward(suite.Ctx, "voterA").Unclaimed)
+	suite.Equal(linotypes.NewCoinFromInt64(300), suite.vm.GetVoterReward(suite.Ctx, "voterA").Unclaimed)
+	suite.Equal(linotypes.NewCoinFromInt64(700), suite.vm.GetVoterReward(suite.Ctx, "voterB").Unclaimed)
+	suite.Equal(linotypes.NewCoinFromInt64(1000), suite.vm.storage.GetUnclaimedReward(suite.Ctx))
+
+	// claim settles reward and moves reward index of votes.
+	suite.acc.On("MoveFromPool", mock.Anything, linotypes.InflationValidatorPool,
+		linotypes.NewAccOrAddrFromAcc("voterA"), linotypes.NewCoinFromInt64(300)).Return(nil).Once()
+	err = suite.vm.ClaimValidatorReward(suite.Ctx, "voterA")
+	suite.NoError(err)
+	suite.Equal(sdk.NewDec(10), suite.vm.storage.GetElectionVoteList(suite.Ctx, "voterA").ElectionVotes[0].RewardIndex)
+	suite.Equal(&model.VoterReward{
+		Unclaimed: linotypes.NewCoinFromInt64(0),
+		Claimed:   linotypes.NewCoinFromInt64(300),
+	}, suite.vm.GetVoterReward(suite.Ctx, "voterA"))
+	suite.Equal(linotypes.NewCoinFromInt64(700), suite.vm.storage.GetUnclaimedReward(suite.Ctx))
+
+	// only reward after last settlement is accrued.
+	suite.acc.On("GetPool", mock.Anything, linotypes.InflationValidatorPool).Return(
+		linotypes.NewCoinFromInt64(1200), nil).Once()
+	err = suite.vm.DistributeInflationToValidator(suite.Ctx)
+	suite.NoError(err)
+	suite.acc.AssertExpectations(suite.T())
+	suite.Equal(linotypes.NewCoinFromInt64(150), suite.vm.GetVoterReward(suite.Ctx, "voterA").Unclaimed)
+	suite.Equal(linotypes.NewCoinFromInt64(1050), suite.vm.GetVoterReward(suite.Ctx, "voterB").Unclaimed)
+	suite.Equal(linotypes.NewCoinFromInt64(1200), suite.vm.storage.GetUnclaimedReward(suite.Ctx))
+}
+
+func (suite *ValidatorManagerTestSuite) TestUpdateCommission() {
+	valKey := secp256k1.GenPrivKey().PubKey()
+	val := linotypes.AccountKey("val")
+	err := suite.vm.RegisterValidator(suite.Ctx, val, valKey, "link")
+	suite.NoError(err)
+	now := suite.Ctx.BlockHeader().Time.Unix()
+	interval := int64(24 * 3600)
+
+	testCases := []struct {
+		testName       string
+		username       linotypes.AccountKey
+		rate           sdk.Dec
+		blockTime      int64
+		expectRes      sdk.Error
+		expectRate     sdk.Dec
+		expectUpdateAt int64
+	}{
+		{
+			testName:       "not a validator",
+			username:       linotypes.AccountKey("user1"),
+			rate:           linotypes.NewDecFromRat(1, 100),
+			blockTime:      now,
+			expectRes:      types.ErrInvalidValidator(),
+			expectRate:     sdk.ZeroDec(),
+			expectUpdateAt: 0,
+		},
+		{
+			testName:       "exceeds max commission rate",
+			username:       val,
+			rate:           linotypes.NewDecFromRat(51, 100),
+			blockTime:      now,
+			expectRes:      types.ErrInvalidCommissionRate(linotypes.NewDecFromRat(51, 100)),
+			expectRate:     sdk.ZeroDec(),
+			expectUpdateAt: 0,
+		},
+		{
+			testName:       "change too large",
+			username:       val,
+			rate:           linotypes.NewDecFromRat(6, 100),
+			blockTime:      now,
+			expectRes:      types.ErrCommissionChangeTooLarge(linotypes.NewDecFromRat(6, 100)),
+			expectRate:     sdk.ZeroDec(),
+			expectUpdateAt: 0,
+		},
+		{
+			testName:       "update commission",
+			username:       val,
+			rate:           linotypes.NewDecFromRat(5, 100),
+			blockTime:      now,
+			expectRes:      nil,
+			expectRate:     linotypes.NewDecFromRat(5, 100),
+			expectUpdateAt: now,
+		},
+		{
+			testName:       "update too frequently",
+			username:       val,
+			rate:           linotypes.NewDecFromRat(4, 100),
+			blockTime:      now + interval - 1,
+			expectRes:      types.ErrCommissionUpdateTooFrequent(),
+			expectRate:     linotypes.NewDecFromRat(5, 100),
+			expectUpdateAt: now,
+		},
+		{
+			testName:       "update after interval",
+			username:       val,
+			rate:           linotypes.NewDecFromRat(10, 100),
+			blockTime:      now + interval,
+			expectRes:      nil,
+			expectRate:     linotypes.NewDecFromRat(10, 100),
+			expectUpdateAt: now + interval,
+		},
+	}
+	for _, tc := range testCases {
+		ctx := suite.Ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.blockTime, 0)})
+		err := suite.vm.UpdateCommission(ctx, tc.username, tc.rate)
+		suite.Equal(tc.expectRes, err, "%s", tc.testName)
+		v, err := suite.vm.storage.GetValidator(ctx, val)
+		suite.NoError(err)
+		suite.Equal(tc.expectRate, v.Commission(), "%s", tc.testName)
+		suite.Equal(tc.expectUpdateAt, v.CommissionUpdatedAt, "%s", tc.testName)
+	}
+}
+
+func (suite *ValidatorManagerTestSuite) TestUpdateCommissionAboveMax() {
+	valKey := secp256k1.GenPrivKey().PubKey()
+	val := linotypes.AccountKey("val")
+	err := suite.vm.RegisterValidator(suite.Ctx, val, valKey, "link")
+	suite.NoError(err)
+	// e.g. a validator migrated at 100%.
+	v, err := suite.vm.storage.GetValidator(suite.Ctx, val)
+	suite.NoError(err)
+	v.CommissionRate = sdk.OneDec()
+	suite.vm.storage.SetValidator(suite.Ctx, val, v)
+
+	err = suite.vm.UpdateCommission(suite.Ctx, val, linotypes.NewDecFromRat(51, 100))
+	suite.Equal(types.ErrInvalidCommissionRate(linotypes.NewDecFromRat(51, 100)), err)
+	err = suite.vm.UpdateCommission(suite.Ctx, val, linotypes.NewDecFromRat(40, 100))
+	suite.NoError(err)
+	v, err = suite.vm.storage.GetValidator(suite.Ctx, val)
+	suite.NoError(err)
+	suite.Equal(linotypes.NewDecFromRat(40, 100), v.Commission())
+
+	// change limit applies once in range.
+	ctx := suite.Ctx.WithBlockHeader(abci.Header{Time: time.Unix(suite.Ctx.BlockHeader().Time.Unix()+24*3600, 0)})
+	err = suite.vm.UpdateCommission(ctx, val, linotypes.NewDecFromRat(30, 100))
+	suite.Equal(types.ErrCommissionChangeTooLarge(linotypes.NewDecFromRat(30, 100)), err)
+}
+
+func (suite *ValidatorManagerTestSuite) TestImportPreCommissionVersion() {
+	valKey := secp256k1.GenPrivKey().PubKey()
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	dir, err := ioutil.TempDir("", "test")
+	suite.Require().Nil(err)
+	defer os.RemoveAll(dir) // clean up
+
+	tmpfn := filepath.Join(dir, "tmpfile")
+	err = utils.Save(tmpfn, cdc, &model.ValidatorTablesIR{
+		Version: preCommissionVersion,
+		Validators: []model.ValidatorIR{{
+			ABCIValidator: model.ABCIValidatorIR{Address: valKey.Address()},
+			PubKey:        model.NewABCIPubKeyIRFromTM(valKey),
+			Username:      "val",
+			ReceivedVotes: linotypes.NewCoinFromInt64(100),
+		}},
+	})
+	suite.Require().Nil(err)
+
+	err = suite.vm.ImportFromFile(suite.Ctx, cdc, tmpfn)
+	suite.Require().Nil(err)
+	val, err := suite.vm.GetValidator(suite.Ctx, "val")
+	suite.Require().Nil(err)
+	suite.Equal(sdk.OneDec(), val.Commission())
+	suite.Equal(linotypes.NewCoinFromInt64(0), suite.vm.storage.GetUnclaimedReward(suite.Ctx))
 }
 
 func (suite *ValidatorManagerTestSuite) TestRevokeValidator() {
@@ -3082,11 +3900,13 @@ func (suite *ValidatorManagerTestSuite) TestRevokeValidator() {
 					Address: valKey.Address(),
 					Power:   1,
 				},
-				Link:          "link",
-				PubKey:        valKey,
-				Username:      val,
-				ReceivedVotes: linotypes.NewCoinFromInt64(300),
-				HasRevoked:    true,
+				Link:           "link",
+				PubKey:         valKey,
+				Username:       val,
+				ReceivedVotes:  linotypes.NewCoinFromInt64(300),
+				CommissionRate: sdk.ZeroDec(),
+				HasRevoked:     true,
+				RewardPerVote:  sdk.ZeroDec(),
 			},
 			expectRes: nil,
 		},
@@ -3104,11 +3924,13 @@ func (suite *ValidatorManagerTestSuite) TestRevokeValidator() {
 					Address: valKey.Address(),
 					Power:   1,
 				},
-				Link:          "link",
-				PubKey:        valKey,
-				Username:      val,
-				ReceivedVotes: linotypes.NewCoinFromInt64(300),
-				HasRevoked:    true,
+				Link:           "link",
+				PubKey:         valKey,
+				Username:       val,
+				ReceivedVotes:  linotypes.NewCoinFromInt64(300),
+				CommissionRate: sdk.ZeroDec(),
+				HasRevoked:     true,
+				RewardPerVote:  sdk.ZeroDec(),
 			},
 			expectRes: types.ErrInvalidValidator(),
 		},
@@ -3221,10 +4043,12 @@ func (suite *ValidatorManagerTestSuite) TestUpdateValidator() {
 					Address: valKey.Address(),
 					Power:   1,
 				},
-				Link:          "web1111111",
-				PubKey:        valKey,
-				Username:      val,
-				ReceivedVotes: linotypes.NewCoinFromInt64(300),
+				Link:           "web1111111",
+				PubKey:         valKey,
+				Username:       val,
+				ReceivedVotes:  linotypes.NewCoinFromInt64(300),
+				CommissionRate: sdk.ZeroDec(),
+				RewardPerVote:  sdk.ZeroDec(),
 			},
 		},
 	}
@@ -3254,11 +4078,12 @@ func (suite *ValidatorManagerTestSuite) TestPunishCommittingValidator() {
 			Address: absKey.Address(),
 			Power:   linotypes.TendermintValidatorPower,
 		},
-		PubKey:        absKey,
-		Username:      abs,
-		ReceivedVotes: linotypes.NewCoinFromInt64(2000),
-		AbsentCommit:  1,
-		NumSlash:      5,
+		PubKey:         absKey,
+		Username:       abs,
+		ReceivedVotes:  linotypes.NewCoinFromInt64(2000),
+		AbsentCommit:   1,
+		NumSlash:       5,
+		CommissionRate: sdk.ZeroDec(),
 	}
 	suite.vm.storage.SetValidator(suite.Ctx, abs, &absVal)
 
@@ -3294,11 +4119,18 @@ func (suite *ValidatorManagerTestSuite) TestPunishCommittingValidator() {
 					Address: absKey.Address(),
 					Power:   0,
 				},
-				PubKey:        absKey,
-				Username:      abs,
-				ReceivedVotes: linotypes.NewCoinFromInt64(2000),
-				AbsentCommit:  0,
-				NumSlash:      0,
+				PubKey:         absKey,
+				Username:       abs,
+				ReceivedVotes:  linotypes.NewCoinFromInt64(2000),
+				AbsentCommit:   0,
+				NumSlash:       0,
+				CommissionRate: sdk.ZeroDec(),
+				JailRecord: model.JailRecord{
+					Reason:   types.JailReasonSlashLimit,
+					JailedAt: suite.Ctx.BlockHeader().Time.Unix(),
+					UnjailAt: suite.Ctx.BlockHeader().Time.Unix() + 24*3600,
+				},
+				RewardPerVote: sdk.ZeroDec(),
 			},
 			username: linotypes.AccountKey("abs2"),
 		},
@@ -3306,12 +4138,111 @@ func (suite *ValidatorManagerTestSuite) TestPunishCommittingValidator() {
 
 	for _, tc := range testCases {
 		suite.vm.storage.SetValidatorList(suite.Ctx, &tc.prevList)
-		err := suite.vm.PunishCommittingValidator(suite.Ctx, tc.username, linotypes.NewCoinFromInt64(200*linotypes.Decimals), linotypes.PunishNoPriceFed)
+		_, err := suite.vm.PunishCommittingValidator(suite.Ctx, tc.username, linotypes.NewCoinFromInt64(200*linotypes.Decimals), linotypes.PunishNoPriceFed)
 		suite.NoError(err)
 		actualList := suite.vm.storage.GetValidatorList(suite.Ctx)
 		suite.Equal(tc.expectedList, *actualList, "%s", tc.testName)
 		val, err := suite.vm.storage.GetValidator(suite.Ctx, tc.username)
 		suite.NoError(err)
 		suite.Equal(tc.expectedVal, *val, "%s", tc.testName)
+		now := suite.Ctx.BlockHeader().Time.Unix()
+		suite.Equal([]model.LedgerEntry{
+			{
+				Time:       now,
+				Type:       types.LedgerEntryPenalty,
+				Amount:     linotypes.NewCoinFromInt64(200 * linotypes.Decimals),
+				Commission: linotypes.NewCoinFromInt64(0),
+				PunishType: linotypes.PunishNoPriceFed,
+			},
+			{
+				Time:       now,
+				Type:       types.LedgerEntryJail,
+				Amount:     linotypes.NewCoinFromInt64(0),
+				Commission: linotypes.NewCoinFromInt64(0),
+				JailReason: types.JailReasonSlashLimit,
+			},
+		}, suite.vm.GetLedger(suite.Ctx, tc.username, 0, math.MaxInt64), "%s", tc.testName)
+	}
+}
+
+func (suite *ValidatorManagerTestSuite) TestPunishAbsentCommitJailsDirectly() {
+	user := linotypes.AccountKey("abs3")
+	penalty := linotypes.NewCoinFromInt64(200 * linotypes.Decimals)
+	suite.vote.On("GetLinoStake", suite.Ctx, user).Return(
+		linotypes.NewCoinFromInt64(200000*linotypes.Decimals), nil).Maybe()
+	suite.vote.On("SlashStake", suite.Ctx, user, penalty,
+		linotypes.InflationValidatorPool).Return(penalty, nil).Once()
+	key := secp256k1.GenPrivKey().PubKey()
+	suite.vm.storage.SetValidator(suite.Ctx, user, &model.Validator{
+		ABCIValidator: abci.Validator{
+			Address: key.Address(),
+			Power:   linotypes.TendermintValidatorPower,
+		},
+		PubKey:         key,
+		Username:       user,
+		ReceivedVotes:  linotypes.NewCoinFromInt64(2000),
+		AbsentCommit:   101,
+		CommissionRate: sdk.ZeroDec(),
+	})
+	suite.vm.storage.SetValidatorList(suite.Ctx, &model.ValidatorList{
+		Oncall:             []linotypes.AccountKey{user},
+		LowestOncallVotes:  linotypes.NewCoinFromInt64(2000),
+		LowestOncall:       user,
+		LowestStandbyVotes: linotypes.NewCoinFromInt64(0),
+	})
+
+	// first slash, far below the slash limitation, still jails the validator.
+	slashed, err := suite.vm.PunishCommittingValidator(suite.Ctx, user, penalty, linotypes.PunishAbsentCommit)
+	suite.NoError(err)
+	suite.Equal(penalty, slashed)
+	lst := suite.vm.storage.GetValidatorList(suite.Ctx)
+	suite.Equal([]linotypes.AccountKey{user}, lst.Jail)
+	suite.Empty(lst.Oncall)
+	val, err := suite.vm.storage.GetValidator(suite.Ctx, user)
+	suite.NoError(err)
+	suite.Equal(types.JailReasonMissedCommits, val.JailRecord.Reason)
+	suite.Equal(int64(0), val.AbsentCommit)
+}
+
+func (suite *ValidatorManagerTestSuite) TestReceivedVotesInvariant() {
+	for _, v := range []struct {
+		name  linotypes.AccountKey
+		votes linotypes.Coin
+	}{
+		{"invval1", linotypes.NewCoinFromInt64(100)},
+		{"invval2", linotypes.NewCoinFromInt64(50)},
+	} {
+		suite.vm.storage.SetValidator(suite.Ctx, v.name, &model.Validator{
+			Username:      v.name,
+			ReceivedVotes: v.votes,
+		})
 	}
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, "invvoter1", &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: "invval1", Vote: linotypes.NewCoinFromInt64(60)},
+			{ValidatorName: "invval2", Vote: linotypes.NewCoinFromInt64(50)},
+		},
+	})
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, "invvoter2", &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: "invval1", Vote: linotypes.NewCoinFromInt64(30)},
+			{ValidatorName: "invgone", Vote: linotypes.NewCoinFromInt64(0)},
+		},
+	})
+
+	msg, broken := suite.vm.receivedVotesInvariant(suite.Ctx)
+	suite.True(broken)
+	suite.Contains(msg, "validator invval1 received votes: coin:100, sum of election votes: coin:90")
+	suite.NotContains(msg, "invval2")
+	suite.NotContains(msg, "invgone")
+
+	suite.vm.storage.SetElectionVoteList(suite.Ctx, "invvoter2", &model.ElectionVoteList{
+		ElectionVotes: []model.ElectionVote{
+			{ValidatorName: "invval1", Vote: linotypes.NewCoinFromInt64(40)},
+			{ValidatorName: "invgone", Vote: linotypes.NewCoinFromInt64(10)},
+		},
+	})
+	msg, _ = suite.vm.receivedVotesInvariant(suite.Ctx)
+	suite.NotContains(msg, "invval1")
+	suite.Contains(msg, "unknown validator invgone received election votes: coin:10")
 }
@@ -24,6 +24,22 @@ type ValidatorKeeper struct {
 	mock.Mock
 }
 
+// ClaimValidatorReward provides a mock function with given fields: ctx, username
+func (_m *ValidatorKeeper) ClaimValidatorReward(ctx types.Context, username linotypes.AccountKey) types.Error {
+	ret := _m.Called(ctx, username)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) types.Error); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
 // DistributeInflationToValidator provides a mock function with given fields: ctx
 func (_m *ValidatorKeeper) DistributeInflationToValidator(ctx types.Context) types.Error {
 	ret := _m.Called(ctx)
@@ -54,6 +70,31 @@ func (_m *ValidatorKeeper) ExportToFile(ctx types.Context, cdc *amino.Codec, fil
 	return r0
 }
 
+// GetCommittingSigningInfos provides a mock function with given fields: ctx
+func (_m *ValidatorKeeper) GetCommittingSigningInfos(ctx types.Context) ([]model.SigningInfo, types.Error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.SigningInfo
+	if rf, ok := ret.Get(0).(func(types.Context) []model.SigningInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SigningInfo)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context) types.Error); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetCommittingValidatorVoteStatus provides a mock function with given fields: ctx
 func (_m *ValidatorKeeper) GetCommittingValidatorVoteStatus(ctx types.Context) []model.ReceivedVotesStatus {
 	ret := _m.Called(ctx)
@@ -102,6 +143,22 @@ func (_m *ValidatorKeeper) GetElectionVoteList(ctx types.Context, accKey linotyp
 	return r0
 }
 
+// GetEvidenceHistory provides a mock function with given fields: ctx, username
+func (_m *ValidatorKeeper) GetEvidenceHistory(ctx types.Context, username linotypes.AccountKey) *model.EvidenceHistory {
+	ret := _m.Called(ctx, username)
+
+	var r0 *model.EvidenceHistory
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) *model.EvidenceHistory); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EvidenceHistory)
+		}
+	}
+
+	return r0
+}
+
 // GetInitValidators provides a mock function with given fields: ctx
 func (_m *ValidatorKeeper) GetInitValidators(ctx types.Context) ([]abcitypes.ValidatorUpdate, types.Error) {
 	ret := _m.Called(ctx)
@@ -127,6 +184,63 @@ func (_m *ValidatorKeeper) GetInitValidators(ctx types.Context) ([]abcitypes.Val
 	return r0, r1
 }
 
+// GetKeyRotation provides a mock function with given fields: ctx, username
+func (_m *ValidatorKeeper) GetKeyRotation(ctx types.Context, username linotypes.AccountKey) *model.KeyRotation {
+	ret := _m.Called(ctx, username)
+
+	var r0 *model.KeyRotation
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) *model.KeyRotation); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.KeyRotation)
+		}
+	}
+
+	return r0
+}
+
+// GetLedger provides a mock function with given fields: ctx, username, start, end
+func (_m *ValidatorKeeper) GetLedger(ctx types.Context, username linotypes.AccountKey, start int64, end int64) []model.LedgerEntry {
+	ret := _m.Called(ctx, username, start, end)
+
+	var r0 []model.LedgerEntry
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, int64, int64) []model.LedgerEntry); ok {
+		r0 = rf(ctx, username, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.LedgerEntry)
+		}
+	}
+
+	return r0
+}
+
+// GetSigningInfo provides a mock function with given fields: ctx, username
+func (_m *ValidatorKeeper) GetSigningInfo(ctx types.Context, username linotypes.AccountKey) (*model.SigningInfo, types.Error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 *model.SigningInfo
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) *model.SigningInfo); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SigningInfo)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey) types.Error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetValidator provides a mock function with given fields: ctx, username
 func (_m *ValidatorKeeper) GetValidator(ctx types.Context, username linotypes.AccountKey) (*model.Validator, types.Error) {
 	ret := _m.Called(ctx, username)
@@ -193,6 +307,22 @@ func (_m *ValidatorKeeper) GetValidatorUpdates(ctx types.Context) ([]abcitypes.V
 	return r0, r1
 }
 
+// GetVoterReward provides a mock function with given fields: ctx, username
+func (_m *ValidatorKeeper) GetVoterReward(ctx types.Context, username linotypes.AccountKey) *model.VoterReward {
+	ret := _m.Called(ctx, username)
+
+	var r0 *model.VoterReward
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) *model.VoterReward); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VoterReward)
+		}
+	}
+
+	return r0
+}
+
 // Hooks provides a mock function with given fields:
 func (_m *ValidatorKeeper) Hooks() manager.Hooks {
 	ret := _m.Called()
@@ -232,19 +362,31 @@ func (_m *ValidatorKeeper) OnBeginBlock(ctx types.Context, req abcitypes.Request
 }
 
 // PunishCommittingValidator provides a mock function with given fields: ctx, username, penalty, punishType
-func (_m *ValidatorKeeper) PunishCommittingValidator(ctx types.Context, username linotypes.AccountKey, penalty linotypes.Coin, punishType linotypes.PunishType) types.Error {
+func (_m *ValidatorKeeper) PunishCommittingValidator(ctx types.Context, username linotypes.AccountKey, penalty linotypes.Coin, punishType linotypes.PunishType) (linotypes.Coin, types.Error) {
 	ret := _m.Called(ctx, username, penalty, punishType)
 
-	var r0 types.Error
-	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.Coin, linotypes.PunishType) types.Error); ok {
+	var r0 linotypes.Coin
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.Coin, linotypes.PunishType) linotypes.Coin); ok {
 		r0 = rf(ctx, username, penalty, punishType)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(types.Error)
+		r0 = ret.Get(0).(linotypes.Coin)
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey, linotypes.Coin, linotypes.PunishType) types.Error); ok {
+		r1 = rf(ctx, username, penalty, punishType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
 		}
 	}
 
-	return r0
+	return r0, r1
+}
+
+// RegisterInvariants provides a mock function with given fields: ir
+func (_m *ValidatorKeeper) RegisterInvariants(ir types.InvariantRegistry) {
+	_m.Called(ir)
 }
 
 // RegisterValidator provides a mock function with given fields: ctx, username, valPubKey, link
@@ -279,6 +421,79 @@ func (_m *ValidatorKeeper) RevokeValidator(ctx types.Context, username linotypes
 	return r0
 }
 
+// RotateValidatorKey provides a mock function with given fields: ctx, username, valPubKey
+func (_m *ValidatorKeeper) RotateValidatorKey(ctx types.Context, username linotypes.AccountKey, valPubKey crypto.PubKey) types.Error {
+	ret := _m.Called(ctx, username, valPubKey)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, crypto.PubKey) types.Error); ok {
+		r0 = rf(ctx, username, valPubKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
+// SimulateVoteChanges provides a mock function with given fields: ctx, changes
+func (_m *ValidatorKeeper) SimulateVoteChanges(ctx types.Context, changes []model.ElectionVote) (*model.ValidatorList, types.Error) {
+	ret := _m.Called(ctx, changes)
+
+	var r0 *model.ValidatorList
+	if rf, ok := ret.Get(0).(func(types.Context, []model.ElectionVote) *model.ValidatorList); ok {
+		r0 = rf(ctx, changes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ValidatorList)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, []model.ElectionVote) types.Error); ok {
+		r1 = rf(ctx, changes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// UnjailValidator provides a mock function with given fields: ctx, username
+func (_m *ValidatorKeeper) UnjailValidator(ctx types.Context, username linotypes.AccountKey) types.Error {
+	ret := _m.Called(ctx, username)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey) types.Error); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
+// UpdateCommission provides a mock function with given fields: ctx, username, rate
+func (_m *ValidatorKeeper) UpdateCommission(ctx types.Context, username linotypes.AccountKey, rate types.Dec) types.Error {
+	ret := _m.Called(ctx, username, rate)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, types.Dec) types.Error); ok {
+		r0 = rf(ctx, username, rate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
 // UpdateValidator provides a mock function with given fields: ctx, username, link
 func (_m *ValidatorKeeper) UpdateValidator(ctx types.Context, username linotypes.AccountKey, link string) types.Error {
 	ret := _m.Called(ctx, username, link)
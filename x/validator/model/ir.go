@@ -1,6 +1,7 @@
 package model
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -41,19 +42,30 @@ type ABCIValidatorIR struct {
 
 // ValidatorIR
 type ValidatorIR struct {
-	ABCIValidator  ABCIValidatorIR  `json:"abci_validator"`
-	PubKey         ABCIPubKeyIR     `json:"pub_key"`
-	Username       types.AccountKey `json:"username"`
-	ReceivedVotes  types.Coin       `json:"received_votes"`
-	HasRevoked     bool             `json:"has_revoked"`
-	AbsentCommit   int64            `json:"absent_commit"`
-	ProducedBlocks int64            `json:"produced_blocks"`
-	Link           string           `json:"link"`
+	ABCIValidator       ABCIValidatorIR  `json:"abci_validator"`
+	PubKey              ABCIPubKeyIR     `json:"pub_key"`
+	Username            types.AccountKey `json:"username"`
+	ReceivedVotes       types.Coin       `json:"received_votes"`
+	HasRevoked          bool             `json:"has_revoked"`
+	AbsentCommit        int64            `json:"absent_commit"`
+	ProducedBlocks      int64            `json:"produced_blocks"`
+	Link                string           `json:"link"`
+	CommissionRate      sdk.Dec          `json:"commission_rate"`
+	CommissionUpdatedAt int64            `json:"commission_updated_at"`
+	JailRecord          JailRecordIR     `json:"jail_record"`
+	RewardPerVote       sdk.Dec          `json:"reward_per_vote"`
+}
+
+type JailRecordIR struct {
+	Reason   int   `json:"reason"`
+	JailedAt int64 `json:"jailed_at"`
+	UnjailAt int64 `json:"unjail_at"`
 }
 
 type ElectionVoteIR struct {
 	ValidatorName types.AccountKey `json:"validator_name"`
 	Vote          types.Coin       `json:"votes"`
+	RewardIndex   sdk.Dec          `json:"reward_index"`
 }
 
 type ElectionVoteListIR struct {
@@ -61,6 +73,31 @@ type ElectionVoteListIR struct {
 	ElectionVotes []ElectionVoteIR `json:"election_votes"`
 }
 
+type VoterRewardIR struct {
+	Username  types.AccountKey `json:"username"`
+	Unclaimed types.Coin       `json:"unclaimed"`
+	Claimed   types.Coin       `json:"claimed"`
+}
+
+type EvidenceIR struct {
+	Height       int64      `json:"height"`
+	Time         int64      `json:"time"`
+	Address      []byte     `json:"address"`
+	Slashed      types.Coin `json:"slashed"`
+	VoterSlashed types.Coin `json:"voter_slashed"`
+}
+
+type EvidenceHistoryIR struct {
+	Username  types.AccountKey `json:"username"`
+	Evidences []EvidenceIR     `json:"evidences"`
+}
+
+type TombstoneIR struct {
+	Address  []byte           `json:"address"`
+	Username types.AccountKey `json:"username"`
+	Height   int64            `json:"height"`
+}
+
 // ValidatorList
 type ValidatorListIR struct {
 	Oncall             []types.AccountKey `json:"oncall"`
@@ -76,8 +113,45 @@ type ValidatorListIR struct {
 
 // ValidatorTablesIR - Validators changed.
 type ValidatorTablesIR struct {
-	Version    int                  `json:"version"`
-	Validators []ValidatorIR        `json:"validators"`
-	Votes      []ElectionVoteListIR `json:"votes"`
-	List       ValidatorListIR      `json:"list"`
+	Version         int                  `json:"version"`
+	Validators      []ValidatorIR        `json:"validators"`
+	Votes           []ElectionVoteListIR `json:"votes"`
+	List            ValidatorListIR      `json:"list"`
+	Rewards         []VoterRewardIR      `json:"rewards"`
+	UnclaimedReward types.Coin           `json:"unclaimed_reward"`
+	Evidences       []EvidenceHistoryIR  `json:"evidences"`
+	Tombstones      []TombstoneIR        `json:"tombstones"`
+	KeyRotations    []KeyRotationIR      `json:"key_rotations"`
+	SigningWindows  []SigningWindowIR    `json:"signing_windows"`
+	Ledgers         []LedgerEntryIR      `json:"ledgers"`
+}
+
+// KeyRotationIR - OldPubKey is nil if rotation is not applied yet.
+type KeyRotationIR struct {
+	Username    types.AccountKey `json:"username"`
+	NewPubKey   ABCIPubKeyIR     `json:"new_pub_key"`
+	OldPubKey   *ABCIPubKeyIR    `json:"old_pub_key"`
+	RequestedAt int64            `json:"requested_at"`
+	AppliedAt   int64            `json:"applied_at"`
+}
+
+// SigningWindowIR
+type SigningWindowIR struct {
+	Username types.AccountKey `json:"username"`
+	Bitmap   []byte           `json:"bitmap"`
+	Size     int64            `json:"size"`
+	Index    int64            `json:"index"`
+	Counted  int64            `json:"counted"`
+	Missed   int64            `json:"missed"`
+}
+
+// LedgerEntryIR
+type LedgerEntryIR struct {
+	Username   types.AccountKey `json:"username"`
+	Time       int64            `json:"time"`
+	Type       int              `json:"type"`
+	Amount     types.Coin       `json:"amount"`
+	Commission types.Coin       `json:"commission"`
+	PunishType int              `json:"punish_type"`
+	JailReason int              `json:"jail_reason"`
 }
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"encoding/binary"
+	"math"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,6 +16,13 @@ var (
 	ValidatorSubstore        = []byte{0x00}
 	ValidatorListSubstore    = []byte{0x01}
 	ElectionVoteListSubstore = []byte{0x02}
+	VoterRewardSubstore      = []byte{0x03}
+	UnclaimedRewardSubstore  = []byte{0x04}
+	EvidenceSubstore         = []byte{0x05}
+	TombstoneSubstore        = []byte{0x06}
+	KeyRotationSubstore      = []byte{0x07}
+	SigningWindowSubstore    = []byte{0x08}
+	LedgerSubstore           = []byte{0x09}
 )
 
 type ValidatorStorage struct {
@@ -87,6 +97,167 @@ func (vs ValidatorStorage) SetElectionVoteList(ctx sdk.Context, accKey linotypes
 	store.Set(GetElectionVoteListKey(accKey), lstByte)
 }
 
+func (vs ValidatorStorage) GetVoterReward(ctx sdk.Context, accKey linotypes.AccountKey) *VoterReward {
+	store := ctx.KVStore(vs.key)
+	rewardByte := store.Get(GetVoterRewardKey(accKey))
+	if rewardByte == nil {
+		// valid empty value.
+		return &VoterReward{
+			Unclaimed: linotypes.NewCoinFromInt64(0),
+			Claimed:   linotypes.NewCoinFromInt64(0),
+		}
+	}
+	reward := new(VoterReward)
+	vs.cdc.MustUnmarshalBinaryLengthPrefixed(rewardByte, reward)
+	return reward
+}
+
+func (vs ValidatorStorage) SetVoterReward(ctx sdk.Context, accKey linotypes.AccountKey, reward *VoterReward) {
+	store := ctx.KVStore(vs.key)
+	rewardByte := vs.cdc.MustMarshalBinaryLengthPrefixed(*reward)
+	store.Set(GetVoterRewardKey(accKey), rewardByte)
+}
+
+// GetUnclaimedReward - total voter rewards that are still held in validator inflation pool.
+func (vs ValidatorStorage) GetUnclaimedReward(ctx sdk.Context) linotypes.Coin {
+	store := ctx.KVStore(vs.key)
+	coinByte := store.Get(GetUnclaimedRewardKey())
+	if coinByte == nil {
+		return linotypes.NewCoinFromInt64(0)
+	}
+	coin := linotypes.NewCoinFromInt64(0)
+	vs.cdc.MustUnmarshalBinaryLengthPrefixed(coinByte, &coin)
+	return coin
+}
+
+func (vs ValidatorStorage) SetUnclaimedReward(ctx sdk.Context, coin linotypes.Coin) {
+	store := ctx.KVStore(vs.key)
+	coinByte := vs.cdc.MustMarshalBinaryLengthPrefixed(coin)
+	store.Set(GetUnclaimedRewardKey(), coinByte)
+}
+
+func (vs ValidatorStorage) GetEvidenceHistory(ctx sdk.Context, accKey linotypes.AccountKey) *EvidenceHistory {
+	store := ctx.KVStore(vs.key)
+	historyByte := store.Get(GetEvidenceKey(accKey))
+	if historyByte == nil {
+		// valid empty value.
+		return &EvidenceHistory{}
+	}
+	history := new(EvidenceHistory)
+	vs.cdc.MustUnmarshalBinaryLengthPrefixed(historyByte, history)
+	return history
+}
+
+func (vs ValidatorStorage) SetEvidenceHistory(ctx sdk.Context, accKey linotypes.AccountKey, history *EvidenceHistory) {
+	store := ctx.KVStore(vs.key)
+	historyByte := vs.cdc.MustMarshalBinaryLengthPrefixed(*history)
+	store.Set(GetEvidenceKey(accKey), historyByte)
+}
+
+func (vs ValidatorStorage) IsTombstoned(ctx sdk.Context, addr []byte) bool {
+	store := ctx.KVStore(vs.key)
+	return store.Has(GetTombstoneKey(addr))
+}
+
+func (vs ValidatorStorage) SetTombstone(ctx sdk.Context, addr []byte, tombstone *Tombstone) {
+	store := ctx.KVStore(vs.key)
+	tombstoneByte := vs.cdc.MustMarshalBinaryLengthPrefixed(*tombstone)
+	store.Set(GetTombstoneKey(addr), tombstoneByte)
+}
+
+// GetKeyRotation - returns nil if validator has no key rotation.
+func (vs ValidatorStorage) GetKeyRotation(ctx sdk.Context, accKey linotypes.AccountKey) *KeyRotation {
+	store := ctx.KVStore(vs.key)
+	rotationByte := store.Get(GetKeyRotationKey(accKey))
+	if rotationByte == nil {
+		return nil
+	}
+	rotation := new(KeyRotation)
+	vs.cdc.MustUnmarshalBinaryLengthPrefixed(rotationByte, rotation)
+	return rotation
+}
+
+func (vs ValidatorStorage) SetKeyRotation(ctx sdk.Context, accKey linotypes.AccountKey, rotation *KeyRotation) {
+	store := ctx.KVStore(vs.key)
+	rotationByte := vs.cdc.MustMarshalBinaryLengthPrefixed(*rotation)
+	store.Set(GetKeyRotationKey(accKey), rotationByte)
+}
+
+func (vs ValidatorStorage) DeleteKeyRotation(ctx sdk.Context, accKey linotypes.AccountKey) {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetKeyRotationKey(accKey))
+}
+
+// GetSigningWindow - returns nil if validator has no signing window.
+func (vs ValidatorStorage) GetSigningWindow(ctx sdk.Context, accKey linotypes.AccountKey) *SigningWindow {
+	store := ctx.KVStore(vs.key)
+	windowByte := store.Get(GetSigningWindowKey(accKey))
+	if windowByte == nil {
+		return nil
+	}
+	window := new(SigningWindow)
+	vs.cdc.MustUnmarshalBinaryLengthPrefixed(windowByte, window)
+	return window
+}
+
+func (vs ValidatorStorage) SetSigningWindow(ctx sdk.Context, accKey linotypes.AccountKey, window *SigningWindow) {
+	store := ctx.KVStore(vs.key)
+	windowByte := vs.cdc.MustMarshalBinaryLengthPrefixed(*window)
+	store.Set(GetSigningWindowKey(accKey), windowByte)
+}
+
+func (vs ValidatorStorage) DeleteSigningWindow(ctx sdk.Context, accKey linotypes.AccountKey) {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetSigningWindowKey(accKey))
+}
+
+// AddLedgerEntry - append entry to the ledger of validator at entry.Time.
+func (vs ValidatorStorage) AddLedgerEntry(ctx sdk.Context, accKey linotypes.AccountKey, entry LedgerEntry) {
+	store := ctx.KVStore(vs.key)
+	key := GetLedgerKey(accKey, entry.Time)
+	entries := new(LedgerEntries)
+	if entriesByte := store.Get(key); entriesByte != nil {
+		vs.cdc.MustUnmarshalBinaryLengthPrefixed(entriesByte, entries)
+	}
+	entries.Entries = append(entries.Entries, entry)
+	store.Set(key, vs.cdc.MustMarshalBinaryLengthPrefixed(*entries))
+}
+
+// GetLedgerEntries - ledger entries of validator from start to end time, both inclusive.
+func (vs ValidatorStorage) GetLedgerEntries(ctx sdk.Context, accKey linotypes.AccountKey, start, end int64) []LedgerEntry {
+	store := ctx.KVStore(vs.key)
+	rst := make([]LedgerEntry, 0)
+	if start > end {
+		return rst
+	}
+	endKey := sdk.PrefixEndBytes(GetLedgerPrefix(accKey))
+	if end < math.MaxInt64 {
+		endKey = GetLedgerKey(accKey, end+1)
+	}
+	itr := store.Iterator(GetLedgerKey(accKey, start), endKey)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		entries := new(LedgerEntries)
+		vs.cdc.MustUnmarshalBinaryLengthPrefixed(itr.Value(), entries)
+		rst = append(rst, entries.Entries...)
+	}
+	return rst
+}
+
+// IterateKeyRotations - iterate all key rotations ordered by username.
+func (vs ValidatorStorage) IterateKeyRotations(ctx sdk.Context, cb func(user linotypes.AccountKey, rotation *KeyRotation) bool) {
+	vs.StoreMap(ctx)[string(KeyRotationSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		return cb(linotypes.AccountKey(key), val.(*KeyRotation))
+	})
+}
+
+// IterateElectionVoteLists - iterate all election vote lists ordered by username.
+func (vs ValidatorStorage) IterateElectionVoteLists(ctx sdk.Context, cb func(user linotypes.AccountKey, lst *ElectionVoteList) bool) {
+	vs.StoreMap(ctx)[string(ElectionVoteListSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		return cb(linotypes.AccountKey(key), val.(*ElectionVoteList))
+	})
+}
+
 func (vs ValidatorStorage) StoreMap(ctx sdk.Context) utils.StoreMap {
 	store := ctx.KVStore(vs.key)
 	stores := []utils.SubStore{
@@ -108,6 +279,48 @@ func (vs ValidatorStorage) StoreMap(ctx sdk.Context) utils.StoreMap {
 			ValCreator: func() interface{} { return new(ElectionVoteList) },
 			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
 		},
+		{
+			Store:      store,
+			Prefix:     VoterRewardSubstore,
+			ValCreator: func() interface{} { return new(VoterReward) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     UnclaimedRewardSubstore,
+			ValCreator: func() interface{} { return new(linotypes.Coin) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     EvidenceSubstore,
+			ValCreator: func() interface{} { return new(EvidenceHistory) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     TombstoneSubstore,
+			ValCreator: func() interface{} { return new(Tombstone) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     KeyRotationSubstore,
+			ValCreator: func() interface{} { return new(KeyRotation) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     SigningWindowSubstore,
+			ValCreator: func() interface{} { return new(SigningWindow) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     LedgerSubstore,
+			ValCreator: func() interface{} { return new(LedgerEntries) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
 	}
 	return utils.NewStoreMap(stores)
 }
@@ -123,3 +336,46 @@ func GetElectionVoteListKey(accKey linotypes.AccountKey) []byte {
 func GetValidatorListKey() []byte {
 	return ValidatorListSubstore
 }
+
+func GetVoterRewardKey(accKey linotypes.AccountKey) []byte {
+	return append(VoterRewardSubstore, accKey...)
+}
+
+func GetUnclaimedRewardKey() []byte {
+	return UnclaimedRewardSubstore
+}
+
+func GetEvidenceKey(accKey linotypes.AccountKey) []byte {
+	return append(EvidenceSubstore, accKey...)
+}
+
+func GetTombstoneKey(addr []byte) []byte {
+	return append(TombstoneSubstore, addr...)
+}
+
+func GetKeyRotationKey(accKey linotypes.AccountKey) []byte {
+	return append(KeyRotationSubstore, accKey...)
+}
+
+func GetSigningWindowKey(accKey linotypes.AccountKey) []byte {
+	return append(SigningWindowSubstore, accKey...)
+}
+
+// GetLedgerPrefix - "ledger substore" + "username" + "/"
+func GetLedgerPrefix(accKey linotypes.AccountKey) []byte {
+	prefix := append(LedgerSubstore, accKey...)
+	return append(prefix, []byte(linotypes.KeySeparator)...)
+}
+
+// GetLedgerKey - "ledger prefix" + "big endian time"
+func GetLedgerKey(accKey linotypes.AccountKey, time int64) []byte {
+	timeBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timeBytes, uint64(time))
+	return append(GetLedgerPrefix(accKey), timeBytes...)
+}
+
+// ParseLedgerKey - parse key without ledger substore prefix.
+func ParseLedgerKey(key []byte) (linotypes.AccountKey, int64) {
+	userLen := len(key) - 8 - len(linotypes.KeySeparator)
+	return linotypes.AccountKey(key[:userLen]), int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+}
@@ -1,11 +1,13 @@
 package model
 
 import (
+	"math"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	valtypes "github.com/lino-network/lino/x/validator/types"
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
@@ -65,8 +67,10 @@ func TestValidator(t *testing.T) {
 			ABCIValidator: abci.Validator{
 				Address: priv.PubKey().Address(),
 				Power:   1000},
-			Username:      tc.user,
-			ReceivedVotes: tc.votes,
+			Username:       tc.user,
+			ReceivedVotes:  tc.votes,
+			CommissionRate: sdk.ZeroDec(),
+			RewardPerVote:  sdk.ZeroDec(),
 		}
 		vs.SetValidator(ctx, tc.user, &validator)
 
@@ -139,6 +143,7 @@ func TestElectionVoteList(t *testing.T) {
 					{
 						ValidatorName: types.AccountKey("test"),
 						Vote:          types.NewCoinFromInt64(100),
+						RewardIndex:   sdk.ZeroDec(),
 					},
 				},
 			},
@@ -154,3 +159,115 @@ func TestElectionVoteList(t *testing.T) {
 		}
 	}
 }
+
+func TestVoterReward(t *testing.T) {
+	ctx, vs := setup(t)
+
+	user := types.AccountKey("user")
+	empty := vs.GetVoterReward(ctx, user)
+	assert.Equal(t, VoterReward{
+		Unclaimed: types.NewCoinFromInt64(0),
+		Claimed:   types.NewCoinFromInt64(0),
+	}, *empty)
+
+	reward := VoterReward{
+		Unclaimed: types.NewCoinFromInt64(100),
+		Claimed:   types.NewCoinFromInt64(10),
+	}
+	vs.SetVoterReward(ctx, user, &reward)
+	assert.Equal(t, reward, *vs.GetVoterReward(ctx, user))
+
+	assert.Equal(t, types.NewCoinFromInt64(0), vs.GetUnclaimedReward(ctx))
+	vs.SetUnclaimedReward(ctx, types.NewCoinFromInt64(100))
+	assert.Equal(t, types.NewCoinFromInt64(100), vs.GetUnclaimedReward(ctx))
+}
+
+func TestKeyRotation(t *testing.T) {
+	ctx, vs := setup(t)
+	user := types.AccountKey("user")
+	assert.Nil(t, vs.GetKeyRotation(ctx, user))
+
+	rotation := KeyRotation{
+		NewPubKey:   secp256k1.GenPrivKey().PubKey(),
+		RequestedAt: 10,
+	}
+	vs.SetKeyRotation(ctx, user, &rotation)
+	assert.Equal(t, rotation, *vs.GetKeyRotation(ctx, user))
+
+	rotation.OldPubKey = secp256k1.GenPrivKey().PubKey()
+	rotation.AppliedAt = 11
+	vs.SetKeyRotation(ctx, user, &rotation)
+	users := []types.AccountKey{}
+	vs.IterateKeyRotations(ctx, func(u types.AccountKey, r *KeyRotation) bool {
+		users = append(users, u)
+		assert.Equal(t, rotation, *r)
+		return false
+	})
+	assert.Equal(t, []types.AccountKey{user}, users)
+
+	vs.DeleteKeyRotation(ctx, user)
+	assert.Nil(t, vs.GetKeyRotation(ctx, user))
+}
+
+func TestLedger(t *testing.T) {
+	ctx, vs := setup(t)
+	user := types.AccountKey("user")
+	entry := func(time int64, typ valtypes.LedgerEntryType) LedgerEntry {
+		return LedgerEntry{
+			Time:       time,
+			Type:       typ,
+			Amount:     types.NewCoinFromInt64(time),
+			Commission: types.NewCoinFromInt64(0),
+		}
+	}
+	vs.AddLedgerEntry(ctx, user, entry(100, valtypes.LedgerEntryPenalty))
+	vs.AddLedgerEntry(ctx, user, entry(100, valtypes.LedgerEntryJail))
+	vs.AddLedgerEntry(ctx, user, entry(200, valtypes.LedgerEntryUnjail))
+	vs.AddLedgerEntry(ctx, types.AccountKey("user2"), entry(150, valtypes.LedgerEntryInflation))
+
+	testCases := []struct {
+		testName string
+		start    int64
+		end      int64
+		expected []LedgerEntry
+	}{
+		{
+			testName: "all entries",
+			start:    0,
+			end:      math.MaxInt64,
+			expected: []LedgerEntry{
+				entry(100, valtypes.LedgerEntryPenalty),
+				entry(100, valtypes.LedgerEntryJail),
+				entry(200, valtypes.LedgerEntryUnjail),
+			},
+		},
+		{
+			testName: "range is inclusive",
+			start:    100,
+			end:      100,
+			expected: []LedgerEntry{
+				entry(100, valtypes.LedgerEntryPenalty),
+				entry(100, valtypes.LedgerEntryJail),
+			},
+		},
+		{
+			testName: "no entry in range",
+			start:    101,
+			end:      199,
+			expected: []LedgerEntry{},
+		},
+		{
+			testName: "invalid range",
+			start:    200,
+			end:      100,
+			expected: []LedgerEntry{},
+		},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, vs.GetLedgerEntries(ctx, user, tc.start, tc.end), tc.testName)
+	}
+
+	parsedUser, parsedTime := ParseLedgerKey(GetLedgerKey(user, 100)[len(LedgerSubstore):])
+	assert.Equal(t, user, parsedUser)
+	assert.Equal(t, int64(100), parsedTime)
+}
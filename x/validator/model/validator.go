@@ -1,10 +1,12 @@
 package model
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 
 	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator/types"
 )
 
 // Validator is basic structure records all validator information
@@ -18,11 +20,45 @@ type Validator struct {
 	ProducedBlocks int64                `json:"produced_blocks"`
 	Link           string               `json:"link"`
 	NumSlash       int64                `json:"num_slash"`
+	// CommissionRate is the share of validator inflation kept by the validator,
+	// the rest is shared with its voters.
+	CommissionRate      sdk.Dec    `json:"commission_rate"`
+	CommissionUpdatedAt int64      `json:"commission_updated_at"`
+	JailRecord          JailRecord `json:"jail_record"`
+	// RewardPerVote is the total voter reward per received vote ever distributed to
+	// validator, voters settle their reward from its growth since they voted.
+	RewardPerVote sdk.Dec `json:"reward_per_vote"`
+}
+
+// JailRecord - why and when validator is jailed, empty if validator is not in jail.
+type JailRecord struct {
+	Reason   types.JailReason `json:"reason"`
+	JailedAt int64            `json:"jailed_at"`
+	// UnjailAt is the earliest time validator can unjail.
+	UnjailAt int64 `json:"unjail_at"`
+}
+
+// Commission - commission rate of validator, zero if never set.
+func (v Validator) Commission() sdk.Dec {
+	if v.CommissionRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return v.CommissionRate
+}
+
+// RewardIndex - total voter reward per vote of validator, zero if never set.
+func (v Validator) RewardIndex() sdk.Dec {
+	if v.RewardPerVote.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return v.RewardPerVote
 }
 
 type ElectionVote struct {
 	ValidatorName linotypes.AccountKey `json:"validator_name"`
 	Vote          linotypes.Coin       `json:"votes"`
+	// RewardIndex is the reward index of validator when the vote was last settled.
+	RewardIndex sdk.Dec `json:"reward_index"`
 }
 
 type ReceivedVotesStatus struct {
@@ -34,6 +70,151 @@ type ElectionVoteList struct {
 	ElectionVotes []ElectionVote `json:"election_votes"`
 }
 
+// VoterReward - validator inflation shared to a voter.
+type VoterReward struct {
+	Unclaimed linotypes.Coin `json:"unclaimed"`
+	Claimed   linotypes.Coin `json:"claimed"`
+}
+
+// Evidence - a handled byzantine evidence of validator.
+type Evidence struct {
+	Height  int64  `json:"height"`
+	Time    int64  `json:"time"`
+	Address []byte `json:"address"`
+	// Slashed is slashed from validator, VoterSlashed is slashed from its voters.
+	Slashed      linotypes.Coin `json:"slashed"`
+	VoterSlashed linotypes.Coin `json:"voter_slashed"`
+}
+
+// EvidenceHistory - all handled evidences of validator.
+type EvidenceHistory struct {
+	Evidences []Evidence `json:"evidences"`
+}
+
+// Tombstone - a consensus key that double signed and can never be used again.
+type Tombstone struct {
+	Username linotypes.AccountKey `json:"username"`
+	Height   int64                `json:"height"`
+}
+
+// KeyRotation - consensus key rotation of validator. It is applied at the
+// first block after RequestedAt, OldPubKey is set once applied.
+type KeyRotation struct {
+	NewPubKey   crypto.PubKey `json:"new_pubkey"`
+	OldPubKey   crypto.PubKey `json:"old_pubkey"`
+	RequestedAt int64         `json:"requested_at"`
+	AppliedAt   int64         `json:"applied_at"`
+}
+
+// SigningWindow - signing status of the recent Size blocks of committing validator.
+// Bit i of Bitmap is set if the block at position i is missed, Index is the
+// position of the next block.
+type SigningWindow struct {
+	Bitmap  []byte `json:"bitmap"`
+	Size    int64  `json:"size"`
+	Index   int64  `json:"index"`
+	Counted int64  `json:"counted"`
+	Missed  int64  `json:"missed"`
+}
+
+// NewSigningWindow - returns an empty window of size blocks.
+func NewSigningWindow(size int64) *SigningWindow {
+	return &SigningWindow{
+		Bitmap: make([]byte, (size+7)/8),
+		Size:   size,
+	}
+}
+
+// IsMissed - returns if block at position i is missed.
+func (w SigningWindow) IsMissed(i int64) bool {
+	return w.Bitmap[i/8]&(1<<uint(i%8)) != 0
+}
+
+// Record - record signing status of the latest block, the oldest block
+// is dropped once the window is full.
+func (w *SigningWindow) Record(signed bool) {
+	if w.Size <= 0 {
+		return
+	}
+	if w.Counted == w.Size && w.IsMissed(w.Index) {
+		w.Missed--
+	}
+	mask := byte(1 << uint(w.Index%8))
+	if signed {
+		w.Bitmap[w.Index/8] &^= mask
+	} else {
+		w.Bitmap[w.Index/8] |= mask
+		w.Missed++
+	}
+	if w.Counted < w.Size {
+		w.Counted++
+	}
+	w.Index = (w.Index + 1) % w.Size
+}
+
+// Recent - signing status of counted blocks from the oldest to the latest.
+func (w SigningWindow) Recent() []bool {
+	rst := make([]bool, 0, w.Counted)
+	for i := w.Size - w.Counted; i < w.Size; i++ {
+		rst = append(rst, !w.IsMissed((w.Index+i)%w.Size))
+	}
+	return rst
+}
+
+// Uptime - fraction of signed blocks in counted blocks, one if nothing counted.
+func (w SigningWindow) Uptime() sdk.Dec {
+	if w.Counted == 0 {
+		return sdk.OneDec()
+	}
+	return sdk.NewDec(w.Counted - w.Missed).QuoInt64(w.Counted)
+}
+
+// SigningInfo - recent signing status of validator, Bitmap lists blocks from
+// the oldest to the latest, 1 for signed and 0 for missed.
+type SigningInfo struct {
+	Username   linotypes.AccountKey `json:"username"`
+	WindowSize int64                `json:"window_size"`
+	Missed     int64                `json:"missed"`
+	Bitmap     string               `json:"bitmap"`
+	Uptime     sdk.Dec              `json:"uptime"`
+}
+
+// NewSigningInfo - signing info of username from its signing window.
+func NewSigningInfo(username linotypes.AccountKey, w SigningWindow) SigningInfo {
+	bitmap := make([]byte, 0, w.Counted)
+	for _, signed := range w.Recent() {
+		if signed {
+			bitmap = append(bitmap, '1')
+		} else {
+			bitmap = append(bitmap, '0')
+		}
+	}
+	return SigningInfo{
+		Username:   username,
+		WindowSize: w.Size,
+		Missed:     w.Missed,
+		Bitmap:     string(bitmap),
+		Uptime:     w.Uptime(),
+	}
+}
+
+// LedgerEntry - inflation, penalty or jail status change of validator.
+type LedgerEntry struct {
+	Time int64                 `json:"time"`
+	Type types.LedgerEntryType `json:"type"`
+	// Amount is the inflation allotted to validator or the slashed penalty.
+	Amount linotypes.Coin `json:"amount"`
+	// Commission is the part of inflation kept by validator, the rest is shared with voters.
+	Commission linotypes.Coin       `json:"commission"`
+	PunishType linotypes.PunishType `json:"punish_type"`
+	JailReason types.JailReason     `json:"jail_reason"`
+}
+
+// LedgerEntries - ledger entries of validator at the same time.
+type LedgerEntries struct {
+	Entries []LedgerEntry `json:"entries"`
+}
+
 // ValidatorList
 type ValidatorList struct {
 	Oncall             []linotypes.AccountKey `json:"oncall"`
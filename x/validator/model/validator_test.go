@@ -0,0 +1,67 @@
+package model
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningWindow(t *testing.T) {
+	w := NewSigningWindow(3)
+	assert.Equal(t, sdk.OneDec(), w.Uptime())
+	assert.Equal(t, []bool{}, w.Recent())
+
+	testCases := []struct {
+		testName       string
+		signed         bool
+		expectedMissed int64
+		expectedRecent []bool
+	}{
+		{
+			testName:       "missed first block",
+			signed:         false,
+			expectedMissed: 1,
+			expectedRecent: []bool{false},
+		},
+		{
+			testName:       "signed second block",
+			signed:         true,
+			expectedMissed: 1,
+			expectedRecent: []bool{false, true},
+		},
+		{
+			testName:       "window is full",
+			signed:         false,
+			expectedMissed: 2,
+			expectedRecent: []bool{false, true, false},
+		},
+		{
+			testName:       "oldest missed block is dropped",
+			signed:         true,
+			expectedMissed: 1,
+			expectedRecent: []bool{true, false, true},
+		},
+		{
+			testName:       "oldest signed block is dropped",
+			signed:         false,
+			expectedMissed: 2,
+			expectedRecent: []bool{false, true, false},
+		},
+	}
+	for _, tc := range testCases {
+		w.Record(tc.signed)
+		assert.Equal(t, tc.expectedMissed, w.Missed, tc.testName)
+		assert.Equal(t, tc.expectedRecent, w.Recent(), tc.testName)
+	}
+
+	info := NewSigningInfo(types.AccountKey("user"), *w)
+	assert.Equal(t, SigningInfo{
+		Username:   types.AccountKey("user"),
+		WindowSize: 3,
+		Missed:     2,
+		Bitmap:     "010",
+		Uptime:     types.NewDecFromRat(1, 3),
+	}, info)
+}
@@ -1,11 +1,15 @@
 package validator
 
 import (
+	"math"
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator/model"
 	"github.com/lino-network/lino/x/validator/types"
 )
 
@@ -22,6 +26,12 @@ const (
 	QueryValidator        = "validator"
 	QueryValidatorList    = "valList"
 	QueryElectionVoteList = "electionVoteList"
+	QueryVoterReward      = "voterReward"
+	QueryEvidence         = "evidence"
+	QuerySigningInfo      = "signingInfo"
+	QuerySigningInfos     = "signingInfos"
+	QuerySimulateVotes    = "simulateVotes"
+	QueryLedger           = "ledger"
 )
 
 // creates a querier for validator REST endpoints
@@ -36,6 +46,18 @@ func NewQuerier(vm ValidatorKeeper) sdk.Querier {
 			return queryValidatorList(ctx, cdc, path[1:], req, vm)
 		case QueryElectionVoteList:
 			return queryElectionVoteList(ctx, cdc, path[1:], req, vm)
+		case QueryVoterReward:
+			return queryVoterReward(ctx, cdc, path[1:], req, vm)
+		case QueryEvidence:
+			return queryEvidence(ctx, cdc, path[1:], req, vm)
+		case QuerySigningInfo:
+			return querySigningInfo(ctx, cdc, path[1:], req, vm)
+		case QuerySigningInfos:
+			return querySigningInfos(ctx, cdc, path[1:], req, vm)
+		case QuerySimulateVotes:
+			return querySimulateVotes(ctx, cdc, path[1:], req, vm)
+		case QueryLedger:
+			return queryLedger(ctx, cdc, path[1:], req, vm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown validator query endpoint")
 		}
@@ -77,3 +99,96 @@ func queryElectionVoteList(ctx sdk.Context, cdc *wire.Codec, path []string, req
 	}
 	return res, nil
 }
+
+func queryVoterReward(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm ValidatorKeeper) ([]byte, sdk.Error) {
+	if err := linotypes.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	reward := vm.GetVoterReward(ctx, linotypes.AccountKey(path[0]))
+	res, marshalErr := cdc.MarshalJSON(reward)
+	if marshalErr != nil {
+		return nil, types.ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryEvidence(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm ValidatorKeeper) ([]byte, sdk.Error) {
+	if err := linotypes.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	history := vm.GetEvidenceHistory(ctx, linotypes.AccountKey(path[0]))
+	res, marshalErr := cdc.MarshalJSON(history)
+	if marshalErr != nil {
+		return nil, types.ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func querySigningInfo(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm ValidatorKeeper) ([]byte, sdk.Error) {
+	if err := linotypes.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	info, err := vm.GetSigningInfo(ctx, linotypes.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(info)
+	if marshalErr != nil {
+		return nil, types.ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func querySigningInfos(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm ValidatorKeeper) ([]byte, sdk.Error) {
+	infos, err := vm.GetCommittingSigningInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(infos)
+	if marshalErr != nil {
+		return nil, types.ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// querySimulateVotes - req.Data is JSON of received votes changes, []model.ElectionVote.
+func querySimulateVotes(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm ValidatorKeeper) ([]byte, sdk.Error) {
+	changes := []model.ElectionVote{}
+	if err := cdc.UnmarshalJSON(req.Data, &changes); err != nil {
+		return nil, types.ErrInvalidVoteChanges(err.Error())
+	}
+	lst, err := vm.SimulateVoteChanges(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(lst)
+	if marshalErr != nil {
+		return nil, types.ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryLedger - path is username and optional start and end unix time, both inclusive.
+func queryLedger(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm ValidatorKeeper) ([]byte, sdk.Error) {
+	if err := linotypes.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, end := int64(0), int64(math.MaxInt64)
+	var parseErr error
+	if len(path) > 1 {
+		if start, parseErr = strconv.ParseInt(path[1], 10, 64); parseErr != nil {
+			return nil, types.ErrInvalidLedgerTimeRange()
+		}
+	}
+	if len(path) > 2 {
+		if end, parseErr = strconv.ParseInt(path[2], 10, 64); parseErr != nil {
+			return nil, types.ErrInvalidLedgerTimeRange()
+		}
+	}
+	entries := vm.GetLedger(ctx, linotypes.AccountKey(path[0]), start, end)
+	res, marshalErr := cdc.MarshalJSON(entries)
+	if marshalErr != nil {
+		return nil, types.ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -10,6 +10,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ValidatorRevokeMsg{}, "lino/valRevoke", nil)
 	cdc.RegisterConcrete(VoteValidatorMsg{}, "lino/voteValidator", nil)
 	cdc.RegisterConcrete(ValidatorUpdateMsg{}, "lino/valUpdate", nil)
+	cdc.RegisterConcrete(ValidatorUpdateCommissionMsg{}, "lino/valUpdateCommission", nil)
+	cdc.RegisterConcrete(ClaimValidatorRewardMsg{}, "lino/valClaimReward", nil)
+	cdc.RegisterConcrete(ValidatorUnjailMsg{}, "lino/valUnjail", nil)
+	cdc.RegisterConcrete(ValidatorRotateKeyMsg{}, "lino/valRotateKey", nil)
 }
 
 // ModuleCdc is the module codec
@@ -85,3 +85,72 @@ func ErrInvalidValidator() sdk.Error {
 	return linotypes.NewError(
 		linotypes.CodeInvalidValidator, fmt.Sprintf("not a validator or has revoked"))
 }
+
+// ErrInvalidCommissionRate - error if commission rate is negative or exceeds the max.
+func ErrInvalidCommissionRate(rate sdk.Dec) sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeInvalidCommissionRate, fmt.Sprintf("invalid commission rate: %s", rate))
+}
+
+// ErrCommissionUpdateTooFrequent - error if commission rate is updated within the change interval.
+func ErrCommissionUpdateTooFrequent() sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeCommissionUpdateTooFrequent, fmt.Sprintf("commission rate updated too frequently"))
+}
+
+// ErrCommissionChangeTooLarge - error if commission rate changes more than the max change rate.
+func ErrCommissionChangeTooLarge(rate sdk.Dec) sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeCommissionChangeTooLarge, fmt.Sprintf("commission rate change too large: %s", rate))
+}
+
+// ErrNoValidatorReward - error if voter has no unclaimed validator reward.
+func ErrNoValidatorReward(username linotypes.AccountKey) sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeNoValidatorReward, fmt.Sprintf("%s has no validator reward to claim", username))
+}
+
+// ErrValidatorNotJailed - error if validator attempting to unjail is not in jail.
+func ErrValidatorNotJailed(username linotypes.AccountKey) sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeValidatorNotJailed, fmt.Sprintf("validator %s is not in jail", username))
+}
+
+// ErrValidatorJailed - error if validator in jail attempts an operation of active validators.
+func ErrValidatorJailed(username linotypes.AccountKey) sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeValidatorJailed, fmt.Sprintf("validator %s is in jail", username))
+}
+
+// ErrUnjailTooEarly - error if validator unjails before the earliest unjail time.
+func ErrUnjailTooEarly(unjailAt int64) sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeUnjailTooEarly, fmt.Sprintf("can not unjail until %d", unjailAt))
+}
+
+// ErrValidatorPubKeyTombstoned - error if validator public key has double signed.
+func ErrValidatorPubKeyTombstoned() sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeValidatorPubKeyTombstoned, fmt.Sprintf("validator public key has been tombstoned"))
+}
+
+// ErrKeyRotationPending - error if validator rotates key before last rotation is applied.
+func ErrKeyRotationPending(username linotypes.AccountKey) sdk.Error {
+	return linotypes.NewError(
+		linotypes.CodeKeyRotationPending, fmt.Sprintf("validator %s has a pending key rotation", username))
+}
+
+// ErrInvalidValidatorPubKey - error if validator public key is missing.
+func ErrInvalidValidatorPubKey() sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidValidatorPubKey, fmt.Sprintf("invalid validator public key"))
+}
+
+// ErrInvalidVoteChanges - error if simulated vote changes can not be applied.
+func ErrInvalidVoteChanges(reason string) sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidVoteChanges, fmt.Sprintf("invalid vote changes: %s", reason))
+}
+
+// ErrInvalidLedgerTimeRange - error if ledger query time range can not be parsed.
+func ErrInvalidLedgerTimeRange() sdk.Error {
+	return linotypes.NewError(linotypes.CodeInvalidLedgerTimeRange, fmt.Sprintf("invalid ledger time range"))
+}
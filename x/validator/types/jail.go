@@ -0,0 +1,13 @@
+package types
+
+// JailReason - why a validator is put into jail.
+type JailReason int
+
+const (
+	JailReasonNone                JailReason = 0
+	JailReasonMissedCommits       JailReason = 1
+	JailReasonByzantine           JailReason = 2
+	JailReasonInsufficientDeposit JailReason = 3
+	// slashed too many times by penalties other than missed commits.
+	JailReasonSlashLimit JailReason = 4
+)
@@ -0,0 +1,11 @@
+package types
+
+// LedgerEntryType - type of validator ledger entry.
+type LedgerEntryType int
+
+const (
+	LedgerEntryInflation LedgerEntryType = 1
+	LedgerEntryPenalty   LedgerEntryType = 2
+	LedgerEntryJail      LedgerEntryType = 3
+	LedgerEntryUnjail    LedgerEntryType = 4
+)
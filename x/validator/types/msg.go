@@ -14,6 +14,10 @@ var _ types.Msg = ValidatorRegisterMsg{}
 var _ types.Msg = ValidatorRevokeMsg{}
 var _ types.Msg = VoteValidatorMsg{}
 var _ types.Msg = ValidatorUpdateMsg{}
+var _ types.Msg = ValidatorUpdateCommissionMsg{}
+var _ types.Msg = ClaimValidatorRewardMsg{}
+var _ types.Msg = ValidatorUnjailMsg{}
+var _ types.Msg = ValidatorRotateKeyMsg{}
 
 // ValidatorRegisterMsg - register to become validator
 type ValidatorRegisterMsg struct {
@@ -249,6 +253,219 @@ func (msg ValidatorUpdateMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+// ValidatorUpdateCommissionMsg - update commission rate of validator
+type ValidatorUpdateCommissionMsg struct {
+	Username       types.AccountKey `json:"username"`
+	CommissionRate sdk.Dec          `json:"commission_rate"`
+}
+
+// ValidatorUpdateCommissionMsg Msg Implementations
+func NewValidatorUpdateCommissionMsg(validator string, rate sdk.Dec) ValidatorUpdateCommissionMsg {
+	return ValidatorUpdateCommissionMsg{
+		Username:       types.AccountKey(validator),
+		CommissionRate: rate,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) Type() string { return "ValidatorUpdateCommissionMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) ValidateBasic() sdk.Error {
+	if !msg.Username.IsValid() {
+		return ErrInvalidUsername()
+	}
+
+	if msg.CommissionRate.IsNil() || msg.CommissionRate.IsNegative() ||
+		msg.CommissionRate.GT(sdk.OneDec()) {
+		return ErrInvalidCommissionRate(msg.CommissionRate)
+	}
+
+	return nil
+}
+
+func (msg ValidatorUpdateCommissionMsg) String() string {
+	return fmt.Sprintf("ValidatorUpdateCommissionMsg{Username:%v, CommissionRate:%v}", msg.Username, msg.CommissionRate)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUpdateCommissionMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUpdateCommissionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ClaimValidatorRewardMsg - voter claims validator inflation shared to it
+type ClaimValidatorRewardMsg struct {
+	Username types.AccountKey `json:"username"`
+}
+
+// ClaimValidatorRewardMsg Msg Implementations
+func NewClaimValidatorRewardMsg(username string) ClaimValidatorRewardMsg {
+	return ClaimValidatorRewardMsg{
+		Username: types.AccountKey(username),
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ClaimValidatorRewardMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ClaimValidatorRewardMsg) Type() string { return "ClaimValidatorRewardMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ClaimValidatorRewardMsg) ValidateBasic() sdk.Error {
+	if !msg.Username.IsValid() {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ClaimValidatorRewardMsg) String() string {
+	return fmt.Sprintf("ClaimValidatorRewardMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implement types.Msg
+func (msg ClaimValidatorRewardMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ClaimValidatorRewardMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ClaimValidatorRewardMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ClaimValidatorRewardMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorUnjailMsg - jailed validator rejoins as candidate
+type ValidatorUnjailMsg struct {
+	Username types.AccountKey `json:"username"`
+}
+
+// ValidatorUnjailMsg Msg Implementations
+func NewValidatorUnjailMsg(validator string) ValidatorUnjailMsg {
+	return ValidatorUnjailMsg{
+		Username: types.AccountKey(validator),
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ValidatorUnjailMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ValidatorUnjailMsg) Type() string { return "ValidatorUnjailMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUnjailMsg) ValidateBasic() sdk.Error {
+	if !msg.Username.IsValid() {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ValidatorUnjailMsg) String() string {
+	return fmt.Sprintf("ValidatorUnjailMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUnjailMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUnjailMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUnjailMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUnjailMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorRotateKeyMsg - replace consensus key of validator from next block
+type ValidatorRotateKeyMsg struct {
+	Username  types.AccountKey `json:"username"`
+	ValPubKey crypto.PubKey    `json:"validator_public_key"`
+}
+
+// ValidatorRotateKeyMsg Msg Implementations
+func NewValidatorRotateKeyMsg(validator string, pubKey crypto.PubKey) ValidatorRotateKeyMsg {
+	return ValidatorRotateKeyMsg{
+		Username:  types.AccountKey(validator),
+		ValPubKey: pubKey,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) Type() string { return "ValidatorRotateKeyMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) ValidateBasic() sdk.Error {
+	if !msg.Username.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if msg.ValPubKey == nil {
+		return ErrInvalidValidatorPubKey()
+	}
+	return nil
+}
+
+func (msg ValidatorRotateKeyMsg) String() string {
+	return fmt.Sprintf("ValidatorRotateKeyMsg{Username:%v, PubKey:%v}", msg.Username, msg.ValPubKey)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorRotateKeyMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorRotateKeyMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 // utils
 func getSignBytes(msg sdk.Msg) []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
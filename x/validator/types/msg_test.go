@@ -89,6 +89,135 @@ func TestValidatorUpdateMsg(t *testing.T) {
 	}
 }
 
+func TestValidatorUpdateCommissionMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorUpdateCommissionMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorUpdateCommissionMsg("user1", types.NewDecFromRat(1, 10)),
+			expectedError: nil,
+		},
+		{
+			testName:      "zero commission",
+			msg:           NewValidatorUpdateCommissionMsg("user1", sdk.ZeroDec()),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorUpdateCommissionMsg("", types.NewDecFromRat(1, 10)),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "negative commission",
+			msg:           NewValidatorUpdateCommissionMsg("user1", types.NewDecFromRat(-1, 10)),
+			expectedError: ErrInvalidCommissionRate(types.NewDecFromRat(-1, 10)),
+		},
+		{
+			testName:      "commission larger than one",
+			msg:           NewValidatorUpdateCommissionMsg("user1", types.NewDecFromRat(11, 10)),
+			expectedError: ErrInvalidCommissionRate(types.NewDecFromRat(11, 10)),
+		},
+		{
+			testName:      "nil commission",
+			msg:           ValidatorUpdateCommissionMsg{Username: "user1"},
+			expectedError: ErrInvalidCommissionRate(sdk.Dec{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestClaimValidatorRewardMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ClaimValidatorRewardMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewClaimValidatorRewardMsg("user1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewClaimValidatorRewardMsg(""),
+			expectedError: ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorUnjailMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorUnjailMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorUnjailMsg("user1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorUnjailMsg(""),
+			expectedError: ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorRotateKeyMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorRotateKeyMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorRotateKeyMsg("user1", secp256k1.GenPrivKey().PubKey()),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorRotateKeyMsg("", secp256k1.GenPrivKey().PubKey()),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "missing pubkey",
+			msg:           NewValidatorRotateKeyMsg("user1", nil),
+			expectedError: ErrInvalidValidatorPubKey(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestVoteValidatorMsg(t *testing.T) {
 	testCases := []struct {
 		testName      string
@@ -147,6 +276,26 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewValidatorUpdateMsg("test", "asd"),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "update commission msg",
+			msg:                NewValidatorUpdateCommissionMsg("test", types.NewDecFromRat(1, 10)),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "claim validator reward msg",
+			msg:                NewClaimValidatorRewardMsg("test"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator unjail msg",
+			msg:                NewValidatorUnjailMsg("test"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator rotate key msg",
+			msg:                NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+			expectedPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -181,6 +330,22 @@ func TestGetSignBytes(t *testing.T) {
 			msg: NewValidatorUpdateMsg(
 				"test", "https://lino.network"),
 		},
+		{
+			testName: "update commission msg",
+			msg:      NewValidatorUpdateCommissionMsg("test", types.NewDecFromRat(1, 10)),
+		},
+		{
+			testName: "claim validator reward msg",
+			msg:      NewClaimValidatorRewardMsg("test"),
+		},
+		{
+			testName: "validator unjail msg",
+			msg:      NewValidatorUnjailMsg("test"),
+		},
+		{
+			testName: "validator rotate key msg",
+			msg:      NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+		},
 	}
 
 	for testName, tc := range testCases {
@@ -215,6 +380,26 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewValidatorUpdateMsg("test", "https://lino.network"),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName:      "update commission msg",
+			msg:           NewValidatorUpdateCommissionMsg("test", types.NewDecFromRat(1, 10)),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "claim validator reward msg",
+			msg:           NewClaimValidatorRewardMsg("test"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator unjail msg",
+			msg:           NewValidatorUnjailMsg("test"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator rotate key msg",
+			msg:           NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+			expectSigners: []types.AccountKey{"test"},
+		},
 	}
 
 	for _, tc := range testCases {
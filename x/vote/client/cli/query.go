@@ -27,6 +27,22 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			"stake-stats <day>", "stake-stats <day>",
 			types.QuerierRoute, types.QueryStakeStats,
 			1, &model.LinoStakeStat{})(cdc),
+		utils.SimpleQueryCmd(
+			"duties", "duties",
+			types.QuerierRoute, types.QueryDuties,
+			0, &[]types.DutySpec{})(cdc),
+		utils.SimpleQueryCmd(
+			"treasury-spend <id>", "treasury-spend <id>",
+			types.QuerierRoute, types.QueryTreasurySpend,
+			1, &model.TreasurySpend{})(cdc),
+		utils.SimpleQueryCmd(
+			"treasury-spends <start> <limit>", "treasury-spends <start> <limit>",
+			types.QuerierRoute, types.QueryTreasurySpends,
+			2, &model.TreasurySpendList{})(cdc),
+		utils.SimpleQueryCmd(
+			"treasury-spend-votes <id>", "treasury-spend-votes <id>",
+			types.QuerierRoute, types.QueryTreasurySpendVotes,
+			1, &[]model.TreasurySpendVote{})(cdc),
 	)...)
 	return cmd
 }
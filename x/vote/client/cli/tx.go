@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,8 +13,11 @@ import (
 )
 
 const (
-	FlagAmount = "amount"
-	FlagTo     = "to"
+	FlagAmount  = "amount"
+	FlagTo      = "to"
+	FlagReason  = "reason"
+	FlagDeposit = "deposit"
+	FlagApprove = "approve"
 )
 
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
@@ -29,6 +34,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdStakeout(cdc),
 		GetCmdClaimInterest(cdc),
 		GetCmdStakeinFor(cdc),
+		GetCmdProposeTreasurySpend(cdc),
+		GetCmdVoteTreasurySpend(cdc),
 	)...)
 
 	return cmd
@@ -121,3 +128,56 @@ func GetCmdStakeinFor(cdc *codec.Codec) *cobra.Command {
 	_ = cmd.MarkFlagRequired(FlagAmount)
 	return cmd
 }
+
+// GetCmdProposeTreasurySpend -
+func GetCmdProposeTreasurySpend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-treasury-spend",
+		Short: "propose-treasury-spend <proposer> --to <receiver> --amount <lino> --deposit <lino> --reason <reason>",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			msg := types.ProposeTreasurySpendMsg{
+				Proposer: linotypes.AccountKey(args[0]),
+				Receiver: linotypes.AccountKey(viper.GetString(FlagTo)),
+				Amount:   viper.GetString(FlagAmount),
+				Deposit:  viper.GetString(FlagDeposit),
+				Reason:   viper.GetString(FlagReason),
+			}
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().String(FlagTo, "", "receiver username")
+	cmd.Flags().String(FlagAmount, "", "amount of lino released from treasury")
+	cmd.Flags().String(FlagDeposit, "", "deposit of the spend proposal")
+	cmd.Flags().String(FlagReason, "", "reason of the spend")
+	_ = cmd.MarkFlagRequired(FlagTo)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	_ = cmd.MarkFlagRequired(FlagDeposit)
+	return cmd
+}
+
+// GetCmdVoteTreasurySpend -
+func GetCmdVoteTreasurySpend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-treasury-spend",
+		Short: "vote-treasury-spend <voter> <id> --approve=<true|false>",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper().WithTxEncoder(linotypes.TxEncoder(cdc))
+			id, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.VoteTreasurySpendMsg{
+				Voter:   linotypes.AccountKey(args[0]),
+				ID:      id,
+				Approve: viper.GetBool(FlagApprove),
+			}
+			return ctx.DoTxPrintResponse(msg)
+		},
+	}
+	cmd.Flags().Bool(FlagApprove, false, "approve or reject the spend")
+	_ = cmd.MarkFlagRequired(FlagApprove)
+	return cmd
+}
@@ -22,6 +22,10 @@ func NewHandler(vk VoteKeeper) sdk.Handler {
 			return handleClaimInterestMsg(ctx, vk, msg)
 		case types.StakeInForMsg:
 			return handleStakeInForMsg(ctx, vk, msg)
+		case types.ProposeTreasurySpendMsg:
+			return handleProposeTreasurySpendMsg(ctx, vk, msg)
+		case types.VoteTreasurySpendMsg:
+			return handleVoteTreasurySpendMsg(ctx, vk, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized vote msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -72,3 +76,25 @@ func handleClaimInterestMsg(ctx sdk.Context, vk VoteKeeper, msg types.ClaimInter
 	}
 	return sdk.Result{}
 }
+
+func handleProposeTreasurySpendMsg(ctx sdk.Context, vk VoteKeeper, msg types.ProposeTreasurySpendMsg) sdk.Result {
+	coin, err := linotypes.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	deposit, err := linotypes.LinoToCoin(msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+	if _, err := vk.ProposeTreasurySpend(ctx, msg.Proposer, msg.Receiver, coin, deposit, msg.Reason); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleVoteTreasurySpendMsg(ctx sdk.Context, vk VoteKeeper, msg types.VoteTreasurySpendMsg) sdk.Result {
+	if err := vk.VoteTreasurySpend(ctx, msg.Voter, msg.ID, msg.Approve); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
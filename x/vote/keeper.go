@@ -14,6 +14,7 @@ import (
 
 type VoteKeeper interface {
 	InitGenesis(ctx sdk.Context)
+	RegisterDuty(spec types.DutySpec)
 	DoesVoterExist(ctx sdk.Context, username linotypes.AccountKey) bool
 	StakeIn(ctx sdk.Context, username linotypes.AccountKey, amount linotypes.Coin) sdk.Error
 	StakeOut(ctx sdk.Context, username linotypes.AccountKey, amount linotypes.Coin) sdk.Error
@@ -23,16 +24,23 @@ type VoteKeeper interface {
 		ctx sdk.Context, username linotypes.AccountKey, duty types.VoterDuty, frozenAmount linotypes.Coin) sdk.Error
 	// It's caller's duty to move coins from stake-in pool to the destination pool.
 	SlashStake(ctx sdk.Context, username linotypes.AccountKey, amount linotypes.Coin, destPool linotypes.PoolName) (linotypes.Coin, sdk.Error)
-	UnassignDuty(ctx sdk.Context, username linotypes.AccountKey, waitingPeriodSec int64) sdk.Error
+	UnassignDuty(ctx sdk.Context, username linotypes.AccountKey, duty types.VoterDuty, waitingPeriodSec int64) sdk.Error
 	ExecUnassignDutyEvent(ctx sdk.Context, event types.UnassignDutyEvent) sdk.Error
 	GetLinoStake(ctx sdk.Context, username linotypes.AccountKey) (linotypes.Coin, sdk.Error)
 	StakeInFor(ctx sdk.Context, sender linotypes.AccountKey, receiver linotypes.AccountKey, amount linotypes.Coin) sdk.Error
 	RecordFriction(ctx sdk.Context, friction linotypes.Coin) sdk.Error
 	DailyAdvanceLinoStakeStats(ctx sdk.Context) sdk.Error
+	ProposeTreasurySpend(ctx sdk.Context, proposer, receiver linotypes.AccountKey, amount, deposit linotypes.Coin, reason string) (int64, sdk.Error)
+	VoteTreasurySpend(ctx sdk.Context, voter linotypes.AccountKey, id int64, approve bool) sdk.Error
+	ExecDecideTreasurySpendEvent(ctx sdk.Context, event types.DecideTreasurySpendEvent) sdk.Error
 
 	// Getter
 	GetVoter(ctx sdk.Context, username linotypes.AccountKey) (*model.Voter, sdk.Error)
 	GetStakeStatsOfDay(ctx sdk.Context, day int64) (*model.LinoStakeStat, sdk.Error)
+	GetDutySpecs(ctx sdk.Context) []types.DutySpec
+	GetTreasurySpend(ctx sdk.Context, id int64) (*model.TreasurySpend, sdk.Error)
+	GetTreasurySpends(ctx sdk.Context, start, limit int64) (*model.TreasurySpendList, sdk.Error)
+	GetTreasurySpendVotes(ctx sdk.Context, id int64) ([]model.TreasurySpendVote, sdk.Error)
 
 	// import export
 	ExportToFile(ctx sdk.Context, cdc *codec.Codec, filepath string) error
@@ -2,6 +2,7 @@ package manager
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 
 	codec "github.com/cosmos/cosmos-sdk/codec"
@@ -18,8 +19,10 @@ import (
 )
 
 const (
-	exportVersion = 2
-	importVersion = 2
+	exportVersion = 4
+	importVersion = 4
+	// voters hold at most one duty before version 3.
+	singleDutyVersion = 2
 )
 
 // VoteManager - vote manager
@@ -31,18 +34,26 @@ type VoteManager struct {
 	am          acc.AccountKeeper
 	gm          global.GlobalKeeper
 
+	// registered duties
+	duties types.DutyRegistry
+
 	// mutable hooks
 	hooks StakingHooks
 }
 
 // NewVoteManager - new vote manager
 func NewVoteManager(key sdk.StoreKey, holder param.ParamKeeper, am acc.AccountKeeper, gm global.GlobalKeeper) VoteManager {
-	return VoteManager{
+	vm := VoteManager{
 		am:          am,
 		storage:     model.NewVoteStorage(key),
 		paramHolder: holder,
 		gm:          gm,
+		duties:      make(types.DutyRegistry),
 	}
+	// app and validator can not be held together with other duties.
+	vm.RegisterDuty(types.DutySpec{Duty: types.DutyApp, Name: "app", Exclusive: true})
+	vm.RegisterDuty(types.DutySpec{Duty: types.DutyValidator, Name: "validator", Exclusive: true})
+	return vm
 }
 
 func (vm VoteManager) InitGenesis(ctx sdk.Context) {
@@ -64,6 +75,18 @@ func (vm *VoteManager) SetHooks(sh StakingHooks) *VoteManager {
 	return vm
 }
 
+// RegisterDuty - register a duty type, so that it can be assigned to voters.
+// It must be called when app is initialized, before any block is processed.
+func (vm VoteManager) RegisterDuty(spec types.DutySpec) {
+	if spec.Duty == types.DutyVoter || spec.Duty == types.DutyPending {
+		panic(fmt.Sprintf("cannot register reserved duty: %d", spec.Duty))
+	}
+	if _, ok := vm.duties[spec.Duty]; ok {
+		panic(fmt.Sprintf("cannot register duty twice: %d", spec.Duty))
+	}
+	vm.duties[spec.Duty] = spec
+}
+
 // DoesVoterExist - check if voter exist or not
 func (vm VoteManager) DoesVoterExist(ctx sdk.Context, username linotypes.AccountKey) bool {
 	return vm.storage.DoesVoterExist(ctx, username)
@@ -109,7 +132,6 @@ func (vm VoteManager) addStake(ctx sdk.Context, username linotypes.AccountKey, a
 			Username:          username,
 			LinoStake:         linotypes.NewCoinFromInt64(0),
 			LastPowerChangeAt: ctx.BlockHeader().Time.Unix(),
-			Duty:              types.DutyVoter,
 			Interest:          linotypes.NewCoinFromInt64(0),
 		}
 	}
@@ -177,7 +199,7 @@ func (vm VoteManager) minusStake(ctx sdk.Context, username linotypes.AccountKey,
 	}
 
 	// make sure stake is sufficient excludes frozen amount
-	if !voter.LinoStake.Minus(voter.FrozenAmount).IsGTE(amount) {
+	if !voter.LinoStake.Minus(voter.FrozenAmount()).IsGTE(amount) {
 		return types.ErrInsufficientStake()
 	}
 
@@ -222,47 +244,55 @@ func (vm VoteManager) ClaimInterest(ctx sdk.Context, username linotypes.AccountK
 }
 
 // AssignDuty froze some amount of stake and assign a duty to user.
+// A voter can hold multiple duties, unless one of them is exclusive.
+// Stake frozen by existing duties can not be frozen again.
 func (vm VoteManager) AssignDuty(ctx sdk.Context, username linotypes.AccountKey, duty types.VoterDuty, frozenAmount linotypes.Coin) sdk.Error {
 	if frozenAmount.IsNegative() {
 		return types.ErrNegativeFrozenAmount()
 	}
+	spec, ok := vm.duties[duty]
+	if !ok {
+		return types.ErrDutyNotRegistered(duty)
+	}
 	voter, err := vm.storage.GetVoter(ctx, username)
 	if err != nil {
 		return err
 	}
-	if voter.Duty != types.DutyVoter {
-		return types.ErrNotAVoterOrHasDuty()
-	}
-
-	if voter.FrozenAmount.IsPositive() {
-		return types.ErrFrozenAmountIsNotEmpty()
+	for _, d := range voter.Duties {
+		if d.Duty == duty || spec.Exclusive || vm.duties[d.Duty].Exclusive {
+			return types.ErrNotAVoterOrHasDuty()
+		}
 	}
 
-	if !voter.LinoStake.IsGTE(frozenAmount) {
+	if !voter.LinoStake.Minus(voter.FrozenAmount()).IsGTE(frozenAmount) {
 		return types.ErrInsufficientStake()
 	}
 
-	voter.Duty = duty
-	voter.FrozenAmount = frozenAmount
+	voter.Duties = append(voter.Duties, model.DutyRecord{
+		Duty:         duty,
+		FrozenAmount: frozenAmount,
+	})
 	vm.storage.SetVoter(ctx, voter)
 	return nil
 }
 
-// UnassignDuty register unassign duty event with time after waitingPeriodSec seconds.
-func (vm VoteManager) UnassignDuty(ctx sdk.Context, username linotypes.AccountKey, waitingPeriodSec int64) sdk.Error {
+// UnassignDuty register unassign duty event of the duty with time after waitingPeriodSec seconds.
+func (vm VoteManager) UnassignDuty(ctx sdk.Context, username linotypes.AccountKey, duty types.VoterDuty, waitingPeriodSec int64) sdk.Error {
 	voter, err := vm.storage.GetVoter(ctx, username)
 	if err != nil {
 		return err
 	}
-	if voter.Duty == types.DutyVoter || voter.Duty == types.DutyPending {
+	idx := voter.FindDuty(duty)
+	if idx < 0 || voter.Duties[idx].IsPending {
 		return types.ErrNoDuty()
 	}
+	unassignAt := ctx.BlockHeader().Time.Unix() + waitingPeriodSec
 	if err := vm.gm.RegisterEventAtTime(
-		ctx, ctx.BlockHeader().Time.Unix()+waitingPeriodSec,
-		types.UnassignDutyEvent{Username: username}); err != nil {
+		ctx, unassignAt, types.UnassignDutyEvent{Username: username, Duty: duty}); err != nil {
 		return err
 	}
-	voter.Duty = types.DutyPending
+	voter.Duties[idx].IsPending = true
+	voter.Duties[idx].UnassignAt = unassignAt
 	vm.storage.SetVoter(ctx, voter)
 	return nil
 }
@@ -314,9 +344,16 @@ func (vm VoteManager) ExecUnassignDutyEvent(ctx sdk.Context, event types.Unassig
 	if err != nil {
 		return err
 	}
-	// set frozen amount to zero and duty to voter
-	voter.FrozenAmount = linotypes.NewCoinFromInt64(0)
-	voter.Duty = types.DutyVoter
+	// remove the pending duty, which releases its frozen amount.
+	// events registered without a duty release all pending duties.
+	var duties []model.DutyRecord
+	for _, d := range voter.Duties {
+		if d.IsPending && (event.Duty == types.DutyVoter || d.Duty == event.Duty) {
+			continue
+		}
+		duties = append(duties, d)
+	}
+	voter.Duties = duties
 	vm.storage.SetVoter(ctx, voter)
 	return nil
 }
@@ -420,12 +457,32 @@ func (vm VoteManager) GetVoter(ctx sdk.Context, username linotypes.AccountKey) (
 	return vm.storage.GetVoter(ctx, username)
 }
 
+// GetVoterDuty - return DutyVoter if voter does not hold any duty, the first active duty
+// if there is any, otherwise DutyPending as all duties are in unassign period.
 func (vm VoteManager) GetVoterDuty(ctx sdk.Context, username linotypes.AccountKey) (types.VoterDuty, sdk.Error) {
 	voter, err := vm.storage.GetVoter(ctx, username)
 	if err != nil {
 		return types.DutyVoter, err
 	}
-	return voter.Duty, nil
+	if len(voter.Duties) == 0 {
+		return types.DutyVoter, nil
+	}
+	for _, d := range voter.Duties {
+		if !d.IsPending {
+			return d.Duty, nil
+		}
+	}
+	return types.DutyPending, nil
+}
+
+// GetDutySpecs - all registered duties, sorted by duty.
+func (vm VoteManager) GetDutySpecs(ctx sdk.Context) []types.DutySpec {
+	rst := make([]types.DutySpec, 0, len(vm.duties))
+	for _, spec := range vm.duties {
+		rst = append(rst, spec)
+	}
+	sort.Slice(rst, func(i, j int) bool { return rst[i].Duty < rst[j].Duty })
+	return rst
 }
 
 func (vm VoteManager) GetLinoStake(ctx sdk.Context, username linotypes.AccountKey) (linotypes.Coin, sdk.Error) {
@@ -451,7 +508,16 @@ func (vm VoteManager) ExportToFile(ctx sdk.Context, cdc *codec.Codec, filepath s
 	// export voters
 	storeMap[string(model.VoterSubstore)].Iterate(func(key []byte, val interface{}) bool {
 		voter := val.(*model.Voter)
-		state.Voters = append(state.Voters, model.VoterIR(*voter))
+		voterir := model.VoterIR{
+			Username:          voter.Username,
+			LinoStake:         voter.LinoStake,
+			LastPowerChangeAt: voter.LastPowerChangeAt,
+			Interest:          voter.Interest,
+		}
+		for _, d := range voter.Duties {
+			voterir.Duties = append(voterir.Duties, model.DutyRecordIR(d))
+		}
+		state.Voters = append(state.Voters, voterir)
 		return false
 	})
 
@@ -469,6 +535,30 @@ func (vm VoteManager) ExportToFile(ctx sdk.Context, cdc *codec.Codec, filepath s
 		return false
 	})
 
+	// export treasury spends with their votes
+	vm.storage.StoreMap(ctx)[string(model.TreasurySpendSubstore)].Iterate(func(key []byte, val interface{}) bool {
+		spend := val.(*model.TreasurySpend)
+		spendir := model.TreasurySpendIR{
+			ID:        spend.ID,
+			Proposer:  spend.Proposer,
+			Receiver:  spend.Receiver,
+			Amount:    spend.Amount,
+			Deposit:   spend.Deposit,
+			Reason:    spend.Reason,
+			CreatedAt: spend.CreatedAt,
+			DecideAt:  spend.DecideAt,
+			Status:    spend.Status,
+			Approve:   spend.Approve,
+			Reject:    spend.Reject,
+		}
+		for _, vote := range vm.storage.GetTreasurySpendVotes(ctx, spend.ID) {
+			spendir.Votes = append(spendir.Votes, model.TreasurySpendVoteIR(vote))
+		}
+		state.TreasurySpends = append(state.TreasurySpends, spendir)
+		return false
+	})
+	state.TreasurySpendNextID = vm.storage.GetTreasuryNextID(ctx)
+
 	return utils.Save(filepath, cdc, state)
 
 }
@@ -481,12 +571,29 @@ func (vm VoteManager) ImportFromFile(ctx sdk.Context, cdc *codec.Codec, filepath
 	}
 	table := rst.(*model.VoterTablesIR)
 
-	if table.Version != importVersion {
+	switch table.Version {
+	case singleDutyVersion:
+		rst, err := utils.Load(filepath, cdc, func() interface{} { return &model.VoterTablesV2IR{} })
+		if err != nil {
+			return err
+		}
+		table = convertVoterTablesV2(rst.(*model.VoterTablesV2IR))
+	case singleDutyVersion + 1, importVersion:
+		// version 3 has no treasury spends, which are imported as empty.
+	default:
 		return fmt.Errorf("unsupported import version: %d", table.Version)
 	}
 
 	for _, voterir := range table.Voters {
-		voter := model.Voter(voterir)
+		voter := model.Voter{
+			Username:          voterir.Username,
+			LinoStake:         voterir.LinoStake,
+			LastPowerChangeAt: voterir.LastPowerChangeAt,
+			Interest:          voterir.Interest,
+		}
+		for _, d := range voterir.Duties {
+			voter.Duties = append(voter.Duties, model.DutyRecord(d))
+		}
 		vm.storage.SetVoter(ctx, &voter)
 	}
 
@@ -496,5 +603,54 @@ func (vm VoteManager) ImportFromFile(ctx sdk.Context, cdc *codec.Codec, filepath
 		vm.storage.SetLinoStakeStat(ctx, v.Day, &stat)
 	}
 
+	for _, spendir := range table.TreasurySpends {
+		vm.storage.SetTreasurySpend(ctx, &model.TreasurySpend{
+			ID:        spendir.ID,
+			Proposer:  spendir.Proposer,
+			Receiver:  spendir.Receiver,
+			Amount:    spendir.Amount,
+			Deposit:   spendir.Deposit,
+			Reason:    spendir.Reason,
+			CreatedAt: spendir.CreatedAt,
+			DecideAt:  spendir.DecideAt,
+			Status:    spendir.Status,
+			Approve:   spendir.Approve,
+			Reject:    spendir.Reject,
+		})
+		for _, vote := range spendir.Votes {
+			v := model.TreasurySpendVote(vote)
+			vm.storage.SetTreasurySpendVote(ctx, spendir.ID, &v)
+		}
+	}
+	vm.storage.SetTreasuryNextID(ctx, table.TreasurySpendNextID)
+
 	return nil
 }
+
+// convertVoterTablesV2 - convert single duty voters to duty records. The duty
+// being unassigned is unknown for pending voters, so it is kept as a pending
+// DutyPending record, which is released by the legacy unassign event that
+// carries no duty.
+func convertVoterTablesV2(v2 *model.VoterTablesV2IR) *model.VoterTablesIR {
+	table := &model.VoterTablesIR{
+		Version:    v2.Version,
+		StakeStats: v2.StakeStats,
+	}
+	for _, v := range v2.Voters {
+		voterir := model.VoterIR{
+			Username:          v.Username,
+			LinoStake:         v.LinoStake,
+			LastPowerChangeAt: v.LastPowerChangeAt,
+			Interest:          v.Interest,
+		}
+		if v.Duty != types.DutyVoter {
+			voterir.Duties = []model.DutyRecordIR{{
+				Duty:         v.Duty,
+				FrozenAmount: v.FrozenAmount,
+				IsPending:    v.Duty == types.DutyPending,
+			}}
+		}
+		table.Voters = append(table.Voters, voterir)
+	}
+	return table
+}
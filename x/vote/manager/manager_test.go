@@ -17,6 +17,7 @@ import (
 	"github.com/lino-network/lino/testsuites"
 	"github.com/lino-network/lino/testutils"
 	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/utils"
 	accmn "github.com/lino-network/lino/x/account/manager"
 	acc "github.com/lino-network/lino/x/account/mocks"
 	global "github.com/lino-network/lino/x/global/mocks"
@@ -39,6 +40,12 @@ var (
 	kvStoreKey  = sdk.NewKVStoreKey(storeKeyStr)
 )
 
+// non-exclusive duties for testing.
+const (
+	testDutyA types.VoterDuty = 100
+	testDutyB types.VoterDuty = 101
+)
+
 type VoteStoreDumper struct{}
 
 func (dumper VoteStoreDumper) NewDumper() *testutils.Dumper {
@@ -85,6 +92,8 @@ func (suite *VoteManagerTestSuite) SetupTest() {
 	suite.hooks = &hk.StakingHooks{}
 	suite.vm = NewVoteManager(kvStoreKey, suite.ph, suite.am, suite.global)
 	suite.vm = *suite.vm.SetHooks(suite.hooks)
+	suite.vm.RegisterDuty(types.DutySpec{Duty: testDutyA, Name: "testa"})
+	suite.vm.RegisterDuty(types.DutySpec{Duty: testDutyB, Name: "testb"})
 
 	suite.minStakeInAmount = linotypes.NewCoinFromInt64(1000 * linotypes.Decimals)
 	suite.returnIntervalSec = 100
@@ -148,8 +157,6 @@ func (suite *VoteManagerTestSuite) TestStakeIn() {
 				Username:          user1,
 				LinoStake:         suite.minStakeInAmount,
 				Interest:          linotypes.NewCoinFromInt64(0),
-				Duty:              types.DutyVoter,
-				FrozenAmount:      linotypes.NewCoinFromInt64(0),
 				LastPowerChangeAt: 100,
 			},
 			expetecStats: &model.LinoStakeStat{
@@ -232,8 +239,6 @@ func (suite *VoteManagerTestSuite) TestStakeInFor() {
 				Username:          user2,
 				LinoStake:         suite.minStakeInAmount,
 				Interest:          linotypes.NewCoinFromInt64(0),
-				Duty:              types.DutyVoter,
-				FrozenAmount:      linotypes.NewCoinFromInt64(0),
 				LastPowerChangeAt: 100,
 			},
 			expetecStats: &model.LinoStakeStat{
@@ -453,8 +458,6 @@ func (suite *VoteManagerTestSuite) TestStakeOut() {
 				Username:          suite.user1,
 				LinoStake:         linotypes.NewCoinFromInt64(1000 * linotypes.Decimals),
 				Interest:          linotypes.NewCoinFromInt64(888 * linotypes.Decimals),
-				Duty:              types.DutyVoter,
-				FrozenAmount:      linotypes.NewCoinFromInt64(0),
 				LastPowerChangeAt: 1,
 			},
 		},
@@ -539,8 +542,6 @@ func (suite *VoteManagerTestSuite) TestClaimInterest() {
 						Username:          suite.user1,
 						LinoStake:         linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
 						Interest:          linotypes.NewCoinFromInt64(0),
-						Duty:              types.DutyVoter,
-						FrozenAmount:      linotypes.NewCoinFromInt64(0),
 						LastPowerChangeAt: 1,
 					},
 				},
@@ -552,8 +553,6 @@ func (suite *VoteManagerTestSuite) TestClaimInterest() {
 						Username:          suite.user1,
 						LinoStake:         linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
 						Interest:          linotypes.NewCoinFromInt64(0),
-						Duty:              types.DutyVoter,
-						FrozenAmount:      linotypes.NewCoinFromInt64(0),
 						LastPowerChangeAt: 1,
 					},
 				},
@@ -572,8 +571,6 @@ func (suite *VoteManagerTestSuite) TestClaimInterest() {
 						Username:          suite.user1,
 						LinoStake:         linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
 						Interest:          linotypes.NewCoinFromInt64(0),
-						Duty:              types.DutyVoter,
-						FrozenAmount:      linotypes.NewCoinFromInt64(0),
 						LastPowerChangeAt: 2,
 					},
 				},
@@ -591,8 +588,6 @@ func (suite *VoteManagerTestSuite) TestClaimInterest() {
 						Username:          suite.user2,
 						LinoStake:         linotypes.NewCoinFromInt64(1000 * linotypes.Decimals),
 						Interest:          linotypes.NewCoinFromInt64(0),
-						Duty:              types.DutyVoter,
-						FrozenAmount:      linotypes.NewCoinFromInt64(0),
 						LastPowerChangeAt: 2,
 					},
 				},
@@ -611,8 +606,6 @@ func (suite *VoteManagerTestSuite) TestClaimInterest() {
 						Username:          suite.user1,
 						LinoStake:         linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
 						Interest:          linotypes.NewCoinFromInt64(0),
-						Duty:              types.DutyVoter,
-						FrozenAmount:      linotypes.NewCoinFromInt64(0),
 						LastPowerChangeAt: 2,
 					},
 				},
@@ -624,8 +617,6 @@ func (suite *VoteManagerTestSuite) TestClaimInterest() {
 						Username:          suite.user2,
 						LinoStake:         linotypes.NewCoinFromInt64(1000 * linotypes.Decimals),
 						Interest:          linotypes.NewCoinFromInt64(0),
-						Duty:              types.DutyVoter,
-						FrozenAmount:      linotypes.NewCoinFromInt64(0),
 						LastPowerChangeAt: 2,
 					},
 				},
@@ -634,11 +625,13 @@ func (suite *VoteManagerTestSuite) TestClaimInterest() {
 					atWhen:       2,
 					expectAmount: newCoin((999 * linotypes.Decimals) / 5 * 2),
 					expectVoter: &model.Voter{
-						Username:          suite.user3,
-						LinoStake:         linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
-						Interest:          linotypes.NewCoinFromInt64(0),
-						Duty:              types.DutyValidator,
-						FrozenAmount:      linotypes.NewCoinFromInt64(1000 * linotypes.Decimals),
+						Username:  suite.user3,
+						LinoStake: linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
+						Interest:  linotypes.NewCoinFromInt64(0),
+						Duties: []model.DutyRecord{{
+							Duty:         types.DutyValidator,
+							FrozenAmount: linotypes.NewCoinFromInt64(1000 * linotypes.Decimals),
+						}},
 						LastPowerChangeAt: 2,
 					},
 				},
@@ -681,6 +674,7 @@ func (suite *VoteManagerTestSuite) TestAssignDuty() {
 		username     linotypes.AccountKey
 		duty         types.VoterDuty
 		frozenAmount linotypes.Coin
+		preDuties    []types.VoterDuty
 		expectErr    sdk.Error
 		expectVoter  *model.Voter
 	}{
@@ -719,11 +713,75 @@ func (suite *VoteManagerTestSuite) TestAssignDuty() {
 			frozenAmount: *newCoin(1000 * linotypes.Decimals),
 			expectErr:    nil,
 			expectVoter: &model.Voter{
-				Username:     suite.user1,
-				LinoStake:    *newCoin(2000 * linotypes.Decimals),
-				Interest:     linotypes.NewCoinFromInt64(0),
-				Duty:         types.DutyValidator,
-				FrozenAmount: *newCoin(1000 * linotypes.Decimals),
+				Username:  suite.user1,
+				LinoStake: *newCoin(2000 * linotypes.Decimals),
+				Interest:  linotypes.NewCoinFromInt64(0),
+				Duties: []model.DutyRecord{{
+					Duty:         types.DutyValidator,
+					FrozenAmount: *newCoin(1000 * linotypes.Decimals),
+				}},
+			},
+		},
+		{
+			testName:     "assign unregistered duty",
+			username:     suite.user1,
+			duty:         types.VoterDuty(999),
+			frozenAmount: *newCoin(1000 * linotypes.Decimals),
+			expectErr:    types.ErrDutyNotRegistered(types.VoterDuty(999)),
+		},
+		{
+			testName:     "assign non-exclusive duty to user with exclusive duty",
+			username:     suite.user3,
+			duty:         testDutyA,
+			frozenAmount: *newCoin(1),
+			expectErr:    types.ErrNotAVoterOrHasDuty(),
+		},
+		{
+			testName:     "assign duty held by user already",
+			username:     suite.user2,
+			duty:         testDutyA,
+			frozenAmount: *newCoin(1),
+			preDuties:    []types.VoterDuty{testDutyA},
+			expectErr:    types.ErrNotAVoterOrHasDuty(),
+		},
+		{
+			testName:     "assign exclusive duty to user with non-exclusive duty",
+			username:     suite.user2,
+			duty:         types.DutyApp,
+			frozenAmount: *newCoin(1),
+			preDuties:    []types.VoterDuty{testDutyA},
+			expectErr:    types.ErrNotAVoterOrHasDuty(),
+		},
+		{
+			testName:     "frozen money larger than stake not frozen by other duties",
+			username:     suite.user2,
+			duty:         testDutyB,
+			frozenAmount: *newCoin(500*linotypes.Decimals + 1),
+			preDuties:    []types.VoterDuty{testDutyA},
+			expectErr:    types.ErrInsufficientStake(),
+		},
+		{
+			testName:     "assign multiple non-exclusive duties successfully",
+			username:     suite.user2,
+			duty:         testDutyB,
+			frozenAmount: *newCoin(500 * linotypes.Decimals),
+			preDuties:    []types.VoterDuty{testDutyA},
+			expectErr:    nil,
+			expectVoter: &model.Voter{
+				Username:          suite.user2,
+				LinoStake:         *newCoin(1000 * linotypes.Decimals),
+				LastPowerChangeAt: 1,
+				Interest:          *newCoin(100 * linotypes.Decimals),
+				Duties: []model.DutyRecord{
+					{
+						Duty:         testDutyA,
+						FrozenAmount: *newCoin(500 * linotypes.Decimals),
+					},
+					{
+						Duty:         testDutyB,
+						FrozenAmount: *newCoin(500 * linotypes.Decimals),
+					},
+				},
 			},
 		},
 	}
@@ -732,6 +790,11 @@ func (suite *VoteManagerTestSuite) TestAssignDuty() {
 		suite.Run(tc.testName, func() {
 			suite.SetupTest()
 			suite.LoadState(false, "3voters")
+			for _, duty := range tc.preDuties {
+				err := suite.vm.AssignDuty(
+					suite.Ctx, tc.username, duty, *newCoin(500 * linotypes.Decimals))
+				suite.Require().Nil(err)
+			}
 			err := suite.vm.AssignDuty(suite.Ctx, tc.username, tc.duty, tc.frozenAmount)
 			suite.Equal(tc.expectErr, err, "%s", tc.testName)
 			if tc.expectVoter != nil {
@@ -769,11 +832,15 @@ func (suite *VoteManagerTestSuite) TestUnassignDuty() {
 			testName: "unassign duty from user who has validator duty",
 			username: suite.user3,
 			expectVoter: &model.Voter{
-				Username:          suite.user3,
-				LinoStake:         linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
-				Interest:          linotypes.NewCoinFromInt64(0),
-				Duty:              types.DutyPending,
-				FrozenAmount:      linotypes.NewCoinFromInt64(1000 * linotypes.Decimals),
+				Username:  suite.user3,
+				LinoStake: linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
+				Interest:  linotypes.NewCoinFromInt64(0),
+				Duties: []model.DutyRecord{{
+					Duty:         types.DutyValidator,
+					FrozenAmount: linotypes.NewCoinFromInt64(1000 * linotypes.Decimals),
+					IsPending:    true,
+					UnassignAt:   101,
+				}},
 				LastPowerChangeAt: 1,
 			},
 		},
@@ -787,9 +854,10 @@ func (suite *VoteManagerTestSuite) TestUnassignDuty() {
 			if tc.expectErr == nil {
 				suite.global.On("RegisterEventAtTime", mock.Anything,
 					1+waitingPeriodSec,
-					types.UnassignDutyEvent{Username: tc.username}).Return(nil).Once()
+					types.UnassignDutyEvent{
+						Username: tc.username, Duty: types.DutyValidator}).Return(nil).Once()
 			}
-			err := suite.vm.UnassignDuty(suite.Ctx, tc.username, waitingPeriodSec)
+			err := suite.vm.UnassignDuty(suite.Ctx, tc.username, types.DutyValidator, waitingPeriodSec)
 			suite.Equal(tc.expectErr, err)
 			if tc.expectVoter != nil {
 				voter, err := suite.vm.GetVoter(suite.Ctx, tc.username)
@@ -828,8 +896,6 @@ func (suite *VoteManagerTestSuite) TestSlashStake() {
 				LinoStake: linotypes.NewCoinFromInt64(0),
 				Interest: linotypes.NewCoinFromInt64(
 					(999*linotypes.Decimals)/5 + 100*linotypes.Decimals),
-				Duty:              types.DutyVoter,
-				FrozenAmount:      linotypes.NewCoinFromInt64(0),
 				LastPowerChangeAt: 2,
 			},
 		},
@@ -839,11 +905,13 @@ func (suite *VoteManagerTestSuite) TestSlashStake() {
 			amount:              *newCoin(1500 * linotypes.Decimals),
 			expectSlashedAmount: *newCoin(1500 * linotypes.Decimals),
 			expectVoter: &model.Voter{
-				Username:          suite.user3,
-				LinoStake:         linotypes.NewCoinFromInt64(500 * linotypes.Decimals),
-				Interest:          linotypes.NewCoinFromInt64(39960000),
-				Duty:              types.DutyValidator,
-				FrozenAmount:      suite.minStakeInAmount,
+				Username:  suite.user3,
+				LinoStake: linotypes.NewCoinFromInt64(500 * linotypes.Decimals),
+				Interest:  linotypes.NewCoinFromInt64(39960000),
+				Duties: []model.DutyRecord{{
+					Duty:         types.DutyValidator,
+					FrozenAmount: suite.minStakeInAmount,
+				}},
 				LastPowerChangeAt: 2,
 			},
 		},
@@ -896,15 +964,39 @@ func (suite *VoteManagerTestSuite) TestExecUnassignDutyEvent() {
 			expectVoter: nil,
 		},
 		{
-			testName:  "execute event on voter with validator duty",
-			event:     types.UnassignDutyEvent{Username: suite.user3},
+			testName: "execute event on voter with pending validator duty",
+			event: types.UnassignDutyEvent{
+				Username: suite.userPendingDuty, Duty: types.DutyValidator},
 			expectErr: nil,
 			expectVoter: &model.Voter{
-				Username:          suite.user3,
-				LinoStake:         linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
-				Interest:          linotypes.NewCoinFromInt64(0),
-				Duty:              types.DutyVoter,
-				FrozenAmount:      linotypes.NewCoinFromInt64(0),
+				Username:  suite.userPendingDuty,
+				LinoStake: linotypes.NewCoinFromInt64(0),
+				Interest:  linotypes.NewCoinFromInt64(0),
+			},
+		},
+		{
+			testName:  "execute event without duty on voter with pending validator duty",
+			event:     types.UnassignDutyEvent{Username: suite.userPendingDuty},
+			expectErr: nil,
+			expectVoter: &model.Voter{
+				Username:  suite.userPendingDuty,
+				LinoStake: linotypes.NewCoinFromInt64(0),
+				Interest:  linotypes.NewCoinFromInt64(0),
+			},
+		},
+		{
+			testName: "execute event on voter with active validator duty",
+			event: types.UnassignDutyEvent{
+				Username: suite.user3, Duty: types.DutyValidator},
+			expectErr: nil,
+			expectVoter: &model.Voter{
+				Username:  suite.user3,
+				LinoStake: linotypes.NewCoinFromInt64(2000 * linotypes.Decimals),
+				Interest:  linotypes.NewCoinFromInt64(0),
+				Duties: []model.DutyRecord{{
+					Duty:         types.DutyValidator,
+					FrozenAmount: linotypes.NewCoinFromInt64(1000 * linotypes.Decimals),
+				}},
 				LastPowerChangeAt: 1,
 			},
 		},
@@ -971,16 +1063,16 @@ func (suite *VoteManagerTestSuite) TestImportExport() {
 		LinoStake:         *newCoin(1234),
 		LastPowerChangeAt: 123,
 		Interest:          *newCoin(2345),
-		Duty:              types.DutyValidator,
-		FrozenAmount:      *newCoin(999),
+		Duties: []model.DutyRecord{{
+			Duty:         types.DutyValidator,
+			FrozenAmount: *newCoin(999),
+		}},
 	})
 	suite.vm.storage.SetVoter(suite.Ctx, &model.Voter{
 		Username:          "voter2",
 		LinoStake:         *newCoin(567),
 		LastPowerChangeAt: 3,
 		Interest:          *newCoin(0),
-		Duty:              types.DutyVoter,
-		FrozenAmount:      *newCoin(0),
 	})
 	suite.vm.storage.SetLinoStakeStat(suite.Ctx, 0, &model.LinoStakeStat{
 		TotalConsumptionFriction: *newCoin(123),
@@ -994,6 +1086,22 @@ func (suite *VoteManagerTestSuite) TestImportExport() {
 		TotalLinoStake:           *newCoin(1789),
 		UnclaimedLinoStake:       *newCoin(11230),
 	})
+	suite.vm.storage.SetTreasurySpend(suite.Ctx, &model.TreasurySpend{
+		ID:        1,
+		Proposer:  "voter1",
+		Receiver:  "voter2",
+		Amount:    *newCoin(100),
+		Deposit:   *newCoin(10),
+		Reason:    "reason",
+		CreatedAt: 3,
+		DecideAt:  103,
+		Status:    types.TreasurySpendExecuted,
+		Approve:   *newCoin(1234),
+		Reject:    *newCoin(567),
+	})
+	suite.vm.storage.SetTreasurySpendVote(suite.Ctx, 1, &model.TreasurySpendVote{Voter: "voter1", Approve: true})
+	suite.vm.storage.SetTreasurySpendVote(suite.Ctx, 1, &model.TreasurySpendVote{Voter: "voter2", Approve: false})
+	suite.vm.storage.SetTreasuryNextID(suite.Ctx, 2)
 
 	cdc := codec.New()
 	dir, err2 := ioutil.TempDir("", "test")
@@ -1012,6 +1120,64 @@ func (suite *VoteManagerTestSuite) TestImportExport() {
 	suite.Golden()
 }
 
+func (suite *VoteManagerTestSuite) TestImportV2() {
+	cdc := codec.New()
+	dir, err2 := ioutil.TempDir("", "test")
+	suite.Require().Nil(err2)
+	defer os.RemoveAll(dir) // clean up
+
+	tmpfn := filepath.Join(dir, "tmpfile")
+	err2 = utils.Save(tmpfn, cdc, &model.VoterTablesV2IR{
+		Version: 2,
+		Voters: []model.VoterV2IR{
+			{
+				Username:     "voter1",
+				LinoStake:    *newCoin(1234),
+				Interest:     *newCoin(0),
+				Duty:         types.DutyValidator,
+				FrozenAmount: *newCoin(999),
+			},
+			{
+				Username:     "voter2",
+				LinoStake:    *newCoin(567),
+				Interest:     *newCoin(0),
+				Duty:         types.DutyPending,
+				FrozenAmount: *newCoin(100),
+			},
+			{
+				Username:     "voter3",
+				LinoStake:    *newCoin(10),
+				Interest:     *newCoin(0),
+				Duty:         types.DutyVoter,
+				FrozenAmount: *newCoin(0),
+			},
+		},
+	})
+	suite.Require().Nil(err2)
+
+	suite.SetupTest()
+	err2 = suite.vm.ImportFromFile(suite.Ctx, cdc, tmpfn)
+	suite.Require().Nil(err2)
+
+	voter, err := suite.vm.GetVoter(suite.Ctx, "voter1")
+	suite.Require().Nil(err)
+	suite.Equal([]model.DutyRecord{{Duty: types.DutyValidator, FrozenAmount: *newCoin(999)}}, voter.Duties)
+	voter, err = suite.vm.GetVoter(suite.Ctx, "voter2")
+	suite.Require().Nil(err)
+	suite.Equal([]model.DutyRecord{
+		{Duty: types.DutyPending, FrozenAmount: *newCoin(100), IsPending: true}}, voter.Duties)
+	voter, err = suite.vm.GetVoter(suite.Ctx, "voter3")
+	suite.Require().Nil(err)
+	suite.Empty(voter.Duties)
+
+	// legacy unassign event releases the pending duty.
+	err = suite.vm.ExecUnassignDutyEvent(suite.Ctx, types.UnassignDutyEvent{Username: "voter2"})
+	suite.Require().Nil(err)
+	voter, err = suite.vm.GetVoter(suite.Ctx, "voter2")
+	suite.Require().Nil(err)
+	suite.Empty(voter.Duties)
+}
+
 func newCoin(n int64) *linotypes.Coin {
 	coin := linotypes.NewCoinFromInt64(n)
 	return &coin
@@ -0,0 +1,191 @@
+package manager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lino-network/lino/param"
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/vote/model"
+	"github.com/lino-network/lino/x/vote/types"
+)
+
+// maxTreasurySpendsPerPage - maximum number of treasury spends returned by one query.
+const maxTreasurySpendsPerPage = 100
+
+// ProposeTreasurySpend - propose to release amount of coins in treasury pool to receiver,
+// proposer must have at least MinStakeIn of lino stake and deposit at least
+// TreasurySpendMinDeposit, which is held in the treasury deposit pool until votes
+// are tallied after TreasurySpendDecideSec seconds.
+func (vm VoteManager) ProposeTreasurySpend(ctx sdk.Context, proposer, receiver linotypes.AccountKey,
+	amount, deposit linotypes.Coin, reason string) (int64, sdk.Error) {
+	param := vm.paramHolder.GetVoteParam(ctx)
+	if !treasurySpendEnabled(param) {
+		return 0, types.ErrInvalidTreasurySpend("treasury spend is not enabled")
+	}
+	stake, err := vm.GetLinoStake(ctx, proposer)
+	if err != nil {
+		return 0, err
+	}
+	if param.MinStakeIn.IsGT(stake) {
+		return 0, types.ErrInsufficientStake()
+	}
+	if !vm.am.DoesAccountExist(ctx, receiver) {
+		return 0, types.ErrAccountNotFound()
+	}
+	if !amount.IsPositive() {
+		return 0, types.ErrInvalidTreasurySpend("amount must be positive")
+	}
+	if param.TreasurySpendMinDeposit.IsGT(deposit) {
+		return 0, types.ErrInvalidTreasurySpend("deposit is less than minimum deposit")
+	}
+	if err := vm.am.MoveToPool(ctx, linotypes.VoteTreasuryDepositPool,
+		linotypes.NewAccOrAddrFromAcc(proposer), deposit); err != nil {
+		return 0, err
+	}
+
+	id := vm.storage.GetTreasuryNextID(ctx)
+	now := ctx.BlockTime().Unix()
+	spend := &model.TreasurySpend{
+		ID:        id,
+		Proposer:  proposer,
+		Receiver:  receiver,
+		Amount:    amount,
+		Deposit:   deposit,
+		Reason:    reason,
+		CreatedAt: now,
+		DecideAt:  now + param.TreasurySpendDecideSec,
+		Status:    types.TreasurySpendPending,
+		Approve:   linotypes.NewCoinFromInt64(0),
+		Reject:    linotypes.NewCoinFromInt64(0),
+	}
+	if err := vm.gm.RegisterEventAtTime(
+		ctx, spend.DecideAt, types.DecideTreasurySpendEvent{ID: id}); err != nil {
+		return 0, err
+	}
+	vm.storage.SetTreasurySpend(ctx, spend)
+	vm.storage.SetTreasuryNextID(ctx, id+1)
+	return id, nil
+}
+
+// VoteTreasurySpend - vote on a pending treasury spend, a later vote of
+// the same voter overrides the earlier one.
+func (vm VoteManager) VoteTreasurySpend(ctx sdk.Context, voter linotypes.AccountKey, id int64, approve bool) sdk.Error {
+	if !vm.storage.DoesVoterExist(ctx, voter) {
+		return types.ErrVoterNotFound()
+	}
+	spend, err := vm.storage.GetTreasurySpend(ctx, id)
+	if err != nil {
+		return err
+	}
+	if spend.Status != types.TreasurySpendPending || ctx.BlockTime().Unix() >= spend.DecideAt {
+		return types.ErrTreasurySpendClosed(id)
+	}
+	vm.storage.SetTreasurySpendVote(ctx, id, &model.TreasurySpendVote{
+		Voter:   voter,
+		Approve: approve,
+	})
+	return nil
+}
+
+// ExecDecideTreasurySpendEvent - tally votes weighted by current lino stake of voters.
+// The spend passes when voted stake reaches TreasurySpendQuorum of all stake in vote and
+// approve stake is more than TreasurySpendPassRatio of voted stake. A passed spend
+// that treasury pool can not afford is marked as failed.
+// The deposit is refunded to the proposer if passed, otherwise burned.
+func (vm VoteManager) ExecDecideTreasurySpendEvent(ctx sdk.Context, event types.DecideTreasurySpendEvent) sdk.Error {
+	spend, err := vm.storage.GetTreasurySpend(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	if spend.Status != types.TreasurySpendPending {
+		return types.ErrTreasurySpendClosed(event.ID)
+	}
+
+	for _, vote := range vm.storage.GetTreasurySpendVotes(ctx, event.ID) {
+		stake, err := vm.GetLinoStake(ctx, vote.Voter)
+		if err != nil {
+			return err
+		}
+		if vote.Approve {
+			spend.Approve = spend.Approve.Plus(stake)
+		} else {
+			spend.Reject = spend.Reject.Plus(stake)
+		}
+	}
+
+	param := vm.paramHolder.GetVoteParam(ctx)
+	totalStake, err := vm.am.GetPool(ctx, linotypes.VoteStakeInPool)
+	if err != nil {
+		return err
+	}
+	voted := spend.Approve.Plus(spend.Reject)
+	spend.Status = types.TreasurySpendRejected
+	if treasurySpendEnabled(param) && voted.IsPositive() &&
+		voted.ToDec().GTE(totalStake.ToDec().Mul(param.TreasurySpendQuorum)) &&
+		spend.Approve.ToDec().GT(voted.ToDec().Mul(param.TreasurySpendPassRatio)) {
+		spend.Status = types.TreasurySpendExecuted
+		if err := vm.am.MoveFromPool(ctx, linotypes.InflationTreasuryPool,
+			linotypes.NewAccOrAddrFromAcc(spend.Receiver), spend.Amount); err != nil {
+			spend.Status = types.TreasurySpendFailed
+		}
+	}
+	if spend.Deposit.IsPositive() {
+		if spend.Status == types.TreasurySpendRejected {
+			err = vm.am.BurnFromPool(ctx, linotypes.VoteTreasuryDepositPool, spend.Deposit)
+		} else {
+			err = vm.am.MoveFromPool(ctx, linotypes.VoteTreasuryDepositPool,
+				linotypes.NewAccOrAddrFromAcc(spend.Proposer), spend.Deposit)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	vm.storage.SetTreasurySpend(ctx, spend)
+	return nil
+}
+
+// treasurySpendEnabled - vote params stored before treasury spend was introduced
+// do not have its decide seconds, ratios and minimum deposit.
+func treasurySpendEnabled(p *param.VoteParam) bool {
+	return p.TreasurySpendDecideSec > 0 &&
+		!p.TreasurySpendPassRatio.IsNil() && !p.TreasurySpendQuorum.IsNil() &&
+		p.TreasurySpendMinDeposit.Amount != (sdk.Int{})
+}
+
+// GetTreasurySpend - get treasury spend by id.
+func (vm VoteManager) GetTreasurySpend(ctx sdk.Context, id int64) (*model.TreasurySpend, sdk.Error) {
+	return vm.storage.GetTreasurySpend(ctx, id)
+}
+
+// GetTreasurySpends - return at most limit treasury spends, starting from the start-th one.
+func (vm VoteManager) GetTreasurySpends(ctx sdk.Context, start, limit int64) (*model.TreasurySpendList, sdk.Error) {
+	if start < 0 || limit <= 0 {
+		return nil, linotypes.ErrInvalidQueryPath()
+	}
+	if limit > maxTreasurySpendsPerPage {
+		limit = maxTreasurySpendsPerPage
+	}
+	// ids are assigned from 1 in order.
+	total := vm.storage.GetTreasuryNextID(ctx) - 1
+	rst := &model.TreasurySpendList{
+		Total:  total,
+		Start:  start,
+		Spends: make([]model.TreasurySpend, 0),
+	}
+	for i := start; i < total && i < start+limit; i++ {
+		spend, err := vm.storage.GetTreasurySpend(ctx, i+1)
+		if err != nil {
+			return nil, err
+		}
+		rst.Spends = append(rst.Spends, *spend)
+	}
+	return rst, nil
+}
+
+// GetTreasurySpendVotes - votes on treasury spend, sorted by voter.
+func (vm VoteManager) GetTreasurySpendVotes(ctx sdk.Context, id int64) ([]model.TreasurySpendVote, sdk.Error) {
+	if _, err := vm.storage.GetTreasurySpend(ctx, id); err != nil {
+		return nil, err
+	}
+	return vm.storage.GetTreasurySpendVotes(ctx, id), nil
+}
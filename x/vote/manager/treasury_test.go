@@ -0,0 +1,367 @@
+package manager
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	parammodel "github.com/lino-network/lino/param"
+	param "github.com/lino-network/lino/param/mocks"
+	linotypes "github.com/lino-network/lino/types"
+	acctypes "github.com/lino-network/lino/x/account/types"
+	"github.com/lino-network/lino/x/vote/model"
+	"github.com/lino-network/lino/x/vote/types"
+)
+
+func (suite *VoteManagerTestSuite) setTreasuryParam() {
+	suite.ph = &param.ParamKeeper{}
+	suite.vm.paramHolder = suite.ph
+	suite.ph.On("GetVoteParam", mock.Anything).Return(&parammodel.VoteParam{
+		MinStakeIn:                 suite.minStakeInAmount,
+		VoterCoinReturnIntervalSec: suite.returnIntervalSec,
+		VoterCoinReturnTimes:       suite.returnTimes,
+		TreasurySpendDecideSec:     100,
+		TreasurySpendPassRatio:     linotypes.NewDecFromRat(1, 2),
+		TreasurySpendQuorum:        linotypes.NewDecFromRat(20, 100),
+		TreasurySpendMinDeposit:    linotypes.NewCoinFromInt64(100),
+	}).Maybe()
+}
+
+func (suite *VoteManagerTestSuite) pendingSpend(id int64) *model.TreasurySpend {
+	return &model.TreasurySpend{
+		ID:        id,
+		Proposer:  suite.user1,
+		Receiver:  suite.user2,
+		Amount:    linotypes.NewCoinFromInt64(100),
+		Deposit:   linotypes.NewCoinFromInt64(100),
+		Reason:    "reason",
+		CreatedAt: 1,
+		DecideAt:  101,
+		Status:    types.TreasurySpendPending,
+		Approve:   linotypes.NewCoinFromInt64(0),
+		Reject:    linotypes.NewCoinFromInt64(0),
+	}
+}
+
+func (suite *VoteManagerTestSuite) TestProposeTreasurySpend() {
+	testCases := []struct {
+		testName  string
+		proposer  linotypes.AccountKey
+		receiver  linotypes.AccountKey
+		amount    linotypes.Coin
+		deposit   linotypes.Coin
+		disabled  bool
+		expectErr sdk.Error
+		expectID  int64
+	}{
+		{
+			testName:  "treasury spend not enabled",
+			proposer:  suite.user1,
+			receiver:  suite.user2,
+			amount:    linotypes.NewCoinFromInt64(100),
+			deposit:   linotypes.NewCoinFromInt64(100),
+			disabled:  true,
+			expectErr: types.ErrInvalidTreasurySpend("treasury spend is not enabled"),
+		},
+		{
+			testName:  "proposer is not a voter",
+			proposer:  suite.userNotVoter,
+			receiver:  suite.user2,
+			amount:    linotypes.NewCoinFromInt64(100),
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrVoterNotFound(),
+		},
+		{
+			testName:  "proposer stake less than minimum stake in",
+			proposer:  suite.userPendingDuty,
+			receiver:  suite.user2,
+			amount:    linotypes.NewCoinFromInt64(100),
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrInsufficientStake(),
+		},
+		{
+			testName:  "receiver does not exist",
+			proposer:  suite.user1,
+			receiver:  "dummy",
+			amount:    linotypes.NewCoinFromInt64(100),
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrAccountNotFound(),
+		},
+		{
+			testName:  "zero amount",
+			proposer:  suite.user1,
+			receiver:  suite.user2,
+			amount:    linotypes.NewCoinFromInt64(0),
+			deposit:   linotypes.NewCoinFromInt64(100),
+			expectErr: types.ErrInvalidTreasurySpend("amount must be positive"),
+		},
+		{
+			testName:  "deposit less than minimum deposit",
+			proposer:  suite.user1,
+			receiver:  suite.user2,
+			amount:    linotypes.NewCoinFromInt64(100),
+			deposit:   linotypes.NewCoinFromInt64(99),
+			expectErr: types.ErrInvalidTreasurySpend("deposit is less than minimum deposit"),
+		},
+		{
+			testName:  "deposit not enough balance",
+			proposer:  suite.user1,
+			receiver:  suite.user2,
+			amount:    linotypes.NewCoinFromInt64(100),
+			deposit:   linotypes.NewCoinFromInt64(101),
+			expectErr: acctypes.ErrAccountSavingCoinNotEnough(),
+		},
+		{
+			testName: "propose",
+			proposer: suite.user1,
+			receiver: suite.user2,
+			amount:   linotypes.NewCoinFromInt64(100),
+			deposit:  linotypes.NewCoinFromInt64(100),
+			expectID: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.testName, func() {
+			suite.LoadState(false, "3voters")
+			suite.NextBlock(time.Unix(1, 0))
+			if !tc.disabled {
+				suite.setTreasuryParam()
+			}
+			suite.am.On("DoesAccountExist", mock.Anything, suite.user2).Return(true).Maybe()
+			suite.am.On("DoesAccountExist", mock.Anything, linotypes.AccountKey("dummy")).Return(false).Maybe()
+			suite.am.On("MoveToPool", mock.Anything, linotypes.VoteTreasuryDepositPool,
+				linotypes.NewAccOrAddrFromAcc(suite.user1), linotypes.NewCoinFromInt64(100)).Return(nil).Maybe()
+			suite.am.On("MoveToPool", mock.Anything, linotypes.VoteTreasuryDepositPool,
+				linotypes.NewAccOrAddrFromAcc(suite.user1), linotypes.NewCoinFromInt64(101)).Return(
+				acctypes.ErrAccountSavingCoinNotEnough()).Maybe()
+			if tc.expectErr == nil {
+				suite.global.On("RegisterEventAtTime", mock.Anything,
+					int64(101), types.DecideTreasurySpendEvent{ID: tc.expectID}).Return(nil).Once()
+			}
+			id, err := suite.vm.ProposeTreasurySpend(suite.Ctx, tc.proposer, tc.receiver, tc.amount, tc.deposit, "reason")
+			suite.Equal(tc.expectErr, err)
+			suite.Equal(tc.expectID, id)
+			if tc.expectErr == nil {
+				spend, err := suite.vm.GetTreasurySpend(suite.Ctx, id)
+				suite.Nil(err)
+				suite.Equal(suite.pendingSpend(id), spend)
+				suite.Equal(id+1, suite.vm.storage.GetTreasuryNextID(suite.Ctx))
+			} else {
+				suite.Equal(int64(1), suite.vm.storage.GetTreasuryNextID(suite.Ctx))
+			}
+			suite.global.AssertExpectations(suite.T())
+		})
+	}
+}
+
+func (suite *VoteManagerTestSuite) TestVoteTreasurySpend() {
+	testCases := []struct {
+		testName    string
+		voter       linotypes.AccountKey
+		id          int64
+		at          int64
+		expectErr   sdk.Error
+		expectVotes []model.TreasurySpendVote
+	}{
+		{
+			testName:  "voter does not exist",
+			voter:     suite.userNotVoter,
+			id:        1,
+			at:        2,
+			expectErr: types.ErrVoterNotFound(),
+		},
+		{
+			testName:  "spend not found",
+			voter:     suite.user1,
+			id:        2,
+			at:        2,
+			expectErr: types.ErrTreasurySpendNotFound(2),
+		},
+		{
+			testName:  "voting period ends",
+			voter:     suite.user1,
+			id:        1,
+			at:        101,
+			expectErr: types.ErrTreasurySpendClosed(1),
+		},
+		{
+			testName: "override previous vote",
+			voter:    suite.user2,
+			id:       1,
+			at:       100,
+			expectVotes: []model.TreasurySpendVote{
+				{Voter: suite.user1, Approve: true},
+				{Voter: suite.user2, Approve: true},
+			},
+		},
+		{
+			testName: "new vote",
+			voter:    suite.user3,
+			id:       1,
+			at:       2,
+			expectVotes: []model.TreasurySpendVote{
+				{Voter: suite.user1, Approve: true},
+				{Voter: suite.user2, Approve: false},
+				{Voter: suite.user3, Approve: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.testName, func() {
+			suite.LoadState(false, "3voters")
+			suite.vm.storage.SetTreasurySpend(suite.Ctx, suite.pendingSpend(1))
+			suite.vm.storage.SetTreasurySpendVote(suite.Ctx, 1, &model.TreasurySpendVote{Voter: suite.user1, Approve: true})
+			suite.vm.storage.SetTreasurySpendVote(suite.Ctx, 1, &model.TreasurySpendVote{Voter: suite.user2, Approve: false})
+			suite.NextBlock(time.Unix(tc.at, 0))
+			err := suite.vm.VoteTreasurySpend(suite.Ctx, tc.voter, tc.id, true)
+			suite.Equal(tc.expectErr, err)
+			if tc.expectVotes != nil {
+				votes, err := suite.vm.GetTreasurySpendVotes(suite.Ctx, tc.id)
+				suite.Nil(err)
+				suite.Equal(tc.expectVotes, votes)
+			}
+		})
+	}
+}
+
+func (suite *VoteManagerTestSuite) TestExecDecideTreasurySpendEvent() {
+	user1Stake := linotypes.NewCoinFromInt64(2000 * linotypes.Decimals)
+	user2Stake := linotypes.NewCoinFromInt64(1000 * linotypes.Decimals)
+	testCases := []struct {
+		testName     string
+		votes        []model.TreasurySpendVote
+		totalStake   linotypes.Coin
+		moveErr      sdk.Error
+		expectStatus types.TreasurySpendStatus
+		expectMove   bool
+		expectRefund bool
+		approve      linotypes.Coin
+		reject       linotypes.Coin
+	}{
+		{
+			testName:     "no vote",
+			totalStake:   user1Stake,
+			expectStatus: types.TreasurySpendRejected,
+			approve:      linotypes.NewCoinFromInt64(0),
+			reject:       linotypes.NewCoinFromInt64(0),
+		},
+		{
+			testName: "quorum not reached",
+			votes: []model.TreasurySpendVote{
+				{Voter: suite.user2, Approve: true},
+			},
+			totalStake:   user2Stake.Plus(user2Stake).Plus(user2Stake).Plus(user2Stake).Plus(user2Stake).Plus(linotypes.NewCoinFromInt64(1)),
+			expectStatus: types.TreasurySpendRejected,
+			approve:      user2Stake,
+			reject:       linotypes.NewCoinFromInt64(0),
+		},
+		{
+			testName: "approve not more than pass ratio",
+			votes: []model.TreasurySpendVote{
+				{Voter: suite.user1, Approve: false},
+				{Voter: suite.user2, Approve: true},
+			},
+			totalStake:   user1Stake.Plus(user2Stake),
+			expectStatus: types.TreasurySpendRejected,
+			approve:      user2Stake,
+			reject:       user1Stake,
+		},
+		{
+			testName: "passed",
+			votes: []model.TreasurySpendVote{
+				{Voter: suite.user1, Approve: true},
+				{Voter: suite.user2, Approve: false},
+			},
+			totalStake:   user1Stake.Plus(user2Stake).Plus(user2Stake),
+			expectStatus: types.TreasurySpendExecuted,
+			expectMove:   true,
+			expectRefund: true,
+			approve:      user1Stake,
+			reject:       user2Stake,
+		},
+		{
+			testName: "passed but treasury is insufficient",
+			votes: []model.TreasurySpendVote{
+				{Voter: suite.user2, Approve: true},
+			},
+			totalStake:   user2Stake.Plus(user2Stake).Plus(user2Stake).Plus(user2Stake).Plus(user2Stake),
+			moveErr:      acctypes.ErrPoolNotEnough(linotypes.InflationTreasuryPool),
+			expectStatus: types.TreasurySpendFailed,
+			expectMove:   true,
+			expectRefund: true,
+			approve:      user2Stake,
+			reject:       linotypes.NewCoinFromInt64(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.testName, func() {
+			suite.LoadState(false, "3voters")
+			suite.setTreasuryParam()
+			suite.NextBlock(time.Unix(101, 0))
+			suite.vm.storage.SetTreasurySpend(suite.Ctx, suite.pendingSpend(1))
+			for i := range tc.votes {
+				suite.vm.storage.SetTreasurySpendVote(suite.Ctx, 1, &tc.votes[i])
+			}
+			suite.am.On("GetPool", mock.Anything, linotypes.VoteStakeInPool).Return(tc.totalStake, nil).Once()
+			if tc.expectMove {
+				suite.am.On("MoveFromPool", mock.Anything, linotypes.InflationTreasuryPool,
+					linotypes.NewAccOrAddrFromAcc(suite.user2), linotypes.NewCoinFromInt64(100)).Return(tc.moveErr).Once()
+			}
+			if tc.expectRefund {
+				suite.am.On("MoveFromPool", mock.Anything, linotypes.VoteTreasuryDepositPool,
+					linotypes.NewAccOrAddrFromAcc(suite.user1), linotypes.NewCoinFromInt64(100)).Return(nil).Once()
+			} else {
+				suite.am.On("BurnFromPool", mock.Anything, linotypes.VoteTreasuryDepositPool,
+					linotypes.NewCoinFromInt64(100)).Return(nil).Once()
+			}
+			err := suite.vm.ExecDecideTreasurySpendEvent(suite.Ctx, types.DecideTreasurySpendEvent{ID: 1})
+			suite.Nil(err)
+			expected := suite.pendingSpend(1)
+			expected.Status = tc.expectStatus
+			expected.Approve = tc.approve
+			expected.Reject = tc.reject
+			spend, err := suite.vm.GetTreasurySpend(suite.Ctx, 1)
+			suite.Nil(err)
+			suite.Equal(expected, spend)
+			suite.am.AssertExpectations(suite.T())
+
+			// decided spend can not be decided again.
+			suite.Equal(types.ErrTreasurySpendClosed(1),
+				suite.vm.ExecDecideTreasurySpendEvent(suite.Ctx, types.DecideTreasurySpendEvent{ID: 1}))
+		})
+	}
+}
+
+func (suite *VoteManagerTestSuite) TestGetTreasurySpends() {
+	suite.LoadState(false, "3voters")
+	for i := int64(1); i <= 3; i++ {
+		suite.vm.storage.SetTreasurySpend(suite.Ctx, suite.pendingSpend(i))
+	}
+	suite.vm.storage.SetTreasuryNextID(suite.Ctx, 4)
+
+	_, err := suite.vm.GetTreasurySpends(suite.Ctx, -1, 1)
+	suite.Equal(linotypes.ErrInvalidQueryPath(), err)
+	_, err = suite.vm.GetTreasurySpends(suite.Ctx, 0, 0)
+	suite.Equal(linotypes.ErrInvalidQueryPath(), err)
+
+	list, err := suite.vm.GetTreasurySpends(suite.Ctx, 1, 1)
+	suite.Nil(err)
+	suite.Equal(&model.TreasurySpendList{
+		Total:  3,
+		Start:  1,
+		Spends: []model.TreasurySpend{*suite.pendingSpend(2)},
+	}, list)
+
+	list, err = suite.vm.GetTreasurySpends(suite.Ctx, 1, 1000)
+	suite.Nil(err)
+	suite.Equal([]model.TreasurySpend{*suite.pendingSpend(2), *suite.pendingSpend(3)}, list.Spends)
+
+	list, err = suite.vm.GetTreasurySpends(suite.Ctx, 3, 10)
+	suite.Nil(err)
+	suite.Equal(int64(3), list.Total)
+	suite.Empty(list.Spends)
+}
@@ -82,6 +82,22 @@ func (_m *VoteKeeper) DoesVoterExist(ctx types.Context, username linotypes.Accou
 	return r0
 }
 
+// ExecDecideTreasurySpendEvent provides a mock function with given fields: ctx, event
+func (_m *VoteKeeper) ExecDecideTreasurySpendEvent(ctx types.Context, event votetypes.DecideTreasurySpendEvent) types.Error {
+	ret := _m.Called(ctx, event)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, votetypes.DecideTreasurySpendEvent) types.Error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
 // ExecUnassignDutyEvent provides a mock function with given fields: ctx, event
 func (_m *VoteKeeper) ExecUnassignDutyEvent(ctx types.Context, event votetypes.UnassignDutyEvent) types.Error {
 	ret := _m.Called(ctx, event)
@@ -112,6 +128,22 @@ func (_m *VoteKeeper) ExportToFile(ctx types.Context, cdc *amino.Codec, filepath
 	return r0
 }
 
+// GetDutySpecs provides a mock function with given fields: ctx
+func (_m *VoteKeeper) GetDutySpecs(ctx types.Context) []votetypes.DutySpec {
+	ret := _m.Called(ctx)
+
+	var r0 []votetypes.DutySpec
+	if rf, ok := ret.Get(0).(func(types.Context) []votetypes.DutySpec); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]votetypes.DutySpec)
+		}
+	}
+
+	return r0
+}
+
 // GetLinoStake provides a mock function with given fields: ctx, username
 func (_m *VoteKeeper) GetLinoStake(ctx types.Context, username linotypes.AccountKey) (linotypes.Coin, types.Error) {
 	ret := _m.Called(ctx, username)
@@ -160,6 +192,81 @@ func (_m *VoteKeeper) GetStakeStatsOfDay(ctx types.Context, day int64) (*model.L
 	return r0, r1
 }
 
+// GetTreasurySpend provides a mock function with given fields: ctx, id
+func (_m *VoteKeeper) GetTreasurySpend(ctx types.Context, id int64) (*model.TreasurySpend, types.Error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.TreasurySpend
+	if rf, ok := ret.Get(0).(func(types.Context, int64) *model.TreasurySpend); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TreasurySpend)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, int64) types.Error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTreasurySpendVotes provides a mock function with given fields: ctx, id
+func (_m *VoteKeeper) GetTreasurySpendVotes(ctx types.Context, id int64) ([]model.TreasurySpendVote, types.Error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []model.TreasurySpendVote
+	if rf, ok := ret.Get(0).(func(types.Context, int64) []model.TreasurySpendVote); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TreasurySpendVote)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, int64) types.Error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTreasurySpends provides a mock function with given fields: ctx, start, limit
+func (_m *VoteKeeper) GetTreasurySpends(ctx types.Context, start int64, limit int64) (*model.TreasurySpendList, types.Error) {
+	ret := _m.Called(ctx, start, limit)
+
+	var r0 *model.TreasurySpendList
+	if rf, ok := ret.Get(0).(func(types.Context, int64, int64) *model.TreasurySpendList); ok {
+		r0 = rf(ctx, start, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TreasurySpendList)
+		}
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, int64, int64) types.Error); ok {
+		r1 = rf(ctx, start, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetVoter provides a mock function with given fields: ctx, username
 func (_m *VoteKeeper) GetVoter(ctx types.Context, username linotypes.AccountKey) (*model.Voter, types.Error) {
 	ret := _m.Called(ctx, username)
@@ -227,6 +334,29 @@ func (_m *VoteKeeper) InitGenesis(ctx types.Context) {
 	_m.Called(ctx)
 }
 
+// ProposeTreasurySpend provides a mock function with given fields: ctx, proposer, receiver, amount, deposit, reason
+func (_m *VoteKeeper) ProposeTreasurySpend(ctx types.Context, proposer linotypes.AccountKey, receiver linotypes.AccountKey, amount linotypes.Coin, deposit linotypes.Coin, reason string) (int64, types.Error) {
+	ret := _m.Called(ctx, proposer, receiver, amount, deposit, reason)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, linotypes.AccountKey, linotypes.Coin, linotypes.Coin, string) int64); ok {
+		r0 = rf(ctx, proposer, receiver, amount, deposit, reason)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 types.Error
+	if rf, ok := ret.Get(1).(func(types.Context, linotypes.AccountKey, linotypes.AccountKey, linotypes.Coin, linotypes.Coin, string) types.Error); ok {
+		r1 = rf(ctx, proposer, receiver, amount, deposit, reason)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(types.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // RecordFriction provides a mock function with given fields: ctx, friction
 func (_m *VoteKeeper) RecordFriction(ctx types.Context, friction linotypes.Coin) types.Error {
 	ret := _m.Called(ctx, friction)
@@ -243,6 +373,11 @@ func (_m *VoteKeeper) RecordFriction(ctx types.Context, friction linotypes.Coin)
 	return r0
 }
 
+// RegisterDuty provides a mock function with given fields: spec
+func (_m *VoteKeeper) RegisterDuty(spec votetypes.DutySpec) {
+	_m.Called(spec)
+}
+
 // SlashStake provides a mock function with given fields: ctx, username, amount, destPool
 func (_m *VoteKeeper) SlashStake(ctx types.Context, username linotypes.AccountKey, amount linotypes.Coin, destPool linotypes.PoolName) (linotypes.Coin, types.Error) {
 	ret := _m.Called(ctx, username, amount, destPool)
@@ -314,13 +449,29 @@ func (_m *VoteKeeper) StakeOut(ctx types.Context, username linotypes.AccountKey,
 	return r0
 }
 
-// UnassignDuty provides a mock function with given fields: ctx, username, waitingPeriodSec
-func (_m *VoteKeeper) UnassignDuty(ctx types.Context, username linotypes.AccountKey, waitingPeriodSec int64) types.Error {
-	ret := _m.Called(ctx, username, waitingPeriodSec)
+// UnassignDuty provides a mock function with given fields: ctx, username, duty, waitingPeriodSec
+func (_m *VoteKeeper) UnassignDuty(ctx types.Context, username linotypes.AccountKey, duty votetypes.VoterDuty, waitingPeriodSec int64) types.Error {
+	ret := _m.Called(ctx, username, duty, waitingPeriodSec)
+
+	var r0 types.Error
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, votetypes.VoterDuty, int64) types.Error); ok {
+		r0 = rf(ctx, username, duty, waitingPeriodSec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Error)
+		}
+	}
+
+	return r0
+}
+
+// VoteTreasurySpend provides a mock function with given fields: ctx, voter, id, approve
+func (_m *VoteKeeper) VoteTreasurySpend(ctx types.Context, voter linotypes.AccountKey, id int64, approve bool) types.Error {
+	ret := _m.Called(ctx, voter, id, approve)
 
 	var r0 types.Error
-	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, int64) types.Error); ok {
-		r0 = rf(ctx, username, waitingPeriodSec)
+	if rf, ok := ret.Get(0).(func(types.Context, linotypes.AccountKey, int64, bool) types.Error); ok {
+		r0 = rf(ctx, voter, id, approve)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(types.Error)
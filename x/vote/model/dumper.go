@@ -10,5 +10,8 @@ func NewVoteDumper(store VoteStorage) *testutils.Dumper {
 	dumper := testutils.NewDumper(store.key, store.cdc)
 	dumper.RegisterType(&Voter{}, "lino/voter", VoterSubstore)
 	dumper.RegisterType(&LinoStakeStat{}, "lino/stakestats", LinoStakeStatSubStore)
+	dumper.RegisterType(&TreasurySpend{}, "lino/treasuryspend", TreasurySpendSubstore)
+	dumper.RegisterType(&TreasurySpendVote{}, "lino/treasuryspendvote", TreasuryVoteSubstore)
+	dumper.RegisterRawString(TreasuryNextIDSubstore)
 	return dumper
 }
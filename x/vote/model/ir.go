@@ -7,6 +7,23 @@ import (
 
 // VoterIR - pk: username
 type VoterIR struct {
+	Username          linotypes.AccountKey `json:"username"`
+	LinoStake         linotypes.Coin       `json:"lino_stake"`
+	LastPowerChangeAt int64                `json:"last_power_change_at"`
+	Interest          linotypes.Coin       `json:"interest"`
+	Duties            []DutyRecordIR       `json:"duties"`
+}
+
+// DutyRecordIR - a duty held by voter.
+type DutyRecordIR struct {
+	Duty         types.VoterDuty `json:"duty"`
+	FrozenAmount linotypes.Coin  `json:"frozen_amount"`
+	IsPending    bool            `json:"is_pending"`
+	UnassignAt   int64           `json:"unassign_at"`
+}
+
+// VoterV2IR - voter of export version 2, which holds at most one duty.
+type VoterV2IR struct {
 	Username          linotypes.AccountKey `json:"username"`
 	LinoStake         linotypes.Coin       `json:"lino_stake"`
 	LastPowerChangeAt int64                `json:"last_power_change_at"`
@@ -30,9 +47,40 @@ type StakeStatDayIR struct {
 	StakeStat LinoStakeStatIR `json:"stake_stat"`
 }
 
+// TreasurySpendIR - treasury spend with its votes, pk: id
+type TreasurySpendIR struct {
+	ID        int64                     `json:"id"`
+	Proposer  linotypes.AccountKey      `json:"proposer"`
+	Receiver  linotypes.AccountKey      `json:"receiver"`
+	Amount    linotypes.Coin            `json:"amount"`
+	Deposit   linotypes.Coin            `json:"deposit"`
+	Reason    string                    `json:"reason"`
+	CreatedAt int64                     `json:"created_at"`
+	DecideAt  int64                     `json:"decide_at"`
+	Status    types.TreasurySpendStatus `json:"status"`
+	Approve   linotypes.Coin            `json:"approve"`
+	Reject    linotypes.Coin            `json:"reject"`
+	Votes     []TreasurySpendVoteIR     `json:"votes"`
+}
+
+// TreasurySpendVoteIR - vote on treasury spend.
+type TreasurySpendVoteIR struct {
+	Voter   linotypes.AccountKey `json:"voter"`
+	Approve bool                 `json:"approve"`
+}
+
 // VoterTablesIR - state of voter
 type VoterTablesIR struct {
+	Version             int               `json:"version"`
+	Voters              []VoterIR         `json:"voters"`
+	StakeStats          []StakeStatDayIR  `json:"stake_stats"`
+	TreasurySpends      []TreasurySpendIR `json:"treasury_spends"`
+	TreasurySpendNextID int64             `json:"treasury_spend_next_id"`
+}
+
+// VoterTablesV2IR - state of voter of export version 2.
+type VoterTablesV2IR struct {
 	Version    int              `json:"version"`
-	Voters     []VoterIR        `json:"voters"`
+	Voters     []VoterV2IR      `json:"voters"`
 	StakeStats []StakeStatDayIR `json:"stake_stats"`
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/binary"
 	"strconv"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -12,8 +13,11 @@ import (
 )
 
 var (
-	VoterSubstore         = []byte{0x01} // SubStore for voter info.
-	LinoStakeStatSubStore = []byte{0x02} // SubStore for lino stake statistic
+	VoterSubstore          = []byte{0x01} // SubStore for voter info.
+	LinoStakeStatSubStore  = []byte{0x02} // SubStore for lino stake statistic
+	TreasurySpendSubstore  = []byte{0x03} // SubStore for treasury spend proposals.
+	TreasuryVoteSubstore   = []byte{0x04} // SubStore for votes on treasury spends.
+	TreasuryNextIDSubstore = []byte{0x05} // SubStore for next treasury spend id.
 )
 
 // VoteStorage - vote storage
@@ -88,6 +92,79 @@ func (vs VoteStorage) GetLinoStakeStat(ctx sdk.Context, day int64) (*LinoStakeSt
 	return linoStakeStat, nil
 }
 
+// GetTreasurySpend - get treasury spend by id.
+func (vs VoteStorage) GetTreasurySpend(ctx sdk.Context, id int64) (*TreasurySpend, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	bz := store.Get(GetTreasurySpendKey(id))
+	if bz == nil {
+		return nil, types.ErrTreasurySpendNotFound(id)
+	}
+	spend := new(TreasurySpend)
+	vs.cdc.MustUnmarshalBinaryLengthPrefixed(bz, spend)
+	return spend, nil
+}
+
+// SetTreasurySpend - set treasury spend.
+func (vs VoteStorage) SetTreasurySpend(ctx sdk.Context, spend *TreasurySpend) {
+	store := ctx.KVStore(vs.key)
+	bz := vs.cdc.MustMarshalBinaryLengthPrefixed(*spend)
+	store.Set(GetTreasurySpendKey(spend.ID), bz)
+}
+
+// GetTreasurySpendVote - get vote of voter on treasury spend.
+func (vs VoteStorage) GetTreasurySpendVote(
+	ctx sdk.Context, id int64, voter linotypes.AccountKey) (*TreasurySpendVote, bool) {
+	store := ctx.KVStore(vs.key)
+	bz := store.Get(GetTreasuryVoteKey(id, voter))
+	if bz == nil {
+		return nil, false
+	}
+	vote := new(TreasurySpendVote)
+	vs.cdc.MustUnmarshalBinaryLengthPrefixed(bz, vote)
+	return vote, true
+}
+
+// SetTreasurySpendVote - set vote of voter on treasury spend.
+func (vs VoteStorage) SetTreasurySpendVote(ctx sdk.Context, id int64, vote *TreasurySpendVote) {
+	store := ctx.KVStore(vs.key)
+	bz := vs.cdc.MustMarshalBinaryLengthPrefixed(*vote)
+	store.Set(GetTreasuryVoteKey(id, vote.Voter), bz)
+}
+
+// GetTreasurySpendVotes - all votes on treasury spend, sorted by voter.
+func (vs VoteStorage) GetTreasurySpendVotes(ctx sdk.Context, id int64) []TreasurySpendVote {
+	store := ctx.KVStore(vs.key)
+	iter := sdk.KVStorePrefixIterator(store, GetTreasuryVotePrefix(id))
+	defer iter.Close()
+	rst := make([]TreasurySpendVote, 0)
+	for ; iter.Valid(); iter.Next() {
+		vote := TreasurySpendVote{}
+		vs.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &vote)
+		rst = append(rst, vote)
+	}
+	return rst
+}
+
+// GetTreasuryNextID - id of next treasury spend, starts from 1.
+func (vs VoteStorage) GetTreasuryNextID(ctx sdk.Context) int64 {
+	store := ctx.KVStore(vs.key)
+	bz := store.Get(TreasuryNextIDSubstore)
+	if bz == nil {
+		return 1
+	}
+	id, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetTreasuryNextID - set id of next treasury spend.
+func (vs VoteStorage) SetTreasuryNextID(ctx sdk.Context, id int64) {
+	store := ctx.KVStore(vs.key)
+	store.Set(TreasuryNextIDSubstore, []byte(strconv.FormatInt(id, 10)))
+}
+
 // StoreMap - map of all substores
 func (vs VoteStorage) StoreMap(ctx sdk.Context) utils.StoreMap {
 	store := ctx.KVStore(vs.key)
@@ -104,6 +181,18 @@ func (vs VoteStorage) StoreMap(ctx sdk.Context) utils.StoreMap {
 			ValCreator: func() interface{} { return new(LinoStakeStat) },
 			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
 		},
+		{
+			Store:      store,
+			Prefix:     TreasurySpendSubstore,
+			ValCreator: func() interface{} { return new(TreasurySpend) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
+		{
+			Store:      store,
+			Prefix:     TreasuryVoteSubstore,
+			ValCreator: func() interface{} { return new(TreasurySpendVote) },
+			Decoder:    vs.cdc.MustUnmarshalBinaryLengthPrefixed,
+		},
 	}
 	return utils.NewStoreMap(substores)
 }
@@ -113,6 +202,35 @@ func GetLinoStakeStatKey(day int64) []byte {
 	return append(LinoStakeStatSubStore, strconv.FormatInt(day, 10)...)
 }
 
+// GetTreasurySpendKey - "treasury spend substore" + big endian id
+func GetTreasurySpendKey(id int64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, uint64(id))
+	return append(TreasurySpendSubstore, idBytes...)
+}
+
+// GetTreasuryVotePrefix - "treasury vote substore" + big endian id
+func GetTreasuryVotePrefix(id int64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, uint64(id))
+	return append(TreasuryVoteSubstore, idBytes...)
+}
+
+// GetTreasuryVoteKey - "treasury vote substore" + big endian id + "voter"
+func GetTreasuryVoteKey(id int64, voter linotypes.AccountKey) []byte {
+	return append(GetTreasuryVotePrefix(id), voter...)
+}
+
+// ParseTreasurySpendKey - get id from key of treasury spend substore, without prefix.
+func ParseTreasurySpendKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key))
+}
+
+// ParseTreasuryVoteKey - get id from key of treasury vote substore, without prefix.
+func ParseTreasuryVoteKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[:8]))
+}
+
 // GetVoterKey - "voter substore" + "voter"
 func GetVoterKey(me linotypes.AccountKey) []byte {
 	return append(VoterSubstore, me...)
@@ -54,16 +54,20 @@ func (suite *voteStoreTestSuite) TestGetSetVoter() {
 		LinoStake:         linotypes.NewCoinFromInt64(123),
 		LastPowerChangeAt: 777,
 		Interest:          linotypes.NewCoinFromInt64(234),
-		Duty:              types.DutyValidator,
-		FrozenAmount:      linotypes.NewCoinFromInt64(9),
+		Duties: []DutyRecord{{
+			Duty:         types.DutyValidator,
+			FrozenAmount: linotypes.NewCoinFromInt64(9),
+		}},
 	}
 	voter2 := Voter{
 		Username:          user2,
 		LinoStake:         linotypes.NewCoinFromInt64(345),
 		LastPowerChangeAt: 888,
 		Interest:          linotypes.NewCoinFromInt64(456),
-		Duty:              types.DutyValidator,
-		FrozenAmount:      linotypes.NewCoinFromInt64(12),
+		Duties: []DutyRecord{{
+			Duty:         types.DutyValidator,
+			FrozenAmount: linotypes.NewCoinFromInt64(12),
+		}},
 	}
 
 	suite.False(store.DoesVoterExist(ctx, user1))
@@ -116,3 +120,48 @@ func (suite *voteStoreTestSuite) TestGetSetLinoStakeStats() {
 
 	suite.Golden()
 }
+
+func (suite *voteStoreTestSuite) TestGetSetTreasurySpend() {
+	store := suite.store
+	ctx := suite.Ctx
+	spend := TreasurySpend{
+		ID:        1,
+		Proposer:  linotypes.AccountKey("user1"),
+		Receiver:  linotypes.AccountKey("user2"),
+		Amount:    linotypes.NewCoinFromInt64(100),
+		Deposit:   linotypes.NewCoinFromInt64(10),
+		Reason:    "reason",
+		CreatedAt: 123,
+		DecideAt:  456,
+		Status:    types.TreasurySpendPending,
+		Approve:   linotypes.NewCoinFromInt64(0),
+		Reject:    linotypes.NewCoinFromInt64(0),
+	}
+
+	_, err := store.GetTreasurySpend(ctx, 1)
+	suite.Equal(types.ErrTreasurySpendNotFound(1), err)
+	suite.Equal(int64(1), store.GetTreasuryNextID(ctx))
+
+	store.SetTreasurySpend(ctx, &spend)
+	store.SetTreasuryNextID(ctx, 2)
+	store.SetTreasurySpendVote(ctx, 1, &TreasurySpendVote{Voter: "user3", Approve: true})
+	store.SetTreasurySpendVote(ctx, 1, &TreasurySpendVote{Voter: "user1", Approve: false})
+	store.SetTreasurySpendVote(ctx, 2, &TreasurySpendVote{Voter: "user2", Approve: true})
+
+	v, err := store.GetTreasurySpend(ctx, 1)
+	suite.Nil(err)
+	suite.Equal(&spend, v)
+	suite.Equal(int64(2), store.GetTreasuryNextID(ctx))
+
+	vote, ok := store.GetTreasurySpendVote(ctx, 1, "user3")
+	suite.True(ok)
+	suite.Equal(&TreasurySpendVote{Voter: "user3", Approve: true}, vote)
+	_, ok = store.GetTreasurySpendVote(ctx, 1, "user2")
+	suite.False(ok)
+	suite.Equal([]TreasurySpendVote{
+		{Voter: "user1", Approve: false},
+		{Voter: "user3", Approve: true},
+	}, store.GetTreasurySpendVotes(ctx, 1))
+
+	suite.Golden()
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	linotypes "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/vote/types"
+)
+
+// TreasurySpend - a proposal to release coins in treasury pool to receiver,
+// decided by stake-weighted votes of voters. The deposit of proposer is held
+// in the treasury deposit pool until decided.
+type TreasurySpend struct {
+	ID        int64                     `json:"id"`
+	Proposer  linotypes.AccountKey      `json:"proposer"`
+	Receiver  linotypes.AccountKey      `json:"receiver"`
+	Amount    linotypes.Coin            `json:"amount"`
+	Deposit   linotypes.Coin            `json:"deposit"`
+	Reason    string                    `json:"reason"`
+	CreatedAt int64                     `json:"created_at"`
+	DecideAt  int64                     `json:"decide_at"`
+	Status    types.TreasurySpendStatus `json:"status"`
+	// lino stake of approve and reject voters when decided.
+	Approve linotypes.Coin `json:"approve"`
+	Reject  linotypes.Coin `json:"reject"`
+}
+
+// TreasurySpendVote - vote of a voter on a treasury spend.
+type TreasurySpendVote struct {
+	Voter   linotypes.AccountKey `json:"voter"`
+	Approve bool                 `json:"approve"`
+}
+
+// TreasurySpendList - a page of treasury spends, sorted by id.
+type TreasurySpendList struct {
+	Total  int64           `json:"total"`
+	Start  int64           `json:"start"`
+	Spends []TreasurySpend `json:"spends"`
+}
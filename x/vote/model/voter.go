@@ -11,8 +11,35 @@ type Voter struct {
 	LinoStake         linotypes.Coin       `json:"lino_stake"`
 	LastPowerChangeAt int64                `json:"last_power_change_at"`
 	Interest          linotypes.Coin       `json:"interest"`
-	Duty              types.VoterDuty      `json:"duty"`
-	FrozenAmount      linotypes.Coin       `json:"frozen_amount"`
+	Duties            []DutyRecord         `json:"duties"`
+}
+
+// DutyRecord - a duty held by voter, each duty freezes its own amount of stake.
+type DutyRecord struct {
+	Duty         types.VoterDuty `json:"duty"`
+	FrozenAmount linotypes.Coin  `json:"frozen_amount"`
+	// pending duty is in unassign period and will be removed at UnassignAt.
+	IsPending  bool  `json:"is_pending"`
+	UnassignAt int64 `json:"unassign_at"`
+}
+
+// FrozenAmount - total amount of stake frozen by all duties.
+func (v Voter) FrozenAmount() linotypes.Coin {
+	frozen := linotypes.NewCoinFromInt64(0)
+	for _, d := range v.Duties {
+		frozen = frozen.Plus(d.FrozenAmount)
+	}
+	return frozen
+}
+
+// FindDuty - return index of the duty in Duties, -1 if voter does not hold it.
+func (v Voter) FindDuty(duty types.VoterDuty) int {
+	for i, d := range v.Duties {
+		if d.Duty == duty {
+			return i
+		}
+	}
+	return -1
 }
 
 // LinoStakeStat - records the information needed by
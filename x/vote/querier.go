@@ -28,6 +28,38 @@ func NewQuerier(vk VoteKeeper) sdk.Querier {
 				}
 				return vk.GetStakeStatsOfDay(ctx, day)
 			})(ctx, cdc, path)
+		case types.QueryDuties:
+			return utils.NewQueryResolver(0, func(args ...string) (interface{}, sdk.Error) {
+				return vk.GetDutySpecs(ctx), nil
+			})(ctx, cdc, path)
+		case types.QueryTreasurySpend:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				id, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return nil, linotypes.ErrInvalidQueryPath()
+				}
+				return vk.GetTreasurySpend(ctx, id)
+			})(ctx, cdc, path)
+		case types.QueryTreasurySpends:
+			return utils.NewQueryResolver(2, func(args ...string) (interface{}, sdk.Error) {
+				start, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return nil, linotypes.ErrInvalidQueryPath()
+				}
+				limit, err := strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return nil, linotypes.ErrInvalidQueryPath()
+				}
+				return vk.GetTreasurySpends(ctx, start, limit)
+			})(ctx, cdc, path)
+		case types.QueryTreasurySpendVotes:
+			return utils.NewQueryResolver(1, func(args ...string) (interface{}, sdk.Error) {
+				id, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return nil, linotypes.ErrInvalidQueryPath()
+				}
+				return vk.GetTreasurySpendVotes(ctx, id)
+			})(ctx, cdc, path)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown vote query endpoint")
 		}
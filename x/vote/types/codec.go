@@ -10,6 +10,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(StakeOutMsg{}, "lino/stakeOut", nil)
 	cdc.RegisterConcrete(ClaimInterestMsg{}, "lino/claimInterest", nil)
 	cdc.RegisterConcrete(StakeInForMsg{}, "lino/stakeInFor", nil)
+	cdc.RegisterConcrete(ProposeTreasurySpendMsg{}, "lino/proposeTreasurySpend", nil)
+	cdc.RegisterConcrete(VoteTreasurySpendMsg{}, "lino/voteTreasurySpend", nil)
 }
 
 var msgCdc = wire.New()
@@ -8,3 +8,15 @@ const (
 	DutyValidator VoterDuty = 2
 	DutyPending   VoterDuty = 3 // pending is when voter is in unassign period
 )
+
+// DutySpec - a duty type registered in vote module. DutyVoter and DutyPending
+// are states of voter, not duties, so they can not be registered.
+type DutySpec struct {
+	Duty VoterDuty `json:"duty"`
+	Name string    `json:"name"`
+	// Exclusive duty can not be held together with any other duty.
+	Exclusive bool `json:"exclusive"`
+}
+
+// DutyRegistry - all registered duty types.
+type DutyRegistry map[VoterDuty]DutySpec
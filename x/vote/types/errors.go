@@ -78,3 +78,27 @@ func ErrStakeStatNotFound(day int64) sdk.Error {
 	return types.NewError(
 		types.CodeStakeStatNotFound, fmt.Sprintf("stake stats not found: %d", day))
 }
+
+// ErrDutyNotRegistered -
+func ErrDutyNotRegistered(duty VoterDuty) sdk.Error {
+	return types.NewError(
+		types.CodeDutyNotRegistered, fmt.Sprintf("duty not registered: %d", duty))
+}
+
+// ErrInvalidTreasurySpend -
+func ErrInvalidTreasurySpend(reason string) sdk.Error {
+	return types.NewError(
+		types.CodeInvalidTreasurySpend, fmt.Sprintf("invalid treasury spend: %s", reason))
+}
+
+// ErrTreasurySpendNotFound -
+func ErrTreasurySpendNotFound(id int64) sdk.Error {
+	return types.NewError(
+		types.CodeTreasurySpendNotFound, fmt.Sprintf("treasury spend not found: %d", id))
+}
+
+// ErrTreasurySpendClosed -
+func ErrTreasurySpendClosed(id int64) sdk.Error {
+	return types.NewError(
+		types.CodeTreasurySpendClosed, fmt.Sprintf("treasury spend is closed for voting: %d", id))
+}
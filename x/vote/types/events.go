@@ -8,4 +8,11 @@ import (
 // duty and frozen money will be cleared.
 type UnassignDutyEvent struct {
 	Username linotypes.AccountKey `json:"username"`
+	Duty     VoterDuty            `json:"duty"`
+}
+
+// DecideTreasurySpendEvent - tally votes of a treasury spend proposal when
+// its voting period ends, release funds if it passes.
+type DecideTreasurySpendEvent struct {
+	ID int64 `json:"id"`
 }
@@ -12,4 +12,9 @@ const (
 
 	QueryVoter      = "voter"
 	QueryStakeStats = "stake-stats"
+	QueryDuties     = "duties"
+
+	QueryTreasurySpend      = "treasury-spend"
+	QueryTreasurySpends     = "treasury-spends"
+	QueryTreasurySpendVotes = "treasury-spend-votes"
 )
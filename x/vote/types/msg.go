@@ -13,6 +13,8 @@ var _ types.Msg = StakeInMsg{}
 var _ types.Msg = StakeOutMsg{}
 var _ types.Msg = ClaimInterestMsg{}
 var _ types.Msg = StakeInForMsg{}
+var _ types.Msg = ProposeTreasurySpendMsg{}
+var _ types.Msg = VoteTreasurySpendMsg{}
 
 // StakeInMsg - voter deposit
 type StakeInMsg struct {
@@ -251,3 +253,140 @@ func (msg StakeInForMsg) GetSigners() []sdk.AccAddress {
 func (msg StakeInForMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// ProposeTreasurySpendMsg - propose to release coins in treasury pool to receiver,
+// with a deposit.
+type ProposeTreasurySpendMsg struct {
+	Proposer types.AccountKey `json:"proposer"`
+	Receiver types.AccountKey `json:"receiver"`
+	Amount   types.LNO        `json:"amount"`
+	Deposit  types.LNO        `json:"deposit"`
+	Reason   string           `json:"reason"`
+}
+
+// VoteTreasurySpendMsg - vote on a treasury spend proposal with lino stake.
+type VoteTreasurySpendMsg struct {
+	Voter   types.AccountKey `json:"voter"`
+	ID      int64            `json:"id"`
+	Approve bool             `json:"approve"`
+}
+
+// NewProposeTreasurySpendMsg - return a ProposeTreasurySpendMsg
+func NewProposeTreasurySpendMsg(proposer, receiver string, amount, deposit types.LNO, reason string) ProposeTreasurySpendMsg {
+	return ProposeTreasurySpendMsg{
+		Proposer: types.AccountKey(proposer),
+		Receiver: types.AccountKey(receiver),
+		Amount:   amount,
+		Deposit:  deposit,
+		Reason:   reason,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg ProposeTreasurySpendMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg ProposeTreasurySpendMsg) Type() string { return "ProposeTreasurySpendMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ProposeTreasurySpendMsg) ValidateBasic() sdk.Error {
+	if !msg.Proposer.IsValid() || !msg.Receiver.IsValid() {
+		return ErrInvalidUsername()
+	}
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	_, err = types.LinoToCoin(msg.Deposit)
+	if err != nil {
+		return err
+	}
+	if len(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrInvalidTreasurySpend("reason is too long")
+	}
+	return nil
+}
+
+func (msg ProposeTreasurySpendMsg) String() string {
+	return fmt.Sprintf("ProposeTreasurySpendMsg{Proposer:%v, Receiver:%v, Amount:%v, Deposit:%v, Reason:%v}",
+		msg.Proposer, msg.Receiver, msg.Amount, msg.Deposit, msg.Reason)
+}
+
+// GetPermission - implements types.Msg
+func (msg ProposeTreasurySpendMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ProposeTreasurySpendMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ProposeTreasurySpendMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Proposer)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ProposeTreasurySpendMsg) GetConsumeAmount() types.Coin {
+	coin, _ := types.LinoToCoin(msg.Deposit)
+	return coin
+}
+
+// NewVoteTreasurySpendMsg - return a VoteTreasurySpendMsg
+func NewVoteTreasurySpendMsg(voter string, id int64, approve bool) VoteTreasurySpendMsg {
+	return VoteTreasurySpendMsg{
+		Voter:   types.AccountKey(voter),
+		ID:      id,
+		Approve: approve,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg VoteTreasurySpendMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg VoteTreasurySpendMsg) Type() string { return "VoteTreasurySpendMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg VoteTreasurySpendMsg) ValidateBasic() sdk.Error {
+	if !msg.Voter.IsValid() {
+		return ErrInvalidUsername()
+	}
+	if msg.ID <= 0 {
+		return ErrTreasurySpendNotFound(msg.ID)
+	}
+	return nil
+}
+
+func (msg VoteTreasurySpendMsg) String() string {
+	return fmt.Sprintf("VoteTreasurySpendMsg{Voter:%v, ID:%v, Approve:%v}", msg.Voter, msg.ID, msg.Approve)
+}
+
+// GetPermission - implements types.Msg
+func (msg VoteTreasurySpendMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg VoteTreasurySpendMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg VoteTreasurySpendMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Voter)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg VoteTreasurySpendMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
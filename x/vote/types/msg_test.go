@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -141,6 +142,89 @@ func TestStakeOutMsg(t *testing.T) {
 	}
 }
 
+func TestProposeTreasurySpendMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ProposeTreasurySpendMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewProposeTreasurySpendMsg("user1", "user2", "1", "1", "reason"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid proposer",
+			msg:           NewProposeTreasurySpendMsg("", "user2", "1", "1", "reason"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid receiver",
+			msg:           NewProposeTreasurySpendMsg("user1", "", "1", "1", "reason"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid amount",
+			msg:           NewProposeTreasurySpendMsg("user1", "user2", "-1", "1", "reason"),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:      "zero amount",
+			msg:           NewProposeTreasurySpendMsg("user1", "user2", "0", "1", "reason"),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:      "invalid deposit",
+			msg:           NewProposeTreasurySpendMsg("user1", "user2", "1", "-1", "reason"),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName: "reason too long",
+			msg: NewProposeTreasurySpendMsg(
+				"user1", "user2", "1", "1", strings.Repeat("r", types.MaximumLengthOfProposalReason+1)),
+			expectedError: ErrInvalidTreasurySpend("reason is too long"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestVoteTreasurySpendMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           VoteTreasurySpendMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewVoteTreasurySpendMsg("user1", 1, true),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid voter",
+			msg:           NewVoteTreasurySpendMsg("", 1, false),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid id",
+			msg:           NewVoteTreasurySpendMsg("user1", 0, true),
+			expectedError: ErrTreasurySpendNotFound(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -157,6 +241,16 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewStakeOutMsg("test", types.LNO("1")),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "propose treasury spend",
+			msg:                NewProposeTreasurySpendMsg("test", "user", types.LNO("1"), types.LNO("1"), ""),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "vote treasury spend",
+			msg:                NewVoteTreasurySpendMsg("test", 1, true),
+			expectedPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -203,6 +297,16 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewStakeOutMsg("test", types.LNO("1")),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName:      "propose treasury spend",
+			msg:           NewProposeTreasurySpendMsg("test", "user", types.LNO("1"), types.LNO("1"), ""),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "vote treasury spend",
+			msg:           NewVoteTreasurySpendMsg("test", 1, true),
+			expectSigners: []types.AccountKey{"test"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,11 @@
+package types
+
+type TreasurySpendStatus int
+
+const (
+	TreasurySpendPending  TreasurySpendStatus = 1
+	TreasurySpendExecuted TreasurySpendStatus = 2
+	TreasurySpendRejected TreasurySpendStatus = 3
+	// failed when it passed but treasury pool does not have enough coins.
+	TreasurySpendFailed TreasurySpendStatus = 4
+)